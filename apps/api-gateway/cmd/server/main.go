@@ -2,12 +2,21 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/provsalt/DOP_P01_Team1/api-gateway/internal/config"
 	"github.com/provsalt/DOP_P01_Team1/api-gateway/internal/handlers"
 	"github.com/provsalt/DOP_P01_Team1/api-gateway/internal/server"
+	"github.com/provsalt/DOP_P01_Team1/common/interceptor"
+	"github.com/provsalt/DOP_P01_Team1/common/logging"
+	"github.com/provsalt/DOP_P01_Team1/common/serviceauth"
 	"github.com/provsalt/DOP_P01_Team1/common/telemetry"
+	"github.com/provsalt/DOP_P01_Team1/common/tlsconfig"
+	"google.golang.org/grpc"
 
 	docs "github.com/provsalt/DOP_P01_Team1/api-gateway/docs"
 )
@@ -26,55 +35,137 @@ import (
 func main() {
 	cfg, err := config.Load()
 	if err != nil {
-		log.Fatalf("Failed to load configuration: %v", err)
-	}
-
-	if cfg.AxiomToken == "" {
-		log.Printf("Tracing disabled: AXIOM_API_TOKEN is empty")
-	} else {
-		shutdown, err := telemetry.InitTelemetry(context.Background(), telemetry.Config{
-			ServiceName:    "api-gateway",
-			Environment:    cfg.Environment,
-			Token:          cfg.AxiomToken,
-			Endpoint:       cfg.AxiomEndpoint,
-			Dataset:        cfg.AxiomDataset,
-			MetricsDataset: cfg.AxiomMetricsDataset,
-		})
-		if err != nil {
-			log.Printf("Tracing disabled: failed to initialize tracer: %v", err)
-		} else {
-			defer shutdown(context.Background())
-		}
+		fatal("Failed to load configuration", "error", err)
+	}
+
+	if _, err := logging.Setup(logging.Config{
+		ServiceName: "api-gateway",
+		Environment: cfg.Environment,
+		Level:       cfg.LogLevel,
+		Format:      cfg.LogFormat,
+	}); err != nil {
+		fatal("Failed to configure logging", "error", err)
 	}
 
-	log.Printf("Using auth-service at: %s", cfg.AuthServiceAddr)
-	log.Printf("Using user-service at: %s", cfg.UserServiceAddr)
-	log.Printf("Using file-service at: %s", cfg.FileServiceAddr)
+	telemetryCfg := telemetry.Config{
+		ServiceName: "api-gateway",
+		Environment: cfg.Environment,
+		Prometheus:  cfg.PrometheusEnabled,
+	}
+	// Axiom is kept as a shortcut; otherwise the OTEL_* variables decide where
+	// telemetry goes, and nothing is exported when none are set.
+	if cfg.AxiomToken != "" {
+		telemetryCfg.Token = cfg.AxiomToken
+		telemetryCfg.Endpoint = cfg.AxiomEndpoint
+		telemetryCfg.Dataset = cfg.AxiomDataset
+		telemetryCfg.MetricsDataset = cfg.AxiomMetricsDataset
+	}
+	shutdownTelemetry, err := telemetry.InitTelemetry(context.Background(), telemetryCfg)
+	if err != nil {
+		slog.Warn("Telemetry disabled: failed to initialize", "error", err)
+		shutdownTelemetry = func(context.Context) error { return nil }
+	}
+
+	slog.Info("Using backend services",
+		"auth_service_addr", cfg.AuthServiceAddr,
+		"user_service_addr", cfg.UserServiceAddr,
+		"file_service_addr", cfg.FileServiceAddr,
+	)
 
 	if cfg.Environment == "development" {
 		docs.SwaggerInfo.Host = "localhost:" + cfg.Port
 	}
 
-	authClient, err := handlers.NewGRPCAuthClient(cfg.AuthServiceAddr)
+	transportCreds, err := tlsconfig.ClientCredentials(cfg.TLS())
 	if err != nil {
-		log.Fatalf("Failed to create auth client: %v", err)
+		fatal("Failed to load TLS configuration", "error", err)
+	}
+	if cfg.TLS().ClientEnabled() {
+		slog.Info("Using TLS for backend gRPC connections")
+	}
+	dialOpts := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(transportCreds),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallSendMsgSize(cfg.GRPCMaxSendMsgSize),
+			grpc.MaxCallRecvMsgSize(cfg.GRPCMaxRecvMsgSize),
+		),
+	}, interceptor.ClientOptions()...)
+
+	authClient, err := handlers.NewGRPCAuthClient(cfg.AuthServiceAddr, dialOpts...)
+	if err != nil {
+		fatal("Failed to create auth client", "error", err)
+	}
+
+	userDialOpts := append([]grpc.DialOption{}, dialOpts...)
+	if cfg.ServiceAuthSecret != "" {
+		userDialOpts = append(userDialOpts, grpc.WithPerRPCCredentials(
+			serviceauth.NewSigner("api-gateway", "user-service", cfg.ServiceAuthSecret),
+		))
 	}
 
-	userClient, err := handlers.NewGRPCUserClient(cfg.UserServiceAddr)
+	userClient, err := handlers.NewGRPCUserClient(cfg.UserServiceAddr, userDialOpts...)
 	if err != nil {
-		log.Fatalf("Failed to create user client: %v", err)
+		fatal("Failed to create user client", "error", err)
 	}
 
-	fileClient, err := handlers.NewGRPCFileClient(cfg.FileServiceAddr)
+	// file-service only honours download links and quotas from the gateway.
+	fileDialOpts := append([]grpc.DialOption{}, dialOpts...)
+	if cfg.ServiceAuthSecret != "" {
+		fileDialOpts = append(fileDialOpts, grpc.WithPerRPCCredentials(
+			serviceauth.NewSigner("api-gateway", "file-service", cfg.ServiceAuthSecret),
+		))
+	}
+
+	fileClient, err := handlers.NewGRPCFileClient(cfg.FileServiceAddr, fileDialOpts...)
 	if err != nil {
-		log.Fatalf("Failed to create file client: %v", err)
+		fatal("Failed to create file client", "error", err)
 	}
 
 	srv := server.New(authClient, userClient, fileClient, cfg)
-	defer srv.Close()
 
-	log.Printf("API Gateway listening on :%s", cfg.Port)
-	if err := srv.Run(":" + cfg.Port); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		slog.Info("API Gateway listening", "port", cfg.Port)
+		serveErr <- srv.Run(":" + cfg.Port)
+	}()
+
+	exitCode := 0
+	select {
+	case err := <-serveErr:
+		if err != nil {
+			slog.Error("Failed to start server", "error", err)
+			exitCode = 1
+		}
+	case <-ctx.Done():
+		slog.Info("Shutdown signal received, draining in-flight requests", "delay", cfg.ShutdownDrainDelay.String(), "timeout", cfg.ShutdownTimeout.String())
+		drainCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownDrainDelay+cfg.ShutdownTimeout)
+		if err := srv.Shutdown(drainCtx); err != nil {
+			slog.Error("Graceful shutdown incomplete", "error", err)
+		}
+		cancel()
 	}
+
+	if err := srv.Close(); err != nil {
+		slog.Error("Failed to close backend clients", "error", err)
+	}
+
+	flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTelemetry(flushCtx); err != nil {
+		slog.Error("Failed to flush telemetry", "error", err)
+	}
+
+	slog.Info("API Gateway stopped")
+	if exitCode != 0 {
+		os.Exit(exitCode)
+	}
+}
+
+// fatal logs msg at error level and exits, mirroring log.Fatal for slog.
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
 }
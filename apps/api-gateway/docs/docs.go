@@ -22,7 +22,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Admin-only endpoint to create a new user account",
+                "description": "Admin-only endpoint to create a new user account.\nSend an Idempotency-Key header to make retries safe: a repeat returns the first response.",
                 "consumes": [
                     "application/json"
                 ],
@@ -69,6 +69,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "A request with the same Idempotency-Key is still in progress",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Idempotency-Key was already used for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -147,54 +159,105 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/list_users": {
+        "/api/admin/files": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Admin-only endpoint to list all users with optional role filter and username search",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Admin-only. List every user's files, or one user's with owner (a username) or owner_id.\nTakes the same search, sort and paging parameters as GET /api/files, except folder_id.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "List all users",
+                "summary": "List files across users",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by role (admin or user)",
-                        "name": "role",
+                        "description": "Username of the owner",
+                        "name": "owner",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Search by username (partial match)",
-                        "name": "username",
+                        "description": "User ID of the owner",
+                        "name": "owner_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive substring of the filename",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Tag the file must carry",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Content type, or a top-level type such as image",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "size",
+                            "created_at"
+                        ],
+                        "type": "string",
+                        "description": "Sort by name, size or created_at (the default)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort direction",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Files per page, 1-200 (default 50)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from a previous page",
+                        "name": "page_token",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of users",
+                        "description": "Files",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "array",
-                                "items": {
-                                    "type": "object",
-                                    "additionalProperties": true
-                                }
+                            "$ref": "#/definitions/internal_handlers.AdminListFilesResponse"
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "Links to the next and previous pages, when there are any"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid role parameter",
+                        "description": "Invalid filter, sort or page token",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
@@ -205,39 +268,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Forbidden - admin role required",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/api/files": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Retrieve a list of all files uploaded by the authenticated user",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "files"
-                ],
-                "summary": "List all files for the authenticated user",
-                "responses": {
-                    "200": {
-                        "description": "Files retrieved successfully",
-                        "schema": {
-                            "$ref": "#/definitions/internal_handlers.ListFilesResponse"
-                        }
                     },
-                    "401": {
-                        "description": "Unauthorized - missing or invalid token",
+                    "404": {
+                        "description": "Owner not found",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
@@ -249,42 +287,52 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/api/admin/files/actions": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upload a file to S3 and save metadata for the authenticated user",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+                "description": "Admin-only. List the recorded admin accesses to users' files, newest first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "files"
+                    "admin"
                 ],
-                "summary": "Upload a file",
+                "summary": "List admin file actions",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "File to upload",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "type": "string",
+                        "description": "Only actions on this file",
+                        "name": "file_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only actions by this admin",
+                        "name": "admin_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of actions, 1-500 (default 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "File uploaded successfully",
+                        "description": "Actions",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers.FileResponse"
+                            "$ref": "#/definitions/internal_handlers.ListAdminActionsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid file or missing required field",
+                        "description": "Invalid limit",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
@@ -295,14 +343,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
                     },
-                    "429": {
-                        "description": "Too many files - limit is 20 per user",
+                    "403": {
+                        "description": "Forbidden - admin role required",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error or file too large",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
@@ -310,44 +358,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/files/multipart/initiate": {
-            "post": {
+        "/api/admin/files/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Start a new multipart upload session for large files",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Admin-only. Retrieve metadata for a file whoever owns it.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "files"
+                    "admin"
                 ],
-                "summary": "Initiate a multipart upload",
+                "summary": "Get any file's metadata",
                 "parameters": [
                     {
-                        "description": "Upload initiation request",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_handlers.InitiateMultipartUploadRequest"
-                        }
+                        "type": "string",
+                        "description": "File ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Upload session created",
+                        "description": "File metadata",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers.InitiateMultipartUploadResponse"
+                            "$ref": "#/definitions/internal_handlers.AdminFileResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Invalid file ID",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
@@ -358,6 +401,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden - admin role required",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "File not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -365,37 +420,41 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/files/multipart/{upload_id}": {
+            },
             "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cancel an in-progress multipart upload and clean up resources",
+                "description": "Admin-only. Delete a file whoever owns it, along with its download links and shares.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "files"
+                    "admin"
                 ],
-                "summary": "Abort a multipart upload",
+                "summary": "Delete any file",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Upload session ID",
-                        "name": "upload_id",
+                        "description": "File ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Upload aborted successfully",
+                        "description": "File deleted",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers.AbortMultipartUploadResponse"
+                            "$ref": "#/definitions/internal_handlers.DeleteFileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid file ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
                     },
                     "401": {
@@ -404,6 +463,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden - admin role required",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "File not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -413,51 +484,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/files/multipart/{upload_id}/complete": {
-            "post": {
+        "/api/admin/files/{id}/download": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Finalize a multipart upload by providing all part ETags",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Admin-only. Download a file whoever owns it. Range and conditional requests work as on GET /api/files/{id}/download.",
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "files"
+                    "admin"
                 ],
-                "summary": "Complete a multipart upload",
+                "summary": "Download any file",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Upload session ID",
-                        "name": "upload_id",
+                        "description": "File ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Parts to complete",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_handlers.CompleteMultipartUploadRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "File uploaded successfully",
+                        "description": "File content",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers.FileResponse"
+                            "type": "file"
+                        }
+                    },
+                    "206": {
+                        "description": "Requested byte range",
+                        "schema": {
+                            "type": "file"
                         }
                     },
+                    "304": {
+                        "description": "File not modified"
+                    },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Invalid file ID",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
@@ -468,8 +536,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden - admin role required",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
                     "404": {
-                        "description": "Upload session not found",
+                        "description": "File not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "416": {
+                        "description": "Range not satisfiable",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
@@ -483,56 +563,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/files/multipart/{upload_id}/part/{part_number}": {
-            "post": {
+        "/api/admin/list_users": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upload a single chunk of a file as part of a multipart upload",
+                "description": "Admin-only endpoint to list all users with optional role filter and username search",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "files"
+                    "admin"
                 ],
-                "summary": "Upload a part of a multipart upload",
+                "summary": "List all users",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Upload session ID",
-                        "name": "upload_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Filter by role (admin or user)",
+                        "name": "role",
+                        "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "Part number",
-                        "name": "part_number",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "File chunk to upload",
-                        "name": "chunk",
-                        "in": "formData",
-                        "required": true
+                        "type": "string",
+                        "description": "Search by username (partial match)",
+                        "name": "username",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Part uploaded successfully",
+                        "description": "List of users",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers.UploadPartResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "array",
+                                "items": {
+                                    "type": "object",
+                                    "additionalProperties": true
+                                }
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid part number or missing chunk",
+                        "description": "Invalid role parameter",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
@@ -552,25 +630,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/files/{id}": {
+        "/api/admin/users/{id}/quota": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve metadata for a specific file by ID",
+                "description": "Admin-only. Show a user's storage quota alongside the default for their role. A limit of 0 is unlimited.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "files"
+                    "admin"
                 ],
-                "summary": "Get file metadata",
+                "summary": "Get a user's storage quota",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "File ID",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -578,15 +656,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "File metadata retrieved successfully",
+                        "description": "Quota",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers.GetFileResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid file ID",
-                        "schema": {
-                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                            "$ref": "#/definitions/internal_handlers.QuotaResponse"
                         }
                     },
                     "401": {
@@ -596,7 +668,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "File not found",
+                        "description": "User not found",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
@@ -609,38 +681,50 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a file by ID (must be owned by the authenticated user)",
+                "description": "Admin-only. Override a user's file count and byte limits. A limit of 0 is unlimited;\nleaving one out or null puts it back to the role default, so {} resets the user.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "files"
+                    "admin"
                 ],
-                "summary": "Delete a file",
+                "summary": "Set a user's storage quota",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "File ID",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "New limits",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.SetQuotaRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "File deleted successfully",
+                        "description": "Updated quota",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers.DeleteFileResponse"
+                            "$ref": "#/definitions/internal_handlers.QuotaResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid file ID",
+                        "description": "Invalid limits",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
@@ -652,7 +736,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "File not found",
+                        "description": "User not found",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
@@ -666,39 +750,123 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/files/{id}/download": {
+        "/api/files": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Download a file by ID (must be owned by the authenticated user)",
+                "description": "Retrieve a list of all files uploaded by the authenticated user.\nPass folder_id to list a single folder instead: the response then also carries its subfolders and the breadcrumbs from the top level down to it.\nThe other parameters narrow the files returned; they can be combined, and repeating tag requires every tag given.\nFiles come a page at a time. The Link header points at the next and previous pages; a page token only works with the filters and sort order it was issued for.",
                 "produces": [
-                    "application/octet-stream"
+                    "application/json"
                 ],
                 "tags": [
                     "files"
                 ],
-                "summary": "Download a file",
+                "summary": "List all files for the authenticated user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "File ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Folder to list, or root for the top level",
+                        "name": "folder_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive substring of the filename",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Tag the file must carry",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Content type, or a top-level type such as image or image/* to match all of them",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum size in bytes",
+                        "name": "min_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum size in bytes",
+                        "name": "max_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Uploaded at or after this Unix time",
+                        "name": "created_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Uploaded before this Unix time",
+                        "name": "created_before",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "size",
+                            "created_at"
+                        ],
+                        "type": "string",
+                        "description": "Sort by name, size or created_at (the default)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort direction",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Files per page, 1-200 (default 50)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from a previous page's next_page_token or prev_page_token",
+                        "name": "page_token",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "File content",
+                        "description": "Files retrieved successfully",
                         "schema": {
-                            "type": "file"
+                            "$ref": "#/definitions/internal_handlers.ListFilesResponse"
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "Links to the next and previous pages, when there are any"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid file ID",
+                        "description": "Invalid folder ID, tag, numeric filter, sort or page token",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
@@ -710,7 +878,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "File not found",
+                        "description": "Folder not found",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
@@ -722,227 +890,2987 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/login": {
+            },
             "post": {
-                "description": "Authenticate a user and receive a JWT token",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload a file to S3 and save metadata for the authenticated user.\nThe file is streamed to storage as it arrives. Send it as the \"file\" field of a multipart form,\nor as the raw request body with its name in the X-Filename header.\nSend an Idempotency-Key header to make retries safe: a repeat returns the first response.\nSend X-Checksum-SHA256 to have the file rejected unless it arrives intact. The stored file's SHA-256 is returned either way.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "files"
                 ],
-                "summary": "User login",
+                "summary": "Upload a file",
                 "parameters": [
                     {
-                        "description": "User credentials",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_handlers.LoginRequest"
-                        }
+                        "type": "file",
+                        "description": "File to upload",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Folder to upload into; the top level when omitted",
+                        "name": "folder_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hex SHA-256 of the file",
+                        "name": "X-Checksum-SHA256",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Login successful",
+                        "description": "File uploaded successfully",
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers.AuthResponse"
+                            "$ref": "#/definitions/internal_handlers.FileResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Invalid file, missing required field or checksum mismatch",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Invalid credentials",
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A request with the same Idempotency-Key is still in progress",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "File exceeds the maximum size of 2GB or the storage quota",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Idempotency-Key was already used for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "File quota reached",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Server error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/internal_handlers.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "internal_handlers.AbortMultipartUploadResponse": {
-            "type": "object",
-            "properties": {
-                "success": {
-                    "type": "boolean",
-                    "example": true
-                }
-            }
-        },
-        "internal_handlers.AuthResponse": {
-            "type": "object",
-            "properties": {
-                "token": {
-                    "type": "string",
-                    "example": "jwt-token-123"
-                },
-                "user": {
-                    "$ref": "#/definitions/internal_handlers.UserResponse"
-                }
-            }
         },
-        "internal_handlers.CompleteMultipartUploadRequest": {
-            "type": "object",
-            "required": [
-                "parts"
-            ],
-            "properties": {
-                "parts": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/internal_handlers.PartInfo"
+        "/api/files/multipart": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the authenticated user's multipart uploads that have been initiated but not completed or aborted, newest first.\nUse it to find an upload_id again after the client has lost it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "List multipart uploads in progress",
+                "responses": {
+                    "200": {
+                        "description": "Uploads in progress",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ListMultipartUploadsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "internal_handlers.DeleteFileResponse": {
-            "type": "object",
-            "properties": {
-                "success": {
-                    "type": "boolean",
-                    "example": true
-                }
-            }
-        },
-        "internal_handlers.DeleteUserRequest": {
-            "type": "object",
+        "/api/files/multipart/initiate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Start a new multipart upload session for large files. The whole file counts against the storage quota from the start.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Initiate a multipart upload",
+                "parameters": [
+                    {
+                        "description": "Upload initiation request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.InitiateMultipartUploadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Upload session created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.InitiateMultipartUploadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "File exceeds the storage quota",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "File quota reached",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/files/multipart/{upload_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Show a multipart upload in progress with the parts received so far, their ETags and sizes, ordered by part number.\nA client can resume by uploading only the missing parts, then complete with the ETags listed here.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "List the parts of a multipart upload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Upload session ID",
+                        "name": "upload_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Upload and its parts",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.MultipartUploadPartsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Upload session not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cancel an in-progress multipart upload and clean up resources",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Abort a multipart upload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Upload session ID",
+                        "name": "upload_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Upload aborted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.AbortMultipartUploadResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/files/multipart/{upload_id}/complete": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Finalize a multipart upload by providing all part ETags.\nWith parts_sha256 (the SHA-256 of each part's raw SHA-256 digest joined in part order), the parts are checked before they are joined; on a mismatch the upload stays open so parts can be sent again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Complete a multipart upload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Upload session ID",
+                        "name": "upload_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Parts to complete",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.CompleteMultipartUploadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "File uploaded successfully",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.FileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or checksum mismatch",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Upload session not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/files/multipart/{upload_id}/part/{part_number}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload a single chunk of a file as part of a multipart upload",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Upload a part of a multipart upload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Upload session ID",
+                        "name": "upload_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Part number",
+                        "name": "part_number",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File chunk to upload",
+                        "name": "chunk",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hex SHA-256 of the chunk",
+                        "name": "X-Checksum-SHA256",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Part uploaded successfully",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.UploadPartResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid part number, missing chunk or checksum mismatch",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - retry after the Retry-After header",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/files/shared-with-me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List files other users have shared with the authenticated user, with the permission each share grants",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "List files shared with me",
+                "responses": {
+                    "200": {
+                        "description": "Shared files",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ListSharedFilesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/files/tags": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List every tag on the authenticated user's files with the number of files carrying it, sorted by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "List tags",
+                "responses": {
+                    "200": {
+                        "description": "Tags",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ListTagsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/files/tus": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Start a resumable upload of Upload-Length bytes, then send them with PATCH to the returned Location.\nUpload-Metadata must carry filename (or name); filetype (or type) and folder_id are optional.",
+                "tags": [
+                    "tus"
+                ],
+                "summary": "Create a tus upload",
+                "parameters": [
+                    {
+                        "enum": [
+                            "1.0.0"
+                        ],
+                        "type": "string",
+                        "description": "Protocol version",
+                        "name": "Tus-Resumable",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Size of the file in bytes",
+                        "name": "Upload-Length",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated key and base64 value pairs",
+                        "name": "Upload-Metadata",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Upload created",
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the new upload"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Missing or invalid Upload-Length or Upload-Metadata",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Unsupported tus version",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Upload exceeds the maximum size of 2GB or the storage quota",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "File quota reached, or too many uploads in progress",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "options": {
+                "description": "Report the tus version, extensions, checksum algorithms and maximum upload size this server supports.",
+                "tags": [
+                    "tus"
+                ],
+                "summary": "Describe the tus server",
+                "responses": {
+                    "204": {
+                        "description": "Server capabilities in the Tus-* headers",
+                        "headers": {
+                            "Tus-Checksum-Algorithm": {
+                                "type": "string",
+                                "description": "Supported checksum algorithms"
+                            },
+                            "Tus-Extension": {
+                                "type": "string",
+                                "description": "Supported extensions"
+                            },
+                            "Tus-Max-Size": {
+                                "type": "integer",
+                                "description": "Largest upload accepted, in bytes"
+                            },
+                            "Tus-Version": {
+                                "type": "string",
+                                "description": "Supported protocol versions"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/files/tus/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Abandon an upload and discard the bytes received. A completed upload's file is kept.",
+                "tags": [
+                    "tus"
+                ],
+                "summary": "Terminate a tus upload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "1.0.0"
+                        ],
+                        "type": "string",
+                        "description": "Protocol version",
+                        "name": "Tus-Resumable",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Upload terminated"
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Upload not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Another request is writing to the upload",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Unsupported tus version",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "head": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Report how many bytes of the upload have been received, so an interrupted upload can resume from there.",
+                "tags": [
+                    "tus"
+                ],
+                "summary": "Get a tus upload's offset",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "1.0.0"
+                        ],
+                        "type": "string",
+                        "description": "Protocol version",
+                        "name": "Tus-Resumable",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Upload state in the Upload-* headers",
+                        "headers": {
+                            "Content-Location": {
+                                "type": "string",
+                                "description": "The stored file, once the upload is complete"
+                            },
+                            "Upload-Length": {
+                                "type": "integer",
+                                "description": "Size of the file in bytes"
+                            },
+                            "Upload-Offset": {
+                                "type": "integer",
+                                "description": "Bytes received"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Upload not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Unsupported tus version",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Send the next bytes of the upload, starting at Upload-Offset. The upload is stored as a file once its last byte arrives.\nWith Upload-Checksum, the bytes are kept only if they match it.",
+                "consumes": [
+                    "application/offset+octet-stream"
+                ],
+                "tags": [
+                    "tus"
+                ],
+                "summary": "Append to a tus upload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "1.0.0"
+                        ],
+                        "type": "string",
+                        "description": "Protocol version",
+                        "name": "Tus-Resumable",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset of the first byte sent",
+                        "name": "Upload-Offset",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Checksum algorithm and base64 digest of the body",
+                        "name": "Upload-Checksum",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Bytes received",
+                        "headers": {
+                            "Content-Location": {
+                                "type": "string",
+                                "description": "The stored file, once the upload is complete"
+                            },
+                            "Upload-Offset": {
+                                "type": "integer",
+                                "description": "Bytes received in total"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid Upload-Offset or Upload-Checksum",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Upload not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Upload-Offset doesn't match, or another request is writing to the upload",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Unsupported tus version",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Body runs past Upload-Length",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Content-Type is not application/offset+octet-stream",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "460": {
+                        "description": "Body doesn't match Upload-Checksum",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/files/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve metadata for a specific file by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Get file metadata",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "File metadata retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.GetFileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid file ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "File not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a file by ID (must be owned by the authenticated user)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Delete a file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "File deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.DeleteFileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid file ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "File not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "head": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the headers a download of the file would carry, without the contents.\nSupports If-None-Match and If-Modified-Since like the download endpoint.",
+                "tags": [
+                    "files"
+                ],
+                "summary": "Get download headers for a file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "File exists"
+                    },
+                    "304": {
+                        "description": "File not modified"
+                    },
+                    "400": {
+                        "description": "Invalid file ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "File not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Rename a file, change its content type, move it to another folder or replace its tags and metadata. Only the fields present\nin the body are changed; a folder_id of root moves the file to the top level, and an empty tags list or metadata object clears them. on_conflict decides what happens when the folder already holds\na file with the new name: fail (the default), rename to a free \"name (n)\" or replace the other file.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Update file metadata",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.UpdateFileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "File updated",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.FileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filename, content type, folder, tags or metadata, or nothing to update",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "File or folder not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A file with that name already exists in the folder",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/files/{id}/download": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download a file by ID (must be owned by the authenticated user or shared with them for download)\nSend a single Range to download part of the file, optionally guarded by If-Range.\nResponses carry ETag and Last-Modified; If-None-Match and If-Modified-Since return 304 when the file is unchanged.\nFiles with a recorded SHA-256 also carry it as a Digest header, and it serves as their ETag.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Download a file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "File content",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "206": {
+                        "description": "Requested byte range",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "304": {
+                        "description": "File not modified"
+                    },
+                    "400": {
+                        "description": "Invalid file ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "File is shared for viewing only",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "File not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "416": {
+                        "description": "Range not satisfiable",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/files/{id}/links": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the file's links that have not expired, been revoked or used up their downloads",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "List download links",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Active links",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ListDownloadLinksResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Download links are not configured",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mint a signed URL that downloads the file without a bearer token.\nLinks expire after expires_in seconds (default 1 day, at most 30 days); max_downloads of 0 allows any number of downloads.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Create a download link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Link options",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.CreateDownloadLinkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Link created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.DownloadLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "File not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Download links are not configured",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/files/{id}/links/{link_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stop a link from working before it expires",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Revoke a download link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link ID",
+                        "name": "link_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Link revoked",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.RevokeDownloadLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid link ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Link not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Download links are not configured",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/files/{id}/shares": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the users a file is shared with",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "List a file's shares",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Shares",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ListSharesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Share a file with another user. \"view\" lets them see the file's metadata; \"download\" also lets them download it.\nSharing again with the same user replaces the permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Share a file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Recipient and permission",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ShareFileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "File shared",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ShareResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or sharing with yourself",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "File or user not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/files/{id}/shares/{share_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stop sharing a file with a user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Revoke a share",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Share ID",
+                        "name": "share_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Share revoked",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.RevokeShareResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid share ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Share not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/files/{id}/tags": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add tags to a file. Tags it already has are left alone; a file can carry at most 20.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Tag a file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tags to add",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.FileTagsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "File with its new tags",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.FileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid tag, or too many tags",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "File not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/files/{id}/tags/{tag}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a tag from a file. Removing a tag the file doesn't have is not an error.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Untag a file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "File with its remaining tags",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.FileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid file ID or tag",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "File not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/folders": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a folder at the top level or inside another folder. Names must be unique within their parent.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "folders"
+                ],
+                "summary": "Create a folder",
+                "parameters": [
+                    {
+                        "description": "Folder name and parent",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.CreateFolderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Folder created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.FolderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid name or parent ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Parent folder not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A folder with that name already exists in the parent",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/folders/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a folder together with all of its subfolders and every file in them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "folders"
+                ],
+                "summary": "Delete a folder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Folder ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Folder deleted",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.DeleteFolderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid folder ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Folder not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "folders"
+                ],
+                "summary": "Rename a folder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Folder ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New name",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.RenameFolderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Folder renamed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.FolderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid name or folder ID",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Folder not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A folder with that name already exists in the parent",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/folders/{id}/move": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move a folder, with everything in it, under another folder. An empty parent_id or root moves it to the top level.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "folders"
+                ],
+                "summary": "Move a folder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Folder ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New parent",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.MoveFolderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Folder moved",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.FolderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid folder ID, or moving a folder into itself",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Folder not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A folder with that name already exists in the new parent",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/login": {
+            "post": {
+                "description": "Authenticate a user and receive a JWT token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "User login",
+                "parameters": [
+                    {
+                        "description": "User credentials",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Login successful",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.AuthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid credentials",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests - retry after the Retry-After header",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/me/usage": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Show the authenticated user's file count and bytes stored against their quota. A limit of 0 is unlimited.\nMultipart uploads still in progress count against the quota and are reported as pending.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Get storage usage",
+                "responses": {
+                    "200": {
+                        "description": "Usage",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.UsageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/d/{token}": {
+            "get": {
+                "description": "Public endpoint that downloads the file a signed link points to. No bearer token is needed.\nRange and conditional requests work as on the authenticated download endpoint; each download counts towards the link's limit.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Download a file through a link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Link token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "File content",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "206": {
+                        "description": "Requested byte range",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "304": {
+                        "description": "File not modified"
+                    },
+                    "404": {
+                        "description": "Link is invalid, expired, revoked or used up",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "416": {
+                        "description": "Range not satisfiable",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Download links are not configured",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "internal_handlers.AbortMultipartUploadResponse": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers.AdminActionResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "list_files",
+                        "view_file",
+                        "download_file",
+                        "delete_file"
+                    ],
+                    "example": "delete_file"
+                },
+                "admin_id": {
+                    "type": "string",
+                    "example": "69654eb7a1135a809430d0b1"
+                },
+                "admin_username": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "created_at": {
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "file_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439011"
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "document.pdf"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "65a1b2c3d4e5f60718293a4f"
+                },
+                "owner_id": {
+                    "type": "string",
+                    "example": "69654eb7a1135a809430d0b7"
+                }
+            }
+        },
+        "internal_handlers.AdminFileMetadata": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string",
+                    "example": "application/pdf"
+                },
+                "created_at": {
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "document.pdf"
+                },
+                "folder_id": {
+                    "type": "string",
+                    "example": "65a1b2c3d4e5f60718293a4d"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439011"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "owner_id": {
+                    "type": "string",
+                    "example": "69654eb7a1135a809430d0b7"
+                },
+                "parts_sha256": {
+                    "type": "string",
+                    "example": "5d41402abc4b2a76b9719d911017c592b94d27b9934d3e08a52e52d7da7dabfa"
+                },
+                "sha256": {
+                    "type": "string",
+                    "example": "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 1024000
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "work",
+                        "q3"
+                    ]
+                }
+            }
+        },
+        "internal_handlers.AdminFileResponse": {
+            "type": "object",
+            "properties": {
+                "file": {
+                    "$ref": "#/definitions/internal_handlers.AdminFileMetadata"
+                }
+            }
+        },
+        "internal_handlers.AdminListFilesResponse": {
+            "type": "object",
+            "properties": {
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handlers.AdminFileMetadata"
+                    }
+                },
+                "next_page_token": {
+                    "type": "string"
+                },
+                "prev_page_token": {
+                    "type": "string"
+                },
+                "total_count": {
+                    "type": "integer",
+                    "example": 137
+                }
+            }
+        },
+        "internal_handlers.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "example": "jwt-token-123"
+                },
+                "user": {
+                    "$ref": "#/definitions/internal_handlers.UserResponse"
+                }
+            }
+        },
+        "internal_handlers.CompleteMultipartUploadRequest": {
+            "type": "object",
+            "required": [
+                "parts"
+            ],
+            "properties": {
+                "parts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handlers.PartInfo"
+                    }
+                },
+                "parts_sha256": {
+                    "type": "string",
+                    "example": "5d41402abc4b2a76b9719d911017c592b94d27b9934d3e08a52e52d7da7dabfa"
+                }
+            }
+        },
+        "internal_handlers.CreateDownloadLinkRequest": {
+            "type": "object",
+            "properties": {
+                "expires_in": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 86400
+                },
+                "max_downloads": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 5
+                }
+            }
+        },
+        "internal_handlers.CreateFolderRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Reports"
+                },
+                "parent_id": {
+                    "type": "string",
+                    "example": ""
+                }
+            }
+        },
+        "internal_handlers.DeleteFileResponse": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers.DeleteFolderResponse": {
+            "type": "object",
+            "properties": {
+                "files_deleted": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "folders_deleted": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers.DeleteUserRequest": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "69654eb7a1135a809430d0b7"
+                }
+            }
+        },
+        "internal_handlers.DeleteUserResponse": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers.DownloadLinkResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "download_count": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "expires_at": {
+                    "type": "integer",
+                    "example": 1704153600
+                },
+                "id": {
+                    "type": "string",
+                    "example": "65a1b2c3d4e5f60718293a4b"
+                },
+                "max_downloads": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://files.example.com/d/65a1b2c3d4e5f60718293a4b.1704153600.c2lnbmF0dXJl"
+                }
+            }
+        },
+        "internal_handlers.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "error message"
+                }
+            }
+        },
+        "internal_handlers.FileMetadata": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string",
+                    "example": "application/pdf"
+                },
+                "created_at": {
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "document.pdf"
+                },
+                "folder_id": {
+                    "type": "string",
+                    "example": "65a1b2c3d4e5f60718293a4d"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439011"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "parts_sha256": {
+                    "type": "string",
+                    "example": "5d41402abc4b2a76b9719d911017c592b94d27b9934d3e08a52e52d7da7dabfa"
+                },
+                "sha256": {
+                    "type": "string",
+                    "example": "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 1024000
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "work",
+                        "q3"
+                    ]
+                }
+            }
+        },
+        "internal_handlers.FileResponse": {
+            "type": "object",
+            "properties": {
+                "file": {
+                    "$ref": "#/definitions/internal_handlers.FileMetadata"
+                }
+            }
+        },
+        "internal_handlers.FileTagsRequest": {
+            "type": "object",
+            "required": [
+                "tags"
+            ],
+            "properties": {
+                "tags": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "work",
+                        "q3"
+                    ]
+                }
+            }
+        },
+        "internal_handlers.FolderResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "id": {
+                    "type": "string",
+                    "example": "65a1b2c3d4e5f60718293a4d"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Reports"
+                },
+                "parent_id": {
+                    "type": "string",
+                    "example": ""
+                }
+            }
+        },
+        "internal_handlers.GetFileResponse": {
+            "type": "object",
+            "properties": {
+                "file": {
+                    "$ref": "#/definitions/internal_handlers.FileMetadata"
+                }
+            }
+        },
+        "internal_handlers.InitiateMultipartUploadRequest": {
+            "type": "object",
+            "required": [
+                "filename",
+                "total_size"
+            ],
+            "properties": {
+                "content_type": {
+                    "type": "string",
+                    "example": "video/mp4"
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "large-video.mp4"
+                },
+                "folder_id": {
+                    "type": "string",
+                    "example": "65a1b2c3d4e5f60718293a4d"
+                },
+                "total_size": {
+                    "type": "integer",
+                    "example": 1073741824
+                }
+            }
+        },
+        "internal_handlers.InitiateMultipartUploadResponse": {
+            "type": "object",
+            "properties": {
+                "chunk_size": {
+                    "type": "integer",
+                    "example": 10485760
+                },
+                "total_parts": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "upload_id": {
+                    "type": "string",
+                    "example": "abc123"
+                }
+            }
+        },
+        "internal_handlers.ListAdminActionsResponse": {
+            "type": "object",
+            "properties": {
+                "actions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handlers.AdminActionResponse"
+                    }
+                }
+            }
+        },
+        "internal_handlers.ListDownloadLinksResponse": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handlers.DownloadLinkResponse"
+                    }
+                }
+            }
+        },
+        "internal_handlers.ListFilesResponse": {
+            "type": "object",
+            "properties": {
+                "breadcrumbs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handlers.FolderResponse"
+                    }
+                },
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handlers.FileMetadata"
+                    }
+                },
+                "folders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handlers.FolderResponse"
+                    }
+                },
+                "next_page_token": {
+                    "type": "string",
+                    "example": "NTA6YWJjZGVmMDEyMzQ1Njc4OQ"
+                },
+                "prev_page_token": {
+                    "type": "string"
+                },
+                "total_count": {
+                    "type": "integer",
+                    "example": 137
+                }
+            }
+        },
+        "internal_handlers.ListMultipartUploadsResponse": {
+            "type": "object",
+            "properties": {
+                "uploads": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handlers.MultipartUploadResponse"
+                    }
+                }
+            }
+        },
+        "internal_handlers.ListSharedFilesResponse": {
+            "type": "object",
+            "properties": {
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handlers.SharedFileMetadata"
+                    }
+                }
+            }
+        },
+        "internal_handlers.ListSharesResponse": {
+            "type": "object",
+            "properties": {
+                "shares": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handlers.ShareResponse"
+                    }
+                }
+            }
+        },
+        "internal_handlers.ListTagsResponse": {
+            "type": "object",
+            "properties": {
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handlers.TagResponse"
+                    }
+                }
+            }
+        },
+        "internal_handlers.LoginRequest": {
+            "type": "object",
             "required": [
-                "id"
+                "password",
+                "username"
             ],
             "properties": {
-                "id": {
+                "password": {
                     "type": "string",
-                    "example": "69654eb7a1135a809430d0b7"
+                    "example": "password123"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "testing"
                 }
             }
         },
-        "internal_handlers.DeleteUserResponse": {
+        "internal_handlers.MoveFolderRequest": {
             "type": "object",
             "properties": {
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                "parent_id": {
+                    "type": "string",
+                    "example": "65a1b2c3d4e5f60718293a4e"
                 }
             }
         },
-        "internal_handlers.ErrorResponse": {
+        "internal_handlers.MultipartUploadPartsResponse": {
             "type": "object",
             "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "error message"
+                "parts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_handlers.UploadedPartResponse"
+                    }
+                },
+                "upload": {
+                    "$ref": "#/definitions/internal_handlers.MultipartUploadResponse"
                 }
             }
         },
-        "internal_handlers.FileMetadata": {
+        "internal_handlers.MultipartUploadResponse": {
             "type": "object",
             "properties": {
+                "chunk_size": {
+                    "type": "integer",
+                    "example": 10485760
+                },
                 "content_type": {
                     "type": "string",
-                    "example": "application/pdf"
+                    "example": "video/mp4"
                 },
                 "created_at": {
                     "type": "integer",
                     "example": 1704067200
                 },
+                "expires_at": {
+                    "type": "integer",
+                    "example": 1704672000
+                },
                 "filename": {
                     "type": "string",
-                    "example": "document.pdf"
+                    "example": "large-video.mp4"
                 },
-                "id": {
+                "folder_id": {
                     "type": "string",
-                    "example": "507f1f77bcf86cd799439011"
+                    "example": "65a1b2c3d4e5f60718293a4d"
                 },
-                "size": {
+                "parts_uploaded": {
                     "type": "integer",
-                    "example": 1024000
+                    "example": 40
+                },
+                "total_parts": {
+                    "type": "integer",
+                    "example": 103
+                },
+                "total_size": {
+                    "type": "integer",
+                    "example": 1073741824
+                },
+                "upload_id": {
+                    "type": "string",
+                    "example": "abc123"
+                },
+                "uploaded_bytes": {
+                    "type": "integer",
+                    "example": 419430400
                 }
             }
         },
-        "internal_handlers.FileResponse": {
+        "internal_handlers.PartInfo": {
             "type": "object",
             "properties": {
-                "file": {
-                    "$ref": "#/definitions/internal_handlers.FileMetadata"
+                "etag": {
+                    "type": "string",
+                    "example": "\"etag1\""
+                },
+                "part_number": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "internal_handlers.GetFileResponse": {
+        "internal_handlers.QuotaLimits": {
             "type": "object",
             "properties": {
-                "file": {
-                    "$ref": "#/definitions/internal_handlers.FileMetadata"
+                "max_bytes": {
+                    "type": "integer",
+                    "example": 10737418240
+                },
+                "max_files": {
+                    "type": "integer",
+                    "example": 20
                 }
             }
         },
-        "internal_handlers.InitiateMultipartUploadRequest": {
+        "internal_handlers.QuotaResponse": {
+            "type": "object",
+            "properties": {
+                "custom": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "quota": {
+                    "$ref": "#/definitions/internal_handlers.QuotaLimits"
+                },
+                "role_default": {
+                    "$ref": "#/definitions/internal_handlers.QuotaLimits"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "69654eb7a1135a809430d0b7"
+                }
+            }
+        },
+        "internal_handlers.RenameFolderRequest": {
             "type": "object",
             "required": [
-                "filename",
-                "total_size"
+                "name"
             ],
             "properties": {
-                "content_type": {
+                "name": {
                     "type": "string",
-                    "example": "video/mp4"
+                    "example": "Archive"
+                }
+            }
+        },
+        "internal_handlers.RevokeDownloadLinkResponse": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers.RevokeShareResponse": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_handlers.SetQuotaRequest": {
+            "type": "object",
+            "properties": {
+                "max_bytes": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 21474836480
                 },
-                "filename": {
+                "max_files": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 50
+                }
+            }
+        },
+        "internal_handlers.ShareFileRequest": {
+            "type": "object",
+            "required": [
+                "permission",
+                "username"
+            ],
+            "properties": {
+                "permission": {
                     "type": "string",
-                    "example": "large-video.mp4"
+                    "enum": [
+                        "view",
+                        "download"
+                    ],
+                    "example": "download"
                 },
-                "total_size": {
-                    "type": "integer",
-                    "example": 1073741824
+                "username": {
+                    "type": "string",
+                    "example": "alice"
                 }
             }
         },
-        "internal_handlers.InitiateMultipartUploadResponse": {
+        "internal_handlers.ShareResponse": {
             "type": "object",
             "properties": {
-                "chunk_size": {
+                "created_at": {
                     "type": "integer",
-                    "example": 10485760
+                    "example": 1704067200
                 },
-                "total_parts": {
-                    "type": "integer",
-                    "example": 10
+                "id": {
+                    "type": "string",
+                    "example": "65a1b2c3d4e5f60718293a4c"
                 },
-                "upload_id": {
+                "permission": {
                     "type": "string",
-                    "example": "abc123"
+                    "enum": [
+                        "view",
+                        "download"
+                    ],
+                    "example": "download"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "69654eb7a1135a809430d0b7"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "alice"
                 }
             }
         },
-        "internal_handlers.ListFilesResponse": {
+        "internal_handlers.SharedFileMetadata": {
             "type": "object",
             "properties": {
-                "files": {
+                "content_type": {
+                    "type": "string",
+                    "example": "application/pdf"
+                },
+                "created_at": {
+                    "type": "integer",
+                    "example": 1704067200
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "document.pdf"
+                },
+                "folder_id": {
+                    "type": "string",
+                    "example": "65a1b2c3d4e5f60718293a4d"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439011"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "owner_id": {
+                    "type": "string",
+                    "example": "69654eb7a1135a809430d0b7"
+                },
+                "parts_sha256": {
+                    "type": "string",
+                    "example": "5d41402abc4b2a76b9719d911017c592b94d27b9934d3e08a52e52d7da7dabfa"
+                },
+                "permission": {
+                    "type": "string",
+                    "enum": [
+                        "view",
+                        "download"
+                    ],
+                    "example": "view"
+                },
+                "sha256": {
+                    "type": "string",
+                    "example": "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 1024000
+                },
+                "tags": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/internal_handlers.FileMetadata"
-                    }
+                        "type": "string"
+                    },
+                    "example": [
+                        "work",
+                        "q3"
+                    ]
                 }
             }
         },
-        "internal_handlers.LoginRequest": {
+        "internal_handlers.SignUpRequest": {
             "type": "object",
             "required": [
                 "password",
@@ -959,7 +3887,62 @@ const docTemplate = `{
                 }
             }
         },
-        "internal_handlers.PartInfo": {
+        "internal_handlers.TagResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "name": {
+                    "type": "string",
+                    "example": "work"
+                }
+            }
+        },
+        "internal_handlers.UpdateFileRequest": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string",
+                    "example": "application/pdf"
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "final-report.pdf"
+                },
+                "folder_id": {
+                    "type": "string",
+                    "example": "65a1b2c3d4e5f60718293a4d"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "on_conflict": {
+                    "type": "string",
+                    "enum": [
+                        "fail",
+                        "rename",
+                        "replace"
+                    ],
+                    "example": "rename"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "work",
+                        "q3"
+                    ]
+                }
+            }
+        },
+        "internal_handlers.UploadPartResponse": {
             "type": "object",
             "properties": {
                 "etag": {
@@ -969,36 +3952,60 @@ const docTemplate = `{
                 "part_number": {
                     "type": "integer",
                     "example": 1
+                },
+                "sha256": {
+                    "type": "string",
+                    "example": "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
                 }
             }
         },
-        "internal_handlers.SignUpRequest": {
+        "internal_handlers.UploadedPartResponse": {
             "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
             "properties": {
-                "password": {
+                "etag": {
                     "type": "string",
-                    "example": "password123"
+                    "example": "\"etag1\""
                 },
-                "username": {
+                "part_number": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "sha256": {
                     "type": "string",
-                    "example": "testing"
+                    "example": "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 10485760
                 }
             }
         },
-        "internal_handlers.UploadPartResponse": {
+        "internal_handlers.UsageResponse": {
             "type": "object",
             "properties": {
-                "etag": {
-                    "type": "string",
-                    "example": "\"etag1\""
+                "file_count": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "part_number": {
+                "max_bytes": {
+                    "type": "integer",
+                    "example": 10737418240
+                },
+                "max_files": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "pending_bytes": {
+                    "type": "integer",
+                    "example": 104857600
+                },
+                "pending_uploads": {
                     "type": "integer",
                     "example": 1
+                },
+                "total_bytes": {
+                    "type": "integer",
+                    "example": 524288000
                 }
             }
         },
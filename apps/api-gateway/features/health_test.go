@@ -17,6 +17,7 @@ import (
 	authv1 "github.com/provsalt/DOP_P01_Team1/common/auth/v1"
 	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
 	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+	"google.golang.org/grpc"
 )
 
 type healthTestContext struct {
@@ -94,6 +95,12 @@ func (m *mockUserClient) GetUser(_ context.Context, _ *userv1.GetUserRequest) (*
 	}, nil
 }
 
+func (m *mockUserClient) GetUserByUsername(_ context.Context, req *userv1.GetUserByUsernameRequest) (*userv1.GetUserByUsernameResponse, error) {
+	return &userv1.GetUserByUsernameResponse{
+		User: &userv1.User{Id: "u123", Username: req.Username, Role: userv1.Role_ROLE_USER},
+	}, nil
+}
+
 func (m *mockUserClient) DeleteAccount(_ context.Context, _ *userv1.DeleteUserByIdRequest) (*userv1.DeleteUserByIdResponse, error) {
 	return &userv1.DeleteUserByIdResponse{Success: true}, nil
 }
@@ -107,6 +114,14 @@ func (m *mockUserClient) ListUsers(_ context.Context, req *userv1.ListUsersReque
 	}, nil
 }
 
+func (m *mockUserClient) GetQuota(_ context.Context, req *userv1.GetQuotaRequest) (*userv1.GetQuotaResponse, error) {
+	return &userv1.GetQuotaResponse{Quota: &userv1.Quota{}, RoleDefault: &userv1.Quota{}}, nil
+}
+
+func (m *mockUserClient) SetQuota(_ context.Context, req *userv1.SetQuotaRequest) (*userv1.GetQuotaResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 func (m *mockUserClient) Close() error {
 	return nil
 }
@@ -125,6 +140,30 @@ func (m *mockFileClient) DeleteFile(ctx context.Context, req *filev1.DeleteFileR
 	return &filev1.DeleteFileResponse{Success: false}, nil
 }
 
+func (m *mockFileClient) UpdateFile(ctx context.Context, req *filev1.UpdateFileRequest) (*filev1.FileResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockFileClient) AddFileTags(ctx context.Context, req *filev1.FileTagsRequest) (*filev1.FileResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockFileClient) RemoveFileTags(ctx context.Context, req *filev1.FileTagsRequest) (*filev1.FileResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockFileClient) ListTags(ctx context.Context, req *filev1.ListTagsRequest) (*filev1.ListTagsResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockFileClient) ListAdminActions(ctx context.Context, req *filev1.ListAdminActionsRequest) (*filev1.ListAdminActionsResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockFileClient) GetUsage(ctx context.Context, req *filev1.GetUsageRequest) (*filev1.GetUsageResponse, error) {
+	return &filev1.GetUsageResponse{}, nil
+}
+
 func (m *mockFileClient) UploadFile(ctx context.Context) (filev1.FileService_UploadFileClient, error) {
 	return nil, fmt.Errorf("not implemented")
 }
@@ -141,11 +180,8 @@ func (m *mockFileClient) InitiateMultipartUpload(ctx context.Context, req *filev
 	}, nil
 }
 
-func (m *mockFileClient) UploadPart(ctx context.Context, req *filev1.UploadPartRequest) (*filev1.UploadPartResponse, error) {
-	return &filev1.UploadPartResponse{
-		Etag:       fmt.Sprintf("\"etag%d\"", req.PartNumber),
-		PartNumber: req.PartNumber,
-	}, nil
+func (m *mockFileClient) UploadPartStream(ctx context.Context) (filev1.FileService_UploadPartStreamClient, error) {
+	return &mockUploadPartStream{}, nil
 }
 
 func (m *mockFileClient) CompleteMultipartUpload(ctx context.Context, req *filev1.CompleteMultipartUploadRequest) (*filev1.FileResponse, error) {
@@ -164,10 +200,81 @@ func (m *mockFileClient) AbortMultipartUpload(ctx context.Context, req *filev1.A
 	return &filev1.AbortMultipartUploadResponse{Success: true}, nil
 }
 
+func (m *mockFileClient) ListMultipartUploads(ctx context.Context, req *filev1.ListMultipartUploadsRequest) (*filev1.ListMultipartUploadsResponse, error) {
+	return &filev1.ListMultipartUploadsResponse{}, nil
+}
+
+func (m *mockFileClient) ListParts(ctx context.Context, req *filev1.ListPartsRequest) (*filev1.ListPartsResponse, error) {
+	return &filev1.ListPartsResponse{Upload: &filev1.MultipartUpload{UploadId: req.UploadId}}, nil
+}
+
+func (m *mockFileClient) CreateDownloadLink(ctx context.Context, req *filev1.CreateDownloadLinkRequest) (*filev1.DownloadLink, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockFileClient) ListDownloadLinks(ctx context.Context, req *filev1.ListDownloadLinksRequest) (*filev1.ListDownloadLinksResponse, error) {
+	return &filev1.ListDownloadLinksResponse{}, nil
+}
+
+func (m *mockFileClient) RevokeDownloadLink(ctx context.Context, req *filev1.RevokeDownloadLinkRequest) (*filev1.RevokeDownloadLinkResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockFileClient) ShareFile(ctx context.Context, req *filev1.ShareFileRequest) (*filev1.Share, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockFileClient) ListShares(ctx context.Context, req *filev1.ListSharesRequest) (*filev1.ListSharesResponse, error) {
+	return &filev1.ListSharesResponse{}, nil
+}
+
+func (m *mockFileClient) RevokeShare(ctx context.Context, req *filev1.RevokeShareRequest) (*filev1.RevokeShareResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockFileClient) ListSharedWithMe(ctx context.Context, req *filev1.ListSharedWithMeRequest) (*filev1.ListSharedWithMeResponse, error) {
+	return &filev1.ListSharedWithMeResponse{}, nil
+}
+
+func (m *mockFileClient) CreateFolder(ctx context.Context, req *filev1.CreateFolderRequest) (*filev1.Folder, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockFileClient) RenameFolder(ctx context.Context, req *filev1.RenameFolderRequest) (*filev1.Folder, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockFileClient) MoveFolder(ctx context.Context, req *filev1.MoveFolderRequest) (*filev1.Folder, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockFileClient) DeleteFolder(ctx context.Context, req *filev1.DeleteFolderRequest) (*filev1.DeleteFolderResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 func (m *mockFileClient) Close() error {
 	return nil
 }
 
+type mockUploadPartStream struct {
+	grpc.ClientStream
+	partNumber int32
+}
+
+func (m *mockUploadPartStream) Send(req *filev1.UploadPartStreamRequest) error {
+	if md := req.GetMetadata(); md != nil {
+		m.partNumber = md.PartNumber
+	}
+	return nil
+}
+
+func (m *mockUploadPartStream) CloseAndRecv() (*filev1.UploadPartResponse, error) {
+	return &filev1.UploadPartResponse{
+		Etag:       fmt.Sprintf("\"etag%d\"", m.partNumber),
+		PartNumber: m.partNumber,
+	}, nil
+}
+
 func (h *healthTestContext) iSendAGETRequestTo(endpoint string) error {
 	start := time.Now()
 
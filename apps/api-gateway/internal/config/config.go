@@ -1,7 +1,14 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
 	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/provsalt/DOP_P01_Team1/api-gateway/middleware"
+	"github.com/provsalt/DOP_P01_Team1/common/tlsconfig"
 )
 
 type Config struct {
@@ -15,6 +22,47 @@ type Config struct {
 	AxiomMetricsDataset string `env:"AXIOM_METRICS_DATASET" env-default:"metrics"`
 	Environment         string `env:"ENVIRONMENT" env-default:"development"`
 	FrontendURL         string `env:"FRONTEND_URL" env-default:"http://localhost:3000"`
+	// ShutdownTimeout bounds how long in-flight requests may drain after SIGTERM.
+	ShutdownTimeout time.Duration `env:"SHUTDOWN_TIMEOUT" env-default:"30s"`
+	// ShutdownDrainDelay is how long /health reports 503 while new requests
+	// are still accepted, so load balancers can stop routing here first.
+	ShutdownDrainDelay time.Duration `env:"SHUTDOWN_DRAIN_DELAY" env-default:"5s"`
+	// Logging
+	LogLevel  string `env:"LOG_LEVEL" env-default:"info"`
+	LogFormat string `env:"LOG_FORMAT" env-default:"json"`
+	// ServiceAuthSecret signs the service tokens internal services present to
+	// each other. It must match across services.
+	ServiceAuthSecret string `env:"SERVICE_AUTH_SECRET"`
+	// TLS for gRPC. CERT/KEY are this service's identity; CA verifies peers and,
+	// on servers, requires client certificates. Files are reloaded on change.
+	TLSCertFile string `env:"TLS_CERT_FILE"`
+	TLSKeyFile  string `env:"TLS_KEY_FILE"`
+	TLSCAFile   string `env:"TLS_CA_FILE"`
+	TLSDevMode  bool   `env:"TLS_DEV_MODE" env-default:"false"`
+	// Largest gRPC messages, in bytes, the gateway sends to and accepts from
+	// backend services. Uploads and downloads are streamed in 64KB chunks, so
+	// these only need to cover the biggest unary message.
+	GRPCMaxSendMsgSize int `env:"GRPC_MAX_SEND_MSG_SIZE" env-default:"4194304"`
+	GRPCMaxRecvMsgSize int `env:"GRPC_MAX_RECV_MSG_SIZE" env-default:"4194304"`
+	// DownloadLinkSecret signs public download links; leave empty to disable
+	// them. PublicURL is the origin links point at, e.g. https://files.example.com;
+	// when empty it is taken from the request.
+	DownloadLinkSecret string `env:"DOWNLOAD_LINK_SECRET"`
+	PublicURL          string `env:"PUBLIC_URL"`
+	// PrometheusEnabled serves /metrics on the gateway port for scraping.
+	PrometheusEnabled bool `env:"PROMETHEUS_ENABLED" env-default:"false"`
+	// Rate limits as N/unit[,burst] with unit s, m or h; empty disables one.
+	// Login is limited per client IP, the rest per authenticated user.
+	RateLimitLogin  string `env:"RATE_LIMIT_LOGIN" env-default:"10/m"`
+	RateLimitAPI    string `env:"RATE_LIMIT_API" env-default:"300/m"`
+	RateLimitUpload string `env:"RATE_LIMIT_UPLOAD" env-default:"120/m,20"`
+	// TrustedProxies lists the IPs and CIDRs whose X-Forwarded-For header is
+	// believed for the client IP. Empty trusts no proxy, so the client IP,
+	// and with it the login limit, can't be spoofed with that header.
+	TrustedProxies []string `env:"TRUSTED_PROXIES" env-separator:","`
+	// IdempotencyTTL is how long responses to requests with an Idempotency-Key
+	// are kept for replay. Zero disables the middleware.
+	IdempotencyTTL time.Duration `env:"IDEMPOTENCY_TTL" env-default:"24h"`
 }
 
 func Load() (*Config, error) {
@@ -22,5 +70,30 @@ func Load() (*Config, error) {
 	if err := cleanenv.ReadEnv(&cfg); err != nil {
 		return nil, err
 	}
+	for _, rate := range []string{cfg.RateLimitLogin, cfg.RateLimitAPI, cfg.RateLimitUpload} {
+		if _, err := middleware.ParseRate(rate); err != nil {
+			return nil, err
+		}
+	}
+	for _, proxy := range cfg.TrustedProxies {
+		if net.ParseIP(proxy) == nil {
+			if _, _, err := net.ParseCIDR(proxy); err != nil {
+				return nil, fmt.Errorf("TRUSTED_PROXIES: %q is not an IP or CIDR", proxy)
+			}
+		}
+	}
+	if cfg.GRPCMaxSendMsgSize <= 0 || cfg.GRPCMaxRecvMsgSize <= 0 {
+		return nil, errors.New("GRPC_MAX_SEND_MSG_SIZE and GRPC_MAX_RECV_MSG_SIZE must be positive")
+	}
 	return &cfg, nil
 }
+
+// TLS returns the gRPC TLS settings in the form the tlsconfig package expects.
+func (c *Config) TLS() tlsconfig.Config {
+	return tlsconfig.Config{
+		CertFile: c.TLSCertFile,
+		KeyFile:  c.TLSKeyFile,
+		CAFile:   c.TLSCAFile,
+		DevMode:  c.TLSDevMode,
+	}
+}
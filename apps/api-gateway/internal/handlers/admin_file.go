@@ -0,0 +1,250 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AdminFileHandler lets admins reach every user's files. Requests are sent
+// with the admin flag set; the file service checks the caller's role and
+// records each access as an admin action.
+type AdminFileHandler struct {
+	files *FileHandler
+	users UserServiceClient
+}
+
+func NewAdminFileHandler(files *FileHandler, users UserServiceClient) *AdminFileHandler {
+	return &AdminFileHandler{files: files, users: users}
+}
+
+// ListFiles godoc
+// @Summary      List files across users
+// @Description  Admin-only. List every user's files, or one user's with owner (a username) or owner_id.
+// @Description  Takes the same search, sort and paging parameters as GET /api/files, except folder_id.
+// @Tags         admin
+// @Produce      json
+// @Param        owner query string false "Username of the owner"
+// @Param        owner_id query string false "User ID of the owner"
+// @Param        q query string false "Case-insensitive substring of the filename"
+// @Param        tag query []string false "Tag the file must carry" collectionFormat(multi)
+// @Param        type query string false "Content type, or a top-level type such as image"
+// @Param        sort query string false "Sort by name, size or created_at (the default)" Enums(name, size, created_at)
+// @Param        order query string false "Sort direction" Enums(asc, desc)
+// @Param        page_size query int false "Files per page, 1-200 (default 50)"
+// @Param        page_token query string false "Token from a previous page"
+// @Success      200 {object} AdminListFilesResponse "Files"
+// @Header       200 {string} Link "Links to the next and previous pages, when there are any"
+// @Failure      400 {object} ErrorResponse "Invalid filter, sort or page token"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Forbidden - admin role required"
+// @Failure      404 {object} ErrorResponse "Owner not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/admin/files [get]
+func (h *AdminFileHandler) ListFiles(c *gin.Context) {
+	if _, err := h.files.getUserFromContext(c); err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	req, err := listFilesRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req.Admin = true
+	req.OwnerId = c.Query("owner_id")
+	if owner := c.Query("owner"); owner != "" {
+		userResp, err := h.users.GetUserByUsername(c, &userv1.GetUserByUsernameRequest{Username: owner})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+				return
+			}
+			c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+			return
+		}
+		req.OwnerId = userResp.User.Id
+	}
+
+	resp, err := h.files.client.ListFiles(h.files.contextWithAuth(c), req)
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	files := make([]AdminFileMetadata, len(resp.Files))
+	for i, file := range resp.Files {
+		files[i] = adminFileMetadata(file)
+	}
+	if links := pageLinks(c, resp.NextPageToken, resp.PrevPageToken); links != "" {
+		c.Header("Link", links)
+	}
+	c.JSON(http.StatusOK, AdminListFilesResponse{
+		Files:         files,
+		TotalCount:    resp.TotalCount,
+		NextPageToken: resp.NextPageToken,
+		PrevPageToken: resp.PrevPageToken,
+	})
+}
+
+// GetFile godoc
+// @Summary      Get any file's metadata
+// @Description  Admin-only. Retrieve metadata for a file whoever owns it.
+// @Tags         admin
+// @Produce      json
+// @Param        id path string true "File ID"
+// @Success      200 {object} AdminFileResponse "File metadata"
+// @Failure      400 {object} ErrorResponse "Invalid file ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Forbidden - admin role required"
+// @Failure      404 {object} ErrorResponse "File not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/admin/files/{id} [get]
+func (h *AdminFileHandler) GetFile(c *gin.Context) {
+	lookup, ok := h.requestedFile(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.files.client.GetFile(h.files.contextWithAuth(c), lookup)
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, AdminFileResponse{File: adminFileMetadata(resp.File)})
+}
+
+// DownloadFile godoc
+// @Summary      Download any file
+// @Description  Admin-only. Download a file whoever owns it. Range and conditional requests work as on GET /api/files/{id}/download.
+// @Tags         admin
+// @Produce      octet-stream
+// @Param        id path string true "File ID"
+// @Success      200 {file} binary "File content"
+// @Success      206 {file} binary "Requested byte range"
+// @Success      304 "File not modified"
+// @Failure      400 {object} ErrorResponse "Invalid file ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Forbidden - admin role required"
+// @Failure      404 {object} ErrorResponse "File not found"
+// @Failure      416 {object} ErrorResponse "Range not satisfiable"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/admin/files/{id}/download [get]
+func (h *AdminFileHandler) DownloadFile(c *gin.Context) {
+	lookup, ok := h.requestedFile(c)
+	if !ok {
+		return
+	}
+	h.files.serveFile(c, lookup)
+}
+
+// DeleteFile godoc
+// @Summary      Delete any file
+// @Description  Admin-only. Delete a file whoever owns it, along with its download links and shares.
+// @Tags         admin
+// @Produce      json
+// @Param        id path string true "File ID"
+// @Success      200 {object} DeleteFileResponse "File deleted"
+// @Failure      400 {object} ErrorResponse "Invalid file ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Forbidden - admin role required"
+// @Failure      404 {object} ErrorResponse "File not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/admin/files/{id} [delete]
+func (h *AdminFileHandler) DeleteFile(c *gin.Context) {
+	lookup, ok := h.requestedFile(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.files.client.DeleteFile(h.files.contextWithAuth(c), &filev1.DeleteFileRequest{
+		Id:    lookup.Id,
+		Admin: true,
+	})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, DeleteFileResponse{Success: resp.Success})
+}
+
+// ListActions godoc
+// @Summary      List admin file actions
+// @Description  Admin-only. List the recorded admin accesses to users' files, newest first.
+// @Tags         admin
+// @Produce      json
+// @Param        file_id query string false "Only actions on this file"
+// @Param        admin_id query string false "Only actions by this admin"
+// @Param        limit query int false "Number of actions, 1-500 (default 100)"
+// @Success      200 {object} ListAdminActionsResponse "Actions"
+// @Failure      400 {object} ErrorResponse "Invalid limit"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Forbidden - admin role required"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/admin/files/actions [get]
+func (h *AdminFileHandler) ListActions(c *gin.Context) {
+	if _, err := h.files.getUserFromContext(c); err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	req := &filev1.ListAdminActionsRequest{
+		FileId:  c.Query("file_id"),
+		AdminId: c.Query("admin_id"),
+	}
+	if limit, ok := c.GetQuery("limit"); ok {
+		n, err := strconv.ParseInt(limit, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be an integer"})
+			return
+		}
+		req.Limit = int32(n)
+	}
+
+	resp, err := h.files.client.ListAdminActions(h.files.contextWithAuth(c), req)
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	actions := make([]AdminActionResponse, len(resp.Actions))
+	for i, action := range resp.Actions {
+		actions[i] = AdminActionResponse{
+			ID:            action.Id,
+			AdminID:       action.AdminId,
+			AdminUsername: action.AdminUsername,
+			Action:        action.Action,
+			FileID:        action.FileId,
+			OwnerID:       action.OwnerId,
+			Filename:      action.Filename,
+			CreatedAt:     action.CreatedAt,
+		}
+	}
+	c.JSON(http.StatusOK, ListAdminActionsResponse{Actions: actions})
+}
+
+func (h *AdminFileHandler) requestedFile(c *gin.Context) (*filev1.GetFileRequest, bool) {
+	lookup, ok := h.files.requestedFile(c)
+	if !ok {
+		return nil, false
+	}
+	lookup.Admin = true
+	return lookup, true
+}
+
+func adminFileMetadata(file *filev1.File) AdminFileMetadata {
+	return AdminFileMetadata{FileMetadata: fileMetadata(file), OwnerID: file.UserId}
+}
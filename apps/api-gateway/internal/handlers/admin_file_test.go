@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func setupAdminFileTestRouter(h *AdminFileHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("user", &userv1.User{Id: "admin-1", Username: "admin", Role: userv1.Role_ROLE_ADMIN})
+		c.Next()
+	})
+	router.GET("/api/admin/files", h.ListFiles)
+	router.GET("/api/admin/files/actions", h.ListActions)
+	router.GET("/api/admin/files/:id", h.GetFile)
+	router.GET("/api/admin/files/:id/download", h.DownloadFile)
+	router.DELETE("/api/admin/files/:id", h.DeleteFile)
+
+	return router
+}
+
+func TestAdminListFiles_ByOwnerUsername(t *testing.T) {
+	users := &mockUserClient{
+		getUserByUsernameFunc: func(ctx context.Context, req *userv1.GetUserByUsernameRequest) (*userv1.GetUserByUsernameResponse, error) {
+			if req.Username != "alice" {
+				t.Errorf("unexpected username %q", req.Username)
+			}
+			return &userv1.GetUserByUsernameResponse{User: &userv1.User{Id: "user-456", Username: "alice"}}, nil
+		},
+	}
+	files := &mockFileClient{
+		listFilesFunc: func(ctx context.Context, req *filev1.ListFilesRequest) (*filev1.ListFilesResponse, error) {
+			if !req.Admin || req.OwnerId != "user-456" || req.Query != "report" {
+				t.Errorf("unexpected request %v", req)
+			}
+			return &filev1.ListFilesResponse{
+				Files:      []*filev1.File{{Id: "file-1", UserId: "user-456", Filename: "report.pdf"}},
+				TotalCount: 1,
+			}, nil
+		},
+	}
+	router := setupAdminFileTestRouter(NewAdminFileHandler(NewFileHandler(files), users))
+
+	req, _ := http.NewRequest("GET", "/api/admin/files?owner=alice&q=report", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var resp AdminListFilesResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(resp.Files) != 1 || resp.Files[0].OwnerID != "user-456" || resp.TotalCount != 1 {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestAdminListFiles_UnknownOwner(t *testing.T) {
+	users := &mockUserClient{
+		getUserByUsernameFunc: func(ctx context.Context, req *userv1.GetUserByUsernameRequest) (*userv1.GetUserByUsernameResponse, error) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		},
+	}
+	router := setupAdminFileTestRouter(NewAdminFileHandler(NewFileHandler(&mockFileClient{}), users))
+
+	req, _ := http.NewRequest("GET", "/api/admin/files?owner=nobody", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestAdminGetFile_SetsAdminFlag(t *testing.T) {
+	files := &mockFileClient{
+		getFileFunc: func(ctx context.Context, req *filev1.GetFileRequest) (*filev1.FileResponse, error) {
+			if !req.Admin || req.Id != "file-1" {
+				t.Errorf("unexpected request %v", req)
+			}
+			return &filev1.FileResponse{File: &filev1.File{Id: req.Id, UserId: "user-456"}}, nil
+		},
+	}
+	router := setupAdminFileTestRouter(NewAdminFileHandler(NewFileHandler(files), &mockUserClient{}))
+
+	req, _ := http.NewRequest("GET", "/api/admin/files/file-1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	var resp AdminFileResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if resp.File.OwnerID != "user-456" {
+		t.Errorf("expected owner user-456, got %q", resp.File.OwnerID)
+	}
+}
+
+func TestAdminDownloadFile_SetsAdminFlag(t *testing.T) {
+	files := &mockFileClient{
+		getFileFunc: func(ctx context.Context, req *filev1.GetFileRequest) (*filev1.FileResponse, error) {
+			if !req.Admin {
+				t.Errorf("expected an admin lookup, got %v", req)
+			}
+			return stubGetFile(ctx, req)
+		},
+		downloadFileFunc: func(ctx context.Context, req *filev1.DownloadFileRequest) (filev1.FileService_DownloadFileClient, error) {
+			if !req.Admin {
+				t.Error("expected admin download")
+			}
+			sent := 0
+			return &mockDownloadStream{
+				recvFunc: func() (*filev1.DownloadFileResponse, error) {
+					sent++
+					switch sent {
+					case 1:
+						return &filev1.DownloadFileResponse{
+							Data: &filev1.DownloadFileResponse_Metadata{
+								Metadata: &filev1.DownloadFileMetadata{Filename: "test.txt", ContentType: "text/plain", Size: 12},
+							},
+						}, nil
+					case 2:
+						return &filev1.DownloadFileResponse{Data: &filev1.DownloadFileResponse_Chunk{Chunk: []byte("test content")}}, nil
+					}
+					return nil, io.EOF
+				},
+			}, nil
+		},
+	}
+	router := setupAdminFileTestRouter(NewAdminFileHandler(NewFileHandler(files), &mockUserClient{}))
+
+	req, _ := http.NewRequest("GET", "/api/admin/files/file-1/download", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if w.Body.String() != "test content" {
+		t.Errorf("unexpected body %q", w.Body.String())
+	}
+}
+
+func TestAdminDeleteFile_SetsAdminFlag(t *testing.T) {
+	files := &mockFileClient{
+		deleteFileFunc: func(ctx context.Context, req *filev1.DeleteFileRequest) (*filev1.DeleteFileResponse, error) {
+			if !req.Admin || req.Id != "file-1" {
+				t.Errorf("unexpected request %v", req)
+			}
+			return &filev1.DeleteFileResponse{Success: true}, nil
+		},
+	}
+	router := setupAdminFileTestRouter(NewAdminFileHandler(NewFileHandler(files), &mockUserClient{}))
+
+	req, _ := http.NewRequest("DELETE", "/api/admin/files/file-1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestAdminListActions(t *testing.T) {
+	files := &mockFileClient{
+		listAdminActionsFunc: func(ctx context.Context, req *filev1.ListAdminActionsRequest) (*filev1.ListAdminActionsResponse, error) {
+			if req.FileId != "file-1" || req.Limit != 10 {
+				t.Errorf("unexpected request %v", req)
+			}
+			return &filev1.ListAdminActionsResponse{Actions: []*filev1.AdminAction{
+				{Id: "action-1", AdminId: "admin-1", AdminUsername: "admin", Action: "delete_file", FileId: "file-1", CreatedAt: 1704067200},
+			}}, nil
+		},
+	}
+	router := setupAdminFileTestRouter(NewAdminFileHandler(NewFileHandler(files), &mockUserClient{}))
+
+	req, _ := http.NewRequest("GET", "/api/admin/files/actions?file_id=file-1&limit=10", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	var resp ListAdminActionsResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(resp.Actions) != 1 || resp.Actions[0].Action != "delete_file" {
+		t.Errorf("unexpected actions %+v", resp.Actions)
+	}
+}
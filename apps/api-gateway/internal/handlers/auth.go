@@ -21,7 +21,8 @@ func NewAuthHandler(client AuthServiceClient) *AuthHandler {
 
 // SignUp godoc
 // @Summary      Create a new user
-// @Description  Admin-only endpoint to create a new user account
+// @Description  Admin-only endpoint to create a new user account.
+// @Description  Send an Idempotency-Key header to make retries safe: a repeat returns the first response.
 // @Tags         admin
 // @Accept       json
 // @Produce      json
@@ -30,6 +31,8 @@ func NewAuthHandler(client AuthServiceClient) *AuthHandler {
 // @Failure      400 {object} ErrorResponse "Invalid request body"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
 // @Failure      403 {object} ErrorResponse "Forbidden - admin role required"
+// @Failure      409 {object} ErrorResponse "A request with the same Idempotency-Key is still in progress"
+// @Failure      422 {object} ErrorResponse "Idempotency-Key was already used for a different request"
 // @Failure      500 {object} ErrorResponse "Internal server error"
 // @Security     BearerAuth
 // @Router       /api/admin/create_user [post]
@@ -88,6 +91,7 @@ func (h *AuthHandler) SignUp(c *gin.Context) {
 // @Success      200 {object} AuthResponse "Login successful"
 // @Failure      400 {object} ErrorResponse "Invalid request body"
 // @Failure      401 {object} ErrorResponse "Invalid credentials"
+// @Failure      429 {object} ErrorResponse "Too many requests - retry after the Retry-After header"
 // @Failure      500 {object} ErrorResponse "Server error"
 // @Router       /api/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
@@ -21,11 +21,11 @@ type grpcAuthClient struct {
 	client authv1.AuthServiceClient
 }
 
-func NewGRPCAuthClient(addr string) (AuthServiceClient, error) {
-	conn, err := grpc.NewClient(addr,
+func NewGRPCAuthClient(addr string, opts ...grpc.DialOption) (AuthServiceClient, error) {
+	conn, err := grpc.NewClient(addr, append([]grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
-	)
+	}, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ChecksumHeader carries the hex SHA-256 of a file or part being uploaded.
+// Uploads that don't match it are rejected.
+const ChecksumHeader = "X-Checksum-SHA256"
+
+const (
+	errChecksumMismatch = "upload does not match " + ChecksumHeader
+	errStoredMismatch   = "stored data does not match the upload"
+)
+
+var errInvalidChecksum = errors.New(ChecksumHeader + " must be a hex SHA-256 digest")
+
+// expectedChecksum returns the request's ChecksumHeader in lower case, or ""
+// when it wasn't sent.
+func expectedChecksum(c *gin.Context) (string, error) {
+	checksum := strings.ToLower(strings.TrimSpace(c.GetHeader(ChecksumHeader)))
+	if checksum == "" {
+		return "", nil
+	}
+	if !isSHA256(checksum) {
+		return "", errInvalidChecksum
+	}
+	return checksum, nil
+}
+
+func isSHA256(checksum string) bool {
+	sum, err := hex.DecodeString(checksum)
+	return err == nil && len(sum) == 32
+}
+
+// digestHeader formats a hex SHA-256 as an RFC 3230 Digest header value.
+func digestHeader(checksum string) string {
+	sum, err := hex.DecodeString(checksum)
+	if err != nil {
+		return ""
+	}
+	return "sha-256=" + base64.StdEncoding.EncodeToString(sum)
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+)
+
+var errRangeNotSatisfiable = errors.New("requested range not satisfiable")
+
+// fileETag identifies the contents of a stored file: its SHA-256, or the
+// digest of its parts for multipart uploads, when the file service recorded
+// one. Files are never rewritten in place, so for older files the ID and
+// creation time are enough.
+func fileETag(file *filev1.File) string {
+	if file.GetSha256() != "" {
+		return fmt.Sprintf("%q", file.GetSha256())
+	}
+	if file.GetPartsSha256() != "" {
+		return fmt.Sprintf("%q", file.GetPartsSha256())
+	}
+	return fmt.Sprintf("%q", fmt.Sprintf("%s-%d", file.GetId(), file.GetCreatedAt()))
+}
+
+func fileLastModified(file *filev1.File) time.Time {
+	return time.Unix(file.GetCreatedAt(), 0).UTC()
+}
+
+// notModified reports whether a GET or HEAD can be answered with 304.
+// If-None-Match takes precedence over If-Modified-Since when both are sent.
+func notModified(r *http.Request, etag string, modified time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		return etagListMatches(inm, etag)
+	}
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" {
+		since, err := http.ParseTime(ims)
+		return err == nil && !modified.Truncate(time.Second).After(since)
+	}
+	return false
+}
+
+// etagListMatches compares etag against an If-None-Match list using weak
+// comparison.
+func etagListMatches(list, etag string) bool {
+	for _, candidate := range strings.Split(list, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// ifRangeMatches reports whether a Range header should be honoured given the
+// request's If-Range validator. Entity tags use strong comparison, so a weak
+// tag never matches; dates must equal the last-modified time exactly.
+func ifRangeMatches(r *http.Request, etag string, modified time.Time) bool {
+	ir := r.Header.Get("If-Range")
+	if ir == "" {
+		return true
+	}
+	if strings.HasPrefix(ir, `"`) || strings.HasPrefix(ir, "W/") {
+		return ir == etag
+	}
+	date, err := http.ParseTime(ir)
+	return err == nil && modified.Truncate(time.Second).Equal(date)
+}
+
+// byteRange is a resolved, satisfiable range within a file.
+type byteRange struct {
+	start  int64
+	length int64
+}
+
+func (br byteRange) contentRange(size int64) string {
+	return fmt.Sprintf("bytes %d-%d/%d", br.start, br.start+br.length-1, size)
+}
+
+// parseRange resolves a Range header against a file of the given size. It
+// reports ok=false when the whole file should be sent instead: no header, a
+// unit other than bytes, a malformed spec, or more than one range, which the
+// gateway doesn't serve as multipart/byteranges. A well-formed range that
+// starts past the end of the file fails with errRangeNotSatisfiable.
+func parseRange(header string, size int64) (br byteRange, ok bool, err error) {
+	spec, found := strings.CutPrefix(header, "bytes=")
+	if !found || strings.Contains(spec, ",") {
+		return byteRange{}, false, nil
+	}
+	first, last, found := strings.Cut(strings.TrimSpace(spec), "-")
+	if !found {
+		return byteRange{}, false, nil
+	}
+
+	if first == "" {
+		// Suffix range: the final N bytes.
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || n < 0 {
+			return byteRange{}, false, nil
+		}
+		if n == 0 || size == 0 {
+			return byteRange{}, false, errRangeNotSatisfiable
+		}
+		n = min(n, size)
+		return byteRange{start: size - n, length: n}, true, nil
+	}
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 {
+		return byteRange{}, false, nil
+	}
+	end := size - 1
+	if last != "" {
+		end, err = strconv.ParseInt(last, 10, 64)
+		if err != nil || end < start {
+			return byteRange{}, false, nil
+		}
+		end = min(end, size-1)
+	}
+	if start >= size {
+		return byteRange{}, false, errRangeNotSatisfiable
+	}
+	return byteRange{start: start, length: end - start + 1}, true, nil
+}
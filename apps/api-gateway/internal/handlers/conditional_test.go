@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		header  string
+		want    byteRange
+		ok      bool
+		wantErr error
+	}{
+		{header: ""},
+		{header: "bytes=0-3", want: byteRange{start: 0, length: 4}, ok: true},
+		{header: "bytes=5-", want: byteRange{start: 5, length: 7}, ok: true},
+		{header: "bytes=10-100", want: byteRange{start: 10, length: 2}, ok: true},
+		{header: "bytes=-4", want: byteRange{start: 8, length: 4}, ok: true},
+		{header: "bytes=-100", want: byteRange{start: 0, length: 12}, ok: true},
+		{header: "bytes=12-", wantErr: errRangeNotSatisfiable},
+		{header: "bytes=-0", wantErr: errRangeNotSatisfiable},
+		{header: "bytes=0-1,4-5"},
+		{header: "bytes=5-2"},
+		{header: "bytes=abc"},
+		{header: "items=0-3"},
+	}
+	for _, tt := range tests {
+		got, ok, err := parseRange(tt.header, 12)
+		if got != tt.want || ok != tt.ok || !errors.Is(err, tt.wantErr) {
+			t.Errorf("parseRange(%q) = %v, %v, %v; want %v, %v, %v", tt.header, got, ok, err, tt.want, tt.ok, tt.wantErr)
+		}
+	}
+}
+
+func TestIfRangeMatches(t *testing.T) {
+	modified := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]bool{
+		"":                              true,
+		`"v1"`:                          true,
+		`W/"v1"`:                        false,
+		`"v2"`:                          false,
+		"Mon, 01 Jan 2024 00:00:00 GMT": true,
+		"Tue, 02 Jan 2024 00:00:00 GMT": false,
+	}
+	for header, want := range tests {
+		r, _ := http.NewRequest("GET", "/", nil)
+		r.Header.Set("If-Range", header)
+		if got := ifRangeMatches(r, `"v1"`, modified); got != want {
+			t.Errorf("ifRangeMatches(%q) = %v, want %v", header, got, want)
+		}
+	}
+}
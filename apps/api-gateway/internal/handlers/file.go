@@ -2,10 +2,19 @@ package handlers
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
@@ -13,14 +22,57 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// FilenameHeader names the file when it is uploaded as a raw request body.
+// Non-ASCII names may be percent-encoded.
+const FilenameHeader = "X-Filename"
+
+// maxFileSize matches the file service's per-file limit.
+const maxFileSize = 2 * 1024 * 1024 * 1024 // 2GB
+
+// multipartOverhead allows for the boundaries and part headers around a file
+// in a multipart body.
+const multipartOverhead = 1 << 20
+
+// maxPartSize matches the part size the file service hands out for multipart
+// uploads.
+const maxPartSize = 10 * 1024 * 1024 // 10MB
+
+const (
+	errFileTooLarge  = "file exceeds the maximum size of 2GB"
+	errChunkTooLarge = "chunk size exceeds maximum of 10MB"
+)
+
+// fileSortFields maps the sort values ListFiles accepts.
+var fileSortFields = map[string]filev1.FileSortField{
+	"name":       filev1.FileSortField_FILE_SORT_FIELD_NAME,
+	"size":       filev1.FileSortField_FILE_SORT_FIELD_SIZE,
+	"created_at": filev1.FileSortField_FILE_SORT_FIELD_CREATED_AT,
+}
+
+// conflictPolicies maps the on_conflict values UpdateFile accepts. An empty
+// value leaves the file service's default, which is to fail.
+var conflictPolicies = map[string]filev1.ConflictPolicy{
+	"fail":    filev1.ConflictPolicy_CONFLICT_POLICY_FAIL,
+	"rename":  filev1.ConflictPolicy_CONFLICT_POLICY_RENAME,
+	"replace": filev1.ConflictPolicy_CONFLICT_POLICY_REPLACE,
+}
+
+var (
+	errFileRequired = errors.New("file is required")
+	errTooLarge     = errors.New("upload exceeds size limit")
+	errSendChunk    = errors.New("failed to send chunk")
 )
 
 type FileHandler struct {
-	client FileServiceClient
+	client  FileServiceClient
+	metrics *fileMetrics
 }
 
 func NewFileHandler(client FileServiceClient) *FileHandler {
-	return &FileHandler{client: client}
+	return &FileHandler{client: client, metrics: newFileMetrics()}
 }
 
 func (h *FileHandler) contextWithAuth(c *gin.Context) context.Context {
@@ -62,6 +114,8 @@ func mapGRPCError(err error) int {
 			return http.StatusConflict
 		case codes.ResourceExhausted:
 			return http.StatusTooManyRequests
+		case codes.OutOfRange:
+			return http.StatusRequestedRangeNotSatisfiable
 		case codes.Unavailable:
 			return http.StatusServiceUnavailable
 		default:
@@ -73,11 +127,29 @@ func mapGRPCError(err error) int {
 
 // ListFiles godoc
 // @Summary      List all files for the authenticated user
-// @Description  Retrieve a list of all files uploaded by the authenticated user
+// @Description  Retrieve a list of all files uploaded by the authenticated user.
+// @Description  Pass folder_id to list a single folder instead: the response then also carries its subfolders and the breadcrumbs from the top level down to it.
+// @Description  The other parameters narrow the files returned; they can be combined, and repeating tag requires every tag given.
+// @Description  Files come a page at a time. The Link header points at the next and previous pages; a page token only works with the filters and sort order it was issued for.
 // @Tags         files
 // @Produce      json
+// @Param        folder_id query string false "Folder to list, or root for the top level"
+// @Param        q query string false "Case-insensitive substring of the filename"
+// @Param        tag query []string false "Tag the file must carry" collectionFormat(multi)
+// @Param        type query string false "Content type, or a top-level type such as image or image/* to match all of them"
+// @Param        min_size query int false "Minimum size in bytes"
+// @Param        max_size query int false "Maximum size in bytes"
+// @Param        created_after query int false "Uploaded at or after this Unix time"
+// @Param        created_before query int false "Uploaded before this Unix time"
+// @Param        sort query string false "Sort by name, size or created_at (the default)" Enums(name, size, created_at)
+// @Param        order query string false "Sort direction" Enums(asc, desc)
+// @Param        page_size query int false "Files per page, 1-200 (default 50)"
+// @Param        page_token query string false "Token from a previous page's next_page_token or prev_page_token"
 // @Success      200 {object} ListFilesResponse "Files retrieved successfully"
+// @Header       200 {string} Link "Links to the next and previous pages, when there are any"
+// @Failure      400 {object} ErrorResponse "Invalid folder ID, tag, numeric filter, sort or page token"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Folder not found"
 // @Failure      500 {object} ErrorResponse "Internal server error"
 // @Security     BearerAuth
 // @Router       /api/files [get]
@@ -88,25 +160,113 @@ func (h *FileHandler) ListFiles(c *gin.Context) {
 		return
 	}
 
+	req, err := listFilesRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	ctx := h.contextWithAuth(c)
-	resp, err := h.client.ListFiles(ctx, &filev1.ListFilesRequest{})
+	resp, err := h.client.ListFiles(ctx, req)
 	if err != nil {
 		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
 		return
 	}
 
-	files := make([]map[string]interface{}, len(resp.Files))
+	files := make([]FileMetadata, len(resp.Files))
 	for i, file := range resp.Files {
-		files[i] = map[string]interface{}{
-			"id":           file.Id,
-			"filename":     file.Filename,
-			"size":         file.Size,
-			"content_type": file.ContentType,
-			"created_at":   file.CreatedAt,
+		files[i] = fileMetadata(file)
+	}
+
+	if links := pageLinks(c, resp.NextPageToken, resp.PrevPageToken); links != "" {
+		c.Header("Link", links)
+	}
+
+	body := gin.H{
+		"files":       files,
+		"total_count": resp.TotalCount,
+	}
+	if resp.NextPageToken != "" {
+		body["next_page_token"] = resp.NextPageToken
+	}
+	if resp.PrevPageToken != "" {
+		body["prev_page_token"] = resp.PrevPageToken
+	}
+	if req.FolderId != nil {
+		body["folders"] = folderResponses(resp.Folders)
+		body["breadcrumbs"] = folderResponses(resp.Breadcrumbs)
+	}
+	c.JSON(http.StatusOK, body)
+}
+
+// listFilesRequest reads the folder, search, sort and paging parameters
+// shared by the file listing endpoints.
+func listFilesRequest(c *gin.Context) (*filev1.ListFilesRequest, error) {
+	req := &filev1.ListFilesRequest{
+		Query:       c.Query("q"),
+		Tags:        c.QueryArray("tag"),
+		ContentType: c.Query("type"),
+		PageToken:   c.Query("page_token"),
+	}
+	if sort, ok := c.GetQuery("sort"); ok {
+		field, ok := fileSortFields[sort]
+		if !ok {
+			return nil, errors.New("sort must be name, size or created_at")
+		}
+		req.SortBy = field
+	}
+	switch c.Query("order") {
+	case "", "asc":
+	case "desc":
+		req.Descending = true
+	default:
+		return nil, errors.New("order must be asc or desc")
+	}
+	if pageSize, ok := c.GetQuery("page_size"); ok {
+		n, err := strconv.ParseInt(pageSize, 10, 32)
+		if err != nil {
+			return nil, errors.New("page_size must be an integer")
 		}
+		req.PageSize = int32(n)
+	}
+	if folderID, ok := c.GetQuery("folder_id"); ok {
+		folderID = parentFolderID(folderID)
+		req.FolderId = &folderID
 	}
+	for name, field := range map[string]**int64{
+		"min_size":       &req.MinSize,
+		"max_size":       &req.MaxSize,
+		"created_after":  &req.CreatedAfter,
+		"created_before": &req.CreatedBefore,
+	} {
+		value, ok := c.GetQuery(name)
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || n < 0 {
+			return nil, errors.New(name + " must be a non-negative integer")
+		}
+		*field = &n
+	}
+	return req, nil
+}
 
-	c.JSON(http.StatusOK, gin.H{"files": files})
+// pageLinks builds a Link header for the pages either side of the current
+// one, keeping every other query parameter of the request.
+func pageLinks(c *gin.Context, next, prev string) string {
+	var links []string
+	for _, page := range []struct{ rel, token string }{{"next", next}, {"prev", prev}} {
+		if page.token == "" {
+			continue
+		}
+		u := *c.Request.URL
+		query := u.Query()
+		query.Set("page_token", page.token)
+		u.RawQuery = query.Encode()
+		links = append(links, fmt.Sprintf(`<%s>; rel="%s"`, u.RequestURI(), page.rel))
+	}
+	return strings.Join(links, ", ")
 }
 
 // GetFile godoc
@@ -144,15 +304,7 @@ func (h *FileHandler) GetFile(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"file": map[string]interface{}{
-			"id":           resp.File.Id,
-			"filename":     resp.File.Filename,
-			"size":         resp.File.Size,
-			"content_type": resp.File.ContentType,
-			"created_at":   resp.File.CreatedAt,
-		},
-	})
+	c.JSON(http.StatusOK, gin.H{"file": fileMetadata(resp.File)})
 }
 
 // DeleteFile godoc
@@ -194,18 +346,96 @@ func (h *FileHandler) DeleteFile(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
 }
 
+// UpdateFile godoc
+// @Summary      Update file metadata
+// @Description  Rename a file, change its content type, move it to another folder or replace its tags and metadata. Only the fields present
+// @Description  in the body are changed; a folder_id of root moves the file to the top level, and an empty tags list or metadata object clears them. on_conflict decides what happens when the folder already holds
+// @Description  a file with the new name: fail (the default), rename to a free "name (n)" or replace the other file.
+// @Tags         files
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "File ID"
+// @Param        request body UpdateFileRequest true "Fields to change"
+// @Success      200 {object} FileResponse "File updated"
+// @Failure      400 {object} ErrorResponse "Invalid filename, content type, folder, tags or metadata, or nothing to update"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "File or folder not found"
+// @Failure      409 {object} ErrorResponse "A file with that name already exists in the folder"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/files/{id} [patch]
+func (h *FileHandler) UpdateFile(c *gin.Context) {
+	lookup, ok := h.requestedFile(c)
+	if !ok {
+		return
+	}
+
+	var req UpdateFileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	update := &filev1.UpdateFileRequest{
+		Id:         lookup.Id,
+		UpdateMask: &fieldmaskpb.FieldMask{},
+		OnConflict: conflictPolicies[req.OnConflict],
+	}
+	if req.Filename != nil {
+		update.Filename = *req.Filename
+		update.UpdateMask.Paths = append(update.UpdateMask.Paths, "filename")
+	}
+	if req.ContentType != nil {
+		update.ContentType = *req.ContentType
+		update.UpdateMask.Paths = append(update.UpdateMask.Paths, "content_type")
+	}
+	if req.FolderID != nil {
+		update.ParentId = parentFolderID(*req.FolderID)
+		update.UpdateMask.Paths = append(update.UpdateMask.Paths, "parent_id")
+	}
+	if req.Tags != nil {
+		update.Tags = req.Tags
+		update.UpdateMask.Paths = append(update.UpdateMask.Paths, "tags")
+	}
+	if req.Metadata != nil {
+		update.Metadata = req.Metadata
+		update.UpdateMask.Paths = append(update.UpdateMask.Paths, "metadata")
+	}
+	if len(update.UpdateMask.Paths) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "nothing to update"})
+		return
+	}
+
+	resp, err := h.client.UpdateFile(h.contextWithAuth(c), update)
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"file": fileMetadata(resp.File)})
+}
+
 // UploadFile godoc
 // @Summary      Upload a file
-// @Description  Upload a file to S3 and save metadata for the authenticated user
+// @Description  Upload a file to S3 and save metadata for the authenticated user.
+// @Description  The file is streamed to storage as it arrives. Send it as the "file" field of a multipart form,
+// @Description  or as the raw request body with its name in the X-Filename header.
+// @Description  Send an Idempotency-Key header to make retries safe: a repeat returns the first response.
+// @Description  Send X-Checksum-SHA256 to have the file rejected unless it arrives intact. The stored file's SHA-256 is returned either way.
 // @Tags         files
 // @Accept       multipart/form-data
 // @Produce      json
 // @Param        file formData file true "File to upload"
+// @Param        folder_id query string false "Folder to upload into; the top level when omitted"
+// @Param        X-Checksum-SHA256 header string false "Hex SHA-256 of the file"
 // @Success      200 {object} FileResponse "File uploaded successfully"
-// @Failure      400 {object} ErrorResponse "Invalid file or missing required field"
+// @Failure      400 {object} ErrorResponse "Invalid file, missing required field or checksum mismatch"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
-// @Failure      429 {object} ErrorResponse "Too many files - limit is 20 per user"
-// @Failure      500 {object} ErrorResponse "Internal server error or file too large"
+// @Failure      409 {object} ErrorResponse "A request with the same Idempotency-Key is still in progress"
+// @Failure      413 {object} ErrorResponse "File exceeds the maximum size of 2GB or the storage quota"
+// @Failure      422 {object} ErrorResponse "Idempotency-Key was already used for a different request"
+// @Failure      429 {object} ErrorResponse "File quota reached"
+// @Failure      500 {object} ErrorResponse "Internal server error"
 // @Security     BearerAuth
 // @Router       /api/files [post]
 func (h *FileHandler) UploadFile(c *gin.Context) {
@@ -215,20 +445,43 @@ func (h *FileHandler) UploadFile(c *gin.Context) {
 		return
 	}
 
-	file, err := c.FormFile("file")
+	checksum, err := expectedChecksum(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	src, err := file.Open()
+	quota := uploadQuotaFromContext(c)
+	limit, overQuota := quota.limit(maxFileSize)
+	tooLarge := errFileTooLarge
+	if overQuota {
+		tooLarge = errQuotaExceeded
+	}
+
+	// Reject oversized bodies before reading them, and cap bodies that don't
+	// declare a length. Only multipart forms carry more than the file.
+	overhead := int64(0)
+	if mediaType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type")); mediaType == "multipart/form-data" {
+		overhead = multipartOverhead
+	}
+	if c.Request.ContentLength > limit+overhead {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": tooLarge})
+		return
+	}
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit+multipartOverhead)
+
+	filename, contentType, src, err := uploadSource(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to open file"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	defer src.Close()
+	digest := sha256.New()
+	src = io.TeeReader(src, digest)
 
-	ctx := h.contextWithAuth(c)
+	// Cancelling the stream on an early return stops the file service from
+	// storing a partial file.
+	ctx, cancel := context.WithCancel(h.contextWithAuth(c))
+	defer cancel()
 	stream, err := h.client.UploadFile(ctx)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -238,8 +491,11 @@ func (h *FileHandler) UploadFile(c *gin.Context) {
 	err = stream.Send(&filev1.UploadFileRequest{
 		Data: &filev1.UploadFileRequest_Metadata{
 			Metadata: &filev1.UploadFileMetadata{
-				Filename:    file.Filename,
-				ContentType: file.Header.Get("Content-Type"),
+				Filename:    filename,
+				ContentType: contentType,
+				ParentId:    parentFolderID(c.Query("folder_id")),
+				Quota:       quota.storageQuota(),
+				Sha256:      checksum,
 			},
 		},
 	})
@@ -248,75 +504,220 @@ func (h *FileHandler) UploadFile(c *gin.Context) {
 		return
 	}
 
+	sent, err := streamChunks(src, limit, func(chunk []byte) error {
+		return stream.Send(&filev1.UploadFileRequest{
+			Data: &filev1.UploadFileRequest_Chunk{Chunk: chunk},
+		})
+	})
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.Is(err, errTooLarge), errors.As(err, &maxBytesErr):
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": tooLarge})
+		return
+	case errors.Is(err, errSendChunk):
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to upload chunk"})
+		return
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read file"})
+		return
+	}
+	received := hex.EncodeToString(digest.Sum(nil))
+	if checksum != "" && received != checksum {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errChecksumMismatch})
+		return
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+	if stored := resp.File.Sha256; stored != "" && stored != received {
+		// Don't leave a corrupt file behind for the client to find later.
+		if _, err := h.client.DeleteFile(ctx, &filev1.DeleteFileRequest{Id: resp.File.Id}); err != nil {
+			slog.ErrorContext(ctx, "Failed to delete mismatched upload", "file_id", resp.File.Id, "error", err)
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errStoredMismatch})
+		return
+	}
+	h.metrics.recordUploaded(ctx, uploadSingle, sent)
+	h.metrics.recordUploadComplete(ctx, uploadSingle, resp.File.Size)
+
+	c.JSON(http.StatusOK, gin.H{"file": fileMetadata(resp.File)})
+}
+
+func fileMetadata(file *filev1.File) FileMetadata {
+	return FileMetadata{
+		ID:          file.Id,
+		Filename:    file.Filename,
+		Size:        file.Size,
+		ContentType: file.ContentType,
+		CreatedAt:   file.CreatedAt,
+		FolderID:    file.ParentId,
+		SHA256:      file.Sha256,
+		PartsSHA256: file.PartsSha256,
+		Tags:        file.Tags,
+		Metadata:    file.Metadata,
+	}
+}
+
+// uploadSource returns the name, content type and contents of an uploaded
+// file. Multipart forms are read part by part, so the file is never buffered;
+// any other body is taken as the file itself, named by X-Filename.
+func uploadSource(c *gin.Context) (string, string, io.Reader, error) {
+	mediaType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		filename := c.GetHeader(FilenameHeader)
+		if filename == "" {
+			return "", "", nil, errFileRequired
+		}
+		if unescaped, err := url.PathUnescape(filename); err == nil {
+			filename = unescaped
+		}
+		contentType := c.GetHeader("Content-Type")
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		return filepath.Base(filename), contentType, c.Request.Body, nil
+	}
+
+	part, err := formFilePart(c, "file")
+	if err != nil {
+		return "", "", nil, errFileRequired
+	}
+	return part.FileName(), part.Header.Get("Content-Type"), part, nil
+}
+
+// formFilePart advances a multipart body to the file sent in field, leaving
+// its contents unread.
+func formFilePart(c *gin.Context, field string) (*multipart.Part, error) {
+	reader, err := c.Request.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			return nil, err
+		}
+		if part.FormName() == field && part.FileName() != "" {
+			return part, nil
+		}
+	}
+}
+
+// streamChunks reads src in 64KB chunks and passes each to send before
+// reading the next, so gRPC flow control paces how fast the client body is
+// consumed. It fails with errTooLarge once more than limit bytes have been
+// read, and wraps send failures in errSendChunk.
+func streamChunks(src io.Reader, limit int64, send func([]byte) error) (int64, error) {
+	var sent int64
 	buffer := make([]byte, 64*1024)
 	for {
 		n, err := src.Read(buffer)
-		if err == io.EOF {
-			break
+		if n > 0 {
+			sent += int64(n)
+			if sent > limit {
+				return sent, errTooLarge
+			}
+			if sendErr := send(buffer[:n]); sendErr != nil {
+				return sent, fmt.Errorf("%w: %w", errSendChunk, sendErr)
+			}
 		}
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read file"})
-			return
+		if err == io.EOF {
+			return sent, nil
 		}
-
-		err = stream.Send(&filev1.UploadFileRequest{
-			Data: &filev1.UploadFileRequest_Chunk{
-				Chunk: buffer[:n],
-			},
-		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to upload chunk"})
-			return
+			return sent, err
 		}
 	}
+}
 
-	resp, err := stream.CloseAndRecv()
-	if err != nil {
-		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+// HeadFile godoc
+// @Summary      Get download headers for a file
+// @Description  Returns the headers a download of the file would carry, without the contents.
+// @Description  Supports If-None-Match and If-Modified-Since like the download endpoint.
+// @Tags         files
+// @Param        id path string true "File ID"
+// @Success      200 "File exists"
+// @Success      304 "File not modified"
+// @Failure      400 {object} ErrorResponse "Invalid file ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "File not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/files/{id} [head]
+func (h *FileHandler) HeadFile(c *gin.Context) {
+	lookup, ok := h.requestedFile(c)
+	if !ok {
+		return
+	}
+	file, ok := h.fileForDownload(c, lookup)
+	if !ok {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"file": map[string]interface{}{
-			"id":           resp.File.Id,
-			"filename":     resp.File.Filename,
-			"size":         resp.File.Size,
-			"content_type": resp.File.ContentType,
-			"created_at":   resp.File.CreatedAt,
-		},
-	})
+	c.Header("Content-Type", file.ContentType)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", file.Filename))
+	c.Header("Content-Length", strconv.FormatInt(file.Size, 10))
+	c.Status(http.StatusOK)
 }
 
 // DownloadFile godoc
 // @Summary      Download a file
-// @Description  Download a file by ID (must be owned by the authenticated user)
+// @Description  Download a file by ID (must be owned by the authenticated user or shared with them for download)
+// @Description  Send a single Range to download part of the file, optionally guarded by If-Range.
+// @Description  Responses carry ETag and Last-Modified; If-None-Match and If-Modified-Since return 304 when the file is unchanged.
+// @Description  Files with a recorded SHA-256 also carry it as a Digest header, and it serves as their ETag.
 // @Tags         files
 // @Produce      octet-stream
 // @Param        id path string true "File ID"
 // @Success      200 {file} binary "File content"
+// @Success      206 {file} binary "Requested byte range"
+// @Success      304 "File not modified"
 // @Failure      400 {object} ErrorResponse "Invalid file ID"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "File is shared for viewing only"
 // @Failure      404 {object} ErrorResponse "File not found"
+// @Failure      416 {object} ErrorResponse "Range not satisfiable"
 // @Failure      500 {object} ErrorResponse "Internal server error"
 // @Security     BearerAuth
 // @Router       /api/files/{id}/download [get]
 func (h *FileHandler) DownloadFile(c *gin.Context) {
-	_, err := h.getUserFromContext(c)
-	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+	lookup, ok := h.requestedFile(c)
+	if !ok {
 		return
 	}
+	h.serveFile(c, lookup)
+}
 
-	fileID := c.Param("id")
-	if fileID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "file id is required"})
+// serveFile streams the file found by lookup, honouring conditional and Range
+// headers. A lookup by download link also downloads through that link.
+func (h *FileHandler) serveFile(c *gin.Context, lookup *filev1.GetFileRequest) {
+	file, ok := h.fileForDownload(c, lookup)
+	if !ok {
 		return
 	}
 
+	req := &filev1.DownloadFileRequest{Id: file.Id, LinkId: lookup.LinkId, Admin: lookup.Admin}
+	var rng byteRange
+	partial := false
+	if ifRangeMatches(c.Request, fileETag(file), fileLastModified(file)) {
+		var err error
+		rng, partial, err = parseRange(c.GetHeader("Range"), file.Size)
+		if err != nil {
+			c.Header("Content-Range", fmt.Sprintf("bytes */%d", file.Size))
+			c.JSON(http.StatusRequestedRangeNotSatisfiable, gin.H{"error": err.Error()})
+			return
+		}
+		if partial {
+			req.Offset = rng.start
+			req.Length = rng.length
+		}
+	}
+
 	ctx := h.contextWithAuth(c)
-	stream, err := h.client.DownloadFile(ctx, &filev1.DownloadFileRequest{
-		Id: fileID,
-	})
+	stream, err := h.client.DownloadFile(ctx, req)
 	if err != nil {
 		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
 		return
@@ -336,9 +737,17 @@ func (h *FileHandler) DownloadFile(c *gin.Context) {
 
 	c.Header("Content-Type", metadata.ContentType)
 	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", metadata.Filename))
-	c.Header("Content-Length", strconv.FormatInt(metadata.Size, 10))
+	if partial {
+		c.Header("Content-Range", rng.contentRange(metadata.Size))
+		c.Header("Content-Length", strconv.FormatInt(rng.length, 10))
+		c.Status(http.StatusPartialContent)
+	} else {
+		c.Header("Content-Length", strconv.FormatInt(metadata.Size, 10))
+		c.Status(http.StatusOK)
+	}
 
-	c.Status(http.StatusOK)
+	var written int64
+	defer func() { h.metrics.recordDownloaded(ctx, written) }()
 	for {
 		msg, err := stream.Recv()
 		if err == io.EOF {
@@ -346,21 +755,65 @@ func (h *FileHandler) DownloadFile(c *gin.Context) {
 		}
 		if err != nil {
 			// Headers already sent, log the error and abort the connection
-			fmt.Printf("Error streaming file download: %v\n", err)
+			slog.ErrorContext(ctx, "Error streaming file download", "error", err)
 			return
 		}
 
 		chunk := msg.GetChunk()
 		if chunk != nil {
-			c.Writer.Write(chunk)
+			n, _ := c.Writer.Write(chunk)
+			written += int64(n)
 			c.Writer.Flush()
 		}
 	}
 }
 
+// requestedFile checks the caller is signed in and returns a lookup for the
+// file named in the path.
+func (h *FileHandler) requestedFile(c *gin.Context) (*filev1.GetFileRequest, bool) {
+	_, err := h.getUserFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return nil, false
+	}
+
+	fileID := c.Param("id")
+	if fileID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file id is required"})
+		return nil, false
+	}
+	return &filev1.GetFileRequest{Id: fileID}, true
+}
+
+// fileForDownload looks up a file and sets its validators and Accept-Ranges.
+// It writes the response and returns false when the lookup fails or the
+// client's copy is still current.
+func (h *FileHandler) fileForDownload(c *gin.Context, lookup *filev1.GetFileRequest) (*filev1.File, bool) {
+	resp, err := h.client.GetFile(h.contextWithAuth(c), lookup)
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return nil, false
+	}
+	file := resp.GetFile()
+
+	etag := fileETag(file)
+	modified := fileLastModified(file)
+	c.Header("ETag", etag)
+	if file.Sha256 != "" {
+		c.Header("Digest", digestHeader(file.Sha256))
+	}
+	c.Header("Last-Modified", modified.Format(http.TimeFormat))
+	c.Header("Accept-Ranges", "bytes")
+	if notModified(c.Request, etag, modified) {
+		c.Status(http.StatusNotModified)
+		return nil, false
+	}
+	return file, true
+}
+
 // InitiateMultipartUpload godoc
 // @Summary      Initiate a multipart upload
-// @Description  Start a new multipart upload session for large files
+// @Description  Start a new multipart upload session for large files. The whole file counts against the storage quota from the start.
 // @Tags         files
 // @Accept       json
 // @Produce      json
@@ -368,6 +821,8 @@ func (h *FileHandler) DownloadFile(c *gin.Context) {
 // @Success      200 {object} InitiateMultipartUploadResponse "Upload session created"
 // @Failure      400 {object} ErrorResponse "Invalid request body"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      413 {object} ErrorResponse "File exceeds the storage quota"
+// @Failure      429 {object} ErrorResponse "File quota reached"
 // @Failure      500 {object} ErrorResponse "Internal server error"
 // @Security     BearerAuth
 // @Router       /api/files/multipart/initiate [post]
@@ -390,23 +845,31 @@ func (h *FileHandler) InitiateMultipartUpload(c *gin.Context) {
 		return
 	}
 
-	const maxFileSize = 2 * 1024 * 1024 * 1024 // 2GB
 	if req.TotalSize > maxFileSize {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "file size exceeds maximum allowed size of 2GB"})
 		return
 	}
 
+	quota := uploadQuotaFromContext(c)
+	if _, overQuota := quota.limit(req.TotalSize); overQuota {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": errQuotaExceeded})
+		return
+	}
+
 	ctx := h.contextWithAuth(c)
 	resp, err := h.client.InitiateMultipartUpload(ctx, &filev1.InitiateMultipartUploadRequest{
 		Filename:    req.Filename,
 		ContentType: req.ContentType,
 		TotalSize:   req.TotalSize,
+		ParentId:    parentFolderID(req.FolderID),
+		Quota:       quota.storageQuota(),
 	})
 	if err != nil {
 		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
 		return
 	}
 
+	h.metrics.recordMultipart(ctx, "started")
 	c.JSON(http.StatusOK, gin.H{
 		"upload_id":   resp.UploadId,
 		"chunk_size":  resp.ChunkSize,
@@ -423,9 +886,11 @@ func (h *FileHandler) InitiateMultipartUpload(c *gin.Context) {
 // @Param        upload_id path string true "Upload session ID"
 // @Param        part_number path int true "Part number"
 // @Param        chunk formData file true "File chunk to upload"
+// @Param        X-Checksum-SHA256 header string false "Hex SHA-256 of the chunk"
 // @Success      200 {object} UploadPartResponse "Part uploaded successfully"
-// @Failure      400 {object} ErrorResponse "Invalid part number or missing chunk"
+// @Failure      400 {object} ErrorResponse "Invalid part number, missing chunk or checksum mismatch"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      429 {object} ErrorResponse "Too many requests - retry after the Retry-After header"
 // @Failure      500 {object} ErrorResponse "Internal server error"
 // @Security     BearerAuth
 // @Router       /api/files/multipart/{upload_id}/part/{part_number} [post]
@@ -449,58 +914,100 @@ func (h *FileHandler) UploadPart(c *gin.Context) {
 	}
 	partNumber := int32(partNumberParsed)
 
-	file, err := c.FormFile("chunk")
+	checksum, err := expectedChecksum(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "chunk is required"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	const maxChunkSize = 10 * 1024 * 1024
-	if file.Size > maxChunkSize {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "chunk size exceeds maximum of 10MB"})
+	if c.Request.ContentLength > maxPartSize+multipartOverhead {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errChunkTooLarge})
 		return
 	}
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxPartSize+multipartOverhead)
 
-	src, err := file.Open()
+	part, err := formFilePart(c, "chunk")
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to open chunk"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "chunk is required"})
 		return
 	}
-	defer src.Close()
 
-	chunk, err := io.ReadAll(src)
+	ctx, cancel := context.WithCancel(h.contextWithAuth(c))
+	defer cancel()
+	stream, err := h.client.UploadPartStream(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read chunk"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	ctx := h.contextWithAuth(c)
-	resp, err := h.client.UploadPart(ctx, &filev1.UploadPartRequest{
-		UploadId:   uploadID,
-		PartNumber: partNumber,
-		Chunk:      chunk,
+	err = stream.Send(&filev1.UploadPartStreamRequest{
+		Data: &filev1.UploadPartStreamRequest_Metadata{
+			Metadata: &filev1.UploadPartMetadata{
+				UploadId:   uploadID,
+				PartNumber: partNumber,
+				Sha256:     checksum,
+			},
+		},
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to send metadata"})
+		return
+	}
+
+	digest := sha256.New()
+	sent, err := streamChunks(io.TeeReader(part, digest), maxPartSize, func(chunk []byte) error {
+		return stream.Send(&filev1.UploadPartStreamRequest{
+			Data: &filev1.UploadPartStreamRequest_Chunk{Chunk: chunk},
+		})
 	})
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.Is(err, errTooLarge), errors.As(err, &maxBytesErr):
+		c.JSON(http.StatusBadRequest, gin.H{"error": errChunkTooLarge})
+		return
+	case errors.Is(err, errSendChunk):
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to upload chunk"})
+		return
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read chunk"})
+		return
+	}
+	received := hex.EncodeToString(digest.Sum(nil))
+	if checksum != "" && received != checksum {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errChecksumMismatch})
+		return
+	}
+
+	resp, err := stream.CloseAndRecv()
 	if err != nil {
 		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
 		return
 	}
+	// The client can send a corrupt part again; it only counts once completed.
+	if resp.Sha256 != "" && resp.Sha256 != received {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errStoredMismatch})
+		return
+	}
 
+	h.metrics.recordUploaded(ctx, uploadMultipart, sent)
 	c.JSON(http.StatusOK, gin.H{
 		"etag":        resp.Etag,
 		"part_number": resp.PartNumber,
+		"sha256":      resp.Sha256,
 	})
 }
 
 // CompleteMultipartUpload godoc
 // @Summary      Complete a multipart upload
-// @Description  Finalize a multipart upload by providing all part ETags
+// @Description  Finalize a multipart upload by providing all part ETags.
+// @Description  With parts_sha256 (the SHA-256 of each part's raw SHA-256 digest joined in part order), the parts are checked before they are joined; on a mismatch the upload stays open so parts can be sent again.
 // @Tags         files
 // @Accept       json
 // @Produce      json
 // @Param        upload_id path string true "Upload session ID"
 // @Param        body body CompleteMultipartUploadRequest true "Parts to complete"
 // @Success      200 {object} FileResponse "File uploaded successfully"
-// @Failure      400 {object} ErrorResponse "Invalid request body"
+// @Failure      400 {object} ErrorResponse "Invalid request body or checksum mismatch"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
 // @Failure      404 {object} ErrorResponse "Upload session not found"
 // @Failure      500 {object} ErrorResponse "Internal server error"
@@ -521,6 +1028,11 @@ func (h *FileHandler) CompleteMultipartUpload(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
 		return
 	}
+	checksum := strings.ToLower(req.PartsSHA256)
+	if checksum != "" && !isSHA256(checksum) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "parts_sha256 must be a hex SHA-256 digest"})
+		return
+	}
 
 	parts := make([]*filev1.PartInfo, len(req.Parts))
 	for i, p := range req.Parts {
@@ -532,23 +1044,18 @@ func (h *FileHandler) CompleteMultipartUpload(c *gin.Context) {
 
 	ctx := h.contextWithAuth(c)
 	resp, err := h.client.CompleteMultipartUpload(ctx, &filev1.CompleteMultipartUploadRequest{
-		UploadId: uploadID,
-		Parts:    parts,
+		UploadId:    uploadID,
+		Parts:       parts,
+		PartsSha256: checksum,
 	})
 	if err != nil {
 		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
 		return
 	}
+	h.metrics.recordMultipart(ctx, "completed")
+	h.metrics.recordUploadComplete(ctx, uploadMultipart, resp.File.Size)
 
-	c.JSON(http.StatusOK, gin.H{
-		"file": map[string]interface{}{
-			"id":           resp.File.Id,
-			"filename":     resp.File.Filename,
-			"size":         resp.File.Size,
-			"content_type": resp.File.ContentType,
-			"created_at":   resp.File.CreatedAt,
-		},
-	})
+	c.JSON(http.StatusOK, gin.H{"file": fileMetadata(resp.File)})
 }
 
 // AbortMultipartUpload godoc
@@ -580,5 +1087,96 @@ func (h *FileHandler) AbortMultipartUpload(c *gin.Context) {
 		return
 	}
 
+	h.metrics.recordMultipart(ctx, "aborted")
 	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
 }
+
+// ListMultipartUploads godoc
+// @Summary      List multipart uploads in progress
+// @Description  List the authenticated user's multipart uploads that have been initiated but not completed or aborted, newest first.
+// @Description  Use it to find an upload_id again after the client has lost it.
+// @Tags         files
+// @Produce      json
+// @Success      200 {object} ListMultipartUploadsResponse "Uploads in progress"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/files/multipart [get]
+func (h *FileHandler) ListMultipartUploads(c *gin.Context) {
+	_, err := h.getUserFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.ListMultipartUploads(h.contextWithAuth(c), &filev1.ListMultipartUploadsRequest{})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	uploads := make([]MultipartUploadResponse, len(resp.Uploads))
+	for i, upload := range resp.Uploads {
+		uploads[i] = multipartUpload(upload)
+	}
+	c.JSON(http.StatusOK, ListMultipartUploadsResponse{Uploads: uploads})
+}
+
+// ListParts godoc
+// @Summary      List the parts of a multipart upload
+// @Description  Show a multipart upload in progress with the parts received so far, their ETags and sizes, ordered by part number.
+// @Description  A client can resume by uploading only the missing parts, then complete with the ETags listed here.
+// @Tags         files
+// @Produce      json
+// @Param        upload_id path string true "Upload session ID"
+// @Success      200 {object} MultipartUploadPartsResponse "Upload and its parts"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Upload session not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/files/multipart/{upload_id} [get]
+func (h *FileHandler) ListParts(c *gin.Context) {
+	_, err := h.getUserFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.ListParts(h.contextWithAuth(c), &filev1.ListPartsRequest{
+		UploadId: c.Param("upload_id"),
+	})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	parts := make([]UploadedPartResponse, len(resp.Parts))
+	for i, part := range resp.Parts {
+		parts[i] = UploadedPartResponse{
+			PartNumber: part.PartNumber,
+			Etag:       part.Etag,
+			Size:       part.Size,
+			SHA256:     part.Sha256,
+		}
+	}
+	c.JSON(http.StatusOK, MultipartUploadPartsResponse{
+		Upload: multipartUpload(resp.Upload),
+		Parts:  parts,
+	})
+}
+
+func multipartUpload(upload *filev1.MultipartUpload) MultipartUploadResponse {
+	return MultipartUploadResponse{
+		UploadID:      upload.GetUploadId(),
+		Filename:      upload.GetFilename(),
+		ContentType:   upload.GetContentType(),
+		TotalSize:     upload.GetTotalSize(),
+		FolderID:      upload.GetParentId(),
+		ChunkSize:     upload.GetChunkSize(),
+		TotalParts:    upload.GetTotalParts(),
+		PartsUploaded: upload.GetPartsUploaded(),
+		UploadedBytes: upload.GetUploadedBytes(),
+		CreatedAt:     upload.GetCreatedAt(),
+		ExpiresAt:     upload.GetExpiresAt(),
+	}
+}
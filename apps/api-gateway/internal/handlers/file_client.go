@@ -13,12 +13,31 @@ type FileServiceClient interface {
 	ListFiles(ctx context.Context, req *filev1.ListFilesRequest) (*filev1.ListFilesResponse, error)
 	GetFile(ctx context.Context, req *filev1.GetFileRequest) (*filev1.FileResponse, error)
 	DeleteFile(ctx context.Context, req *filev1.DeleteFileRequest) (*filev1.DeleteFileResponse, error)
+	UpdateFile(ctx context.Context, req *filev1.UpdateFileRequest) (*filev1.FileResponse, error)
+	AddFileTags(ctx context.Context, req *filev1.FileTagsRequest) (*filev1.FileResponse, error)
+	RemoveFileTags(ctx context.Context, req *filev1.FileTagsRequest) (*filev1.FileResponse, error)
+	ListTags(ctx context.Context, req *filev1.ListTagsRequest) (*filev1.ListTagsResponse, error)
+	ListAdminActions(ctx context.Context, req *filev1.ListAdminActionsRequest) (*filev1.ListAdminActionsResponse, error)
+	GetUsage(ctx context.Context, req *filev1.GetUsageRequest) (*filev1.GetUsageResponse, error)
 	UploadFile(ctx context.Context) (filev1.FileService_UploadFileClient, error)
 	DownloadFile(ctx context.Context, req *filev1.DownloadFileRequest) (filev1.FileService_DownloadFileClient, error)
 	InitiateMultipartUpload(ctx context.Context, req *filev1.InitiateMultipartUploadRequest) (*filev1.InitiateMultipartUploadResponse, error)
-	UploadPart(ctx context.Context, req *filev1.UploadPartRequest) (*filev1.UploadPartResponse, error)
+	UploadPartStream(ctx context.Context) (filev1.FileService_UploadPartStreamClient, error)
 	CompleteMultipartUpload(ctx context.Context, req *filev1.CompleteMultipartUploadRequest) (*filev1.FileResponse, error)
 	AbortMultipartUpload(ctx context.Context, req *filev1.AbortMultipartUploadRequest) (*filev1.AbortMultipartUploadResponse, error)
+	ListMultipartUploads(ctx context.Context, req *filev1.ListMultipartUploadsRequest) (*filev1.ListMultipartUploadsResponse, error)
+	ListParts(ctx context.Context, req *filev1.ListPartsRequest) (*filev1.ListPartsResponse, error)
+	CreateDownloadLink(ctx context.Context, req *filev1.CreateDownloadLinkRequest) (*filev1.DownloadLink, error)
+	ListDownloadLinks(ctx context.Context, req *filev1.ListDownloadLinksRequest) (*filev1.ListDownloadLinksResponse, error)
+	RevokeDownloadLink(ctx context.Context, req *filev1.RevokeDownloadLinkRequest) (*filev1.RevokeDownloadLinkResponse, error)
+	ShareFile(ctx context.Context, req *filev1.ShareFileRequest) (*filev1.Share, error)
+	ListShares(ctx context.Context, req *filev1.ListSharesRequest) (*filev1.ListSharesResponse, error)
+	RevokeShare(ctx context.Context, req *filev1.RevokeShareRequest) (*filev1.RevokeShareResponse, error)
+	ListSharedWithMe(ctx context.Context, req *filev1.ListSharedWithMeRequest) (*filev1.ListSharedWithMeResponse, error)
+	CreateFolder(ctx context.Context, req *filev1.CreateFolderRequest) (*filev1.Folder, error)
+	RenameFolder(ctx context.Context, req *filev1.RenameFolderRequest) (*filev1.Folder, error)
+	MoveFolder(ctx context.Context, req *filev1.MoveFolderRequest) (*filev1.Folder, error)
+	DeleteFolder(ctx context.Context, req *filev1.DeleteFolderRequest) (*filev1.DeleteFolderResponse, error)
 	Close() error
 }
 
@@ -27,16 +46,11 @@ type grpcFileClient struct {
 	client filev1.FileServiceClient
 }
 
-func NewGRPCFileClient(addr string) (FileServiceClient, error) {
-	maxMsgSize := 20 * 1024 * 1024
-	conn, err := grpc.NewClient(addr,
+func NewGRPCFileClient(addr string, opts ...grpc.DialOption) (FileServiceClient, error) {
+	conn, err := grpc.NewClient(addr, append([]grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
-		grpc.WithDefaultCallOptions(
-			grpc.MaxCallRecvMsgSize(maxMsgSize),
-			grpc.MaxCallSendMsgSize(maxMsgSize),
-		),
-	)
+	}, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +73,30 @@ func (c *grpcFileClient) DeleteFile(ctx context.Context, req *filev1.DeleteFileR
 	return c.client.DeleteFile(ctx, req)
 }
 
+func (c *grpcFileClient) UpdateFile(ctx context.Context, req *filev1.UpdateFileRequest) (*filev1.FileResponse, error) {
+	return c.client.UpdateFile(ctx, req)
+}
+
+func (c *grpcFileClient) AddFileTags(ctx context.Context, req *filev1.FileTagsRequest) (*filev1.FileResponse, error) {
+	return c.client.AddFileTags(ctx, req)
+}
+
+func (c *grpcFileClient) RemoveFileTags(ctx context.Context, req *filev1.FileTagsRequest) (*filev1.FileResponse, error) {
+	return c.client.RemoveFileTags(ctx, req)
+}
+
+func (c *grpcFileClient) ListTags(ctx context.Context, req *filev1.ListTagsRequest) (*filev1.ListTagsResponse, error) {
+	return c.client.ListTags(ctx, req)
+}
+
+func (c *grpcFileClient) ListAdminActions(ctx context.Context, req *filev1.ListAdminActionsRequest) (*filev1.ListAdminActionsResponse, error) {
+	return c.client.ListAdminActions(ctx, req)
+}
+
+func (c *grpcFileClient) GetUsage(ctx context.Context, req *filev1.GetUsageRequest) (*filev1.GetUsageResponse, error) {
+	return c.client.GetUsage(ctx, req)
+}
+
 func (c *grpcFileClient) UploadFile(ctx context.Context) (filev1.FileService_UploadFileClient, error) {
 	return c.client.UploadFile(ctx)
 }
@@ -71,8 +109,8 @@ func (c *grpcFileClient) InitiateMultipartUpload(ctx context.Context, req *filev
 	return c.client.InitiateMultipartUpload(ctx, req)
 }
 
-func (c *grpcFileClient) UploadPart(ctx context.Context, req *filev1.UploadPartRequest) (*filev1.UploadPartResponse, error) {
-	return c.client.UploadPart(ctx, req)
+func (c *grpcFileClient) UploadPartStream(ctx context.Context) (filev1.FileService_UploadPartStreamClient, error) {
+	return c.client.UploadPartStream(ctx)
 }
 
 func (c *grpcFileClient) CompleteMultipartUpload(ctx context.Context, req *filev1.CompleteMultipartUploadRequest) (*filev1.FileResponse, error) {
@@ -83,6 +121,58 @@ func (c *grpcFileClient) AbortMultipartUpload(ctx context.Context, req *filev1.A
 	return c.client.AbortMultipartUpload(ctx, req)
 }
 
+func (c *grpcFileClient) ListMultipartUploads(ctx context.Context, req *filev1.ListMultipartUploadsRequest) (*filev1.ListMultipartUploadsResponse, error) {
+	return c.client.ListMultipartUploads(ctx, req)
+}
+
+func (c *grpcFileClient) ListParts(ctx context.Context, req *filev1.ListPartsRequest) (*filev1.ListPartsResponse, error) {
+	return c.client.ListParts(ctx, req)
+}
+
+func (c *grpcFileClient) CreateDownloadLink(ctx context.Context, req *filev1.CreateDownloadLinkRequest) (*filev1.DownloadLink, error) {
+	return c.client.CreateDownloadLink(ctx, req)
+}
+
+func (c *grpcFileClient) ListDownloadLinks(ctx context.Context, req *filev1.ListDownloadLinksRequest) (*filev1.ListDownloadLinksResponse, error) {
+	return c.client.ListDownloadLinks(ctx, req)
+}
+
+func (c *grpcFileClient) RevokeDownloadLink(ctx context.Context, req *filev1.RevokeDownloadLinkRequest) (*filev1.RevokeDownloadLinkResponse, error) {
+	return c.client.RevokeDownloadLink(ctx, req)
+}
+
+func (c *grpcFileClient) ShareFile(ctx context.Context, req *filev1.ShareFileRequest) (*filev1.Share, error) {
+	return c.client.ShareFile(ctx, req)
+}
+
+func (c *grpcFileClient) ListShares(ctx context.Context, req *filev1.ListSharesRequest) (*filev1.ListSharesResponse, error) {
+	return c.client.ListShares(ctx, req)
+}
+
+func (c *grpcFileClient) RevokeShare(ctx context.Context, req *filev1.RevokeShareRequest) (*filev1.RevokeShareResponse, error) {
+	return c.client.RevokeShare(ctx, req)
+}
+
+func (c *grpcFileClient) ListSharedWithMe(ctx context.Context, req *filev1.ListSharedWithMeRequest) (*filev1.ListSharedWithMeResponse, error) {
+	return c.client.ListSharedWithMe(ctx, req)
+}
+
+func (c *grpcFileClient) CreateFolder(ctx context.Context, req *filev1.CreateFolderRequest) (*filev1.Folder, error) {
+	return c.client.CreateFolder(ctx, req)
+}
+
+func (c *grpcFileClient) RenameFolder(ctx context.Context, req *filev1.RenameFolderRequest) (*filev1.Folder, error) {
+	return c.client.RenameFolder(ctx, req)
+}
+
+func (c *grpcFileClient) MoveFolder(ctx context.Context, req *filev1.MoveFolderRequest) (*filev1.Folder, error) {
+	return c.client.MoveFolder(ctx, req)
+}
+
+func (c *grpcFileClient) DeleteFolder(ctx context.Context, req *filev1.DeleteFolderRequest) (*filev1.DeleteFolderResponse, error) {
+	return c.client.DeleteFolder(ctx, req)
+}
+
 func (c *grpcFileClient) Close() error {
 	return c.conn.Close()
 }
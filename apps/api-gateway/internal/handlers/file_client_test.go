@@ -12,12 +12,31 @@ type mockFileClient struct {
 	listFilesFunc         func(ctx context.Context, req *filev1.ListFilesRequest) (*filev1.ListFilesResponse, error)
 	getFileFunc           func(ctx context.Context, req *filev1.GetFileRequest) (*filev1.FileResponse, error)
 	deleteFileFunc        func(ctx context.Context, req *filev1.DeleteFileRequest) (*filev1.DeleteFileResponse, error)
+	updateFileFunc        func(ctx context.Context, req *filev1.UpdateFileRequest) (*filev1.FileResponse, error)
+	addTagsFunc           func(ctx context.Context, req *filev1.FileTagsRequest) (*filev1.FileResponse, error)
+	removeTagsFunc        func(ctx context.Context, req *filev1.FileTagsRequest) (*filev1.FileResponse, error)
+	listTagsFunc          func(ctx context.Context, req *filev1.ListTagsRequest) (*filev1.ListTagsResponse, error)
+	listAdminActionsFunc  func(ctx context.Context, req *filev1.ListAdminActionsRequest) (*filev1.ListAdminActionsResponse, error)
+	getUsageFunc          func(ctx context.Context, req *filev1.GetUsageRequest) (*filev1.GetUsageResponse, error)
 	uploadFileFunc        func(ctx context.Context) (filev1.FileService_UploadFileClient, error)
 	downloadFileFunc      func(ctx context.Context, req *filev1.DownloadFileRequest) (filev1.FileService_DownloadFileClient, error)
 	initiateMultipartFunc func(ctx context.Context, req *filev1.InitiateMultipartUploadRequest) (*filev1.InitiateMultipartUploadResponse, error)
 	uploadPartFunc        func(ctx context.Context, req *filev1.UploadPartRequest) (*filev1.UploadPartResponse, error)
 	completeMultipartFunc func(ctx context.Context, req *filev1.CompleteMultipartUploadRequest) (*filev1.FileResponse, error)
 	abortMultipartFunc    func(ctx context.Context, req *filev1.AbortMultipartUploadRequest) (*filev1.AbortMultipartUploadResponse, error)
+	listMultipartFunc     func(ctx context.Context, req *filev1.ListMultipartUploadsRequest) (*filev1.ListMultipartUploadsResponse, error)
+	listPartsFunc         func(ctx context.Context, req *filev1.ListPartsRequest) (*filev1.ListPartsResponse, error)
+	createLinkFunc        func(ctx context.Context, req *filev1.CreateDownloadLinkRequest) (*filev1.DownloadLink, error)
+	listLinksFunc         func(ctx context.Context, req *filev1.ListDownloadLinksRequest) (*filev1.ListDownloadLinksResponse, error)
+	revokeLinkFunc        func(ctx context.Context, req *filev1.RevokeDownloadLinkRequest) (*filev1.RevokeDownloadLinkResponse, error)
+	shareFileFunc         func(ctx context.Context, req *filev1.ShareFileRequest) (*filev1.Share, error)
+	listSharesFunc        func(ctx context.Context, req *filev1.ListSharesRequest) (*filev1.ListSharesResponse, error)
+	revokeShareFunc       func(ctx context.Context, req *filev1.RevokeShareRequest) (*filev1.RevokeShareResponse, error)
+	sharedWithMeFunc      func(ctx context.Context, req *filev1.ListSharedWithMeRequest) (*filev1.ListSharedWithMeResponse, error)
+	createFolderFunc      func(ctx context.Context, req *filev1.CreateFolderRequest) (*filev1.Folder, error)
+	renameFolderFunc      func(ctx context.Context, req *filev1.RenameFolderRequest) (*filev1.Folder, error)
+	moveFolderFunc        func(ctx context.Context, req *filev1.MoveFolderRequest) (*filev1.Folder, error)
+	deleteFolderFunc      func(ctx context.Context, req *filev1.DeleteFolderRequest) (*filev1.DeleteFolderResponse, error)
 }
 
 func (m *mockFileClient) ListFiles(ctx context.Context, req *filev1.ListFilesRequest) (*filev1.ListFilesResponse, error) {
@@ -41,6 +60,48 @@ func (m *mockFileClient) DeleteFile(ctx context.Context, req *filev1.DeleteFileR
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockFileClient) UpdateFile(ctx context.Context, req *filev1.UpdateFileRequest) (*filev1.FileResponse, error) {
+	if m.updateFileFunc != nil {
+		return m.updateFileFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) AddFileTags(ctx context.Context, req *filev1.FileTagsRequest) (*filev1.FileResponse, error) {
+	if m.addTagsFunc != nil {
+		return m.addTagsFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) RemoveFileTags(ctx context.Context, req *filev1.FileTagsRequest) (*filev1.FileResponse, error) {
+	if m.removeTagsFunc != nil {
+		return m.removeTagsFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) ListTags(ctx context.Context, req *filev1.ListTagsRequest) (*filev1.ListTagsResponse, error) {
+	if m.listTagsFunc != nil {
+		return m.listTagsFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) ListAdminActions(ctx context.Context, req *filev1.ListAdminActionsRequest) (*filev1.ListAdminActionsResponse, error) {
+	if m.listAdminActionsFunc != nil {
+		return m.listAdminActionsFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) GetUsage(ctx context.Context, req *filev1.GetUsageRequest) (*filev1.GetUsageResponse, error) {
+	if m.getUsageFunc != nil {
+		return m.getUsageFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
 func (m *mockFileClient) UploadFile(ctx context.Context) (filev1.FileService_UploadFileClient, error) {
 	if m.uploadFileFunc != nil {
 		return m.uploadFileFunc(ctx)
@@ -62,9 +123,11 @@ func (m *mockFileClient) InitiateMultipartUpload(ctx context.Context, req *filev
 	return nil, errors.New("not implemented")
 }
 
-func (m *mockFileClient) UploadPart(ctx context.Context, req *filev1.UploadPartRequest) (*filev1.UploadPartResponse, error) {
+// UploadPartStream collects the streamed part and hands it to uploadPartFunc
+// as a single request when the stream is closed.
+func (m *mockFileClient) UploadPartStream(ctx context.Context) (filev1.FileService_UploadPartStreamClient, error) {
 	if m.uploadPartFunc != nil {
-		return m.uploadPartFunc(ctx, req)
+		return &mockUploadPartStream{ctx: ctx, uploadPartFunc: m.uploadPartFunc}, nil
 	}
 	return nil, errors.New("not implemented")
 }
@@ -83,6 +146,97 @@ func (m *mockFileClient) AbortMultipartUpload(ctx context.Context, req *filev1.A
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockFileClient) ListMultipartUploads(ctx context.Context, req *filev1.ListMultipartUploadsRequest) (*filev1.ListMultipartUploadsResponse, error) {
+	if m.listMultipartFunc != nil {
+		return m.listMultipartFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) ListParts(ctx context.Context, req *filev1.ListPartsRequest) (*filev1.ListPartsResponse, error) {
+	if m.listPartsFunc != nil {
+		return m.listPartsFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) CreateDownloadLink(ctx context.Context, req *filev1.CreateDownloadLinkRequest) (*filev1.DownloadLink, error) {
+	if m.createLinkFunc != nil {
+		return m.createLinkFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) ListDownloadLinks(ctx context.Context, req *filev1.ListDownloadLinksRequest) (*filev1.ListDownloadLinksResponse, error) {
+	if m.listLinksFunc != nil {
+		return m.listLinksFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) RevokeDownloadLink(ctx context.Context, req *filev1.RevokeDownloadLinkRequest) (*filev1.RevokeDownloadLinkResponse, error) {
+	if m.revokeLinkFunc != nil {
+		return m.revokeLinkFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) ShareFile(ctx context.Context, req *filev1.ShareFileRequest) (*filev1.Share, error) {
+	if m.shareFileFunc != nil {
+		return m.shareFileFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) ListShares(ctx context.Context, req *filev1.ListSharesRequest) (*filev1.ListSharesResponse, error) {
+	if m.listSharesFunc != nil {
+		return m.listSharesFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) RevokeShare(ctx context.Context, req *filev1.RevokeShareRequest) (*filev1.RevokeShareResponse, error) {
+	if m.revokeShareFunc != nil {
+		return m.revokeShareFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) ListSharedWithMe(ctx context.Context, req *filev1.ListSharedWithMeRequest) (*filev1.ListSharedWithMeResponse, error) {
+	if m.sharedWithMeFunc != nil {
+		return m.sharedWithMeFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) CreateFolder(ctx context.Context, req *filev1.CreateFolderRequest) (*filev1.Folder, error) {
+	if m.createFolderFunc != nil {
+		return m.createFolderFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) RenameFolder(ctx context.Context, req *filev1.RenameFolderRequest) (*filev1.Folder, error) {
+	if m.renameFolderFunc != nil {
+		return m.renameFolderFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) MoveFolder(ctx context.Context, req *filev1.MoveFolderRequest) (*filev1.Folder, error) {
+	if m.moveFolderFunc != nil {
+		return m.moveFolderFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFileClient) DeleteFolder(ctx context.Context, req *filev1.DeleteFolderRequest) (*filev1.DeleteFolderResponse, error) {
+	if m.deleteFolderFunc != nil {
+		return m.deleteFolderFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
 func (m *mockFileClient) Close() error {
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -34,10 +35,14 @@ func setupFileTestRouter(handler *FileHandler) *gin.Engine {
 
 	router.GET("/api/files", handler.ListFiles)
 	router.GET("/api/files/:id", handler.GetFile)
+	router.HEAD("/api/files/:id", handler.HeadFile)
 	router.DELETE("/api/files/:id", handler.DeleteFile)
+	router.PATCH("/api/files/:id", handler.UpdateFile)
 	router.POST("/api/files", handler.UploadFile)
 	router.GET("/api/files/:id/download", handler.DownloadFile)
+	router.GET("/api/files/multipart", handler.ListMultipartUploads)
 	router.POST("/api/files/multipart/initiate", handler.InitiateMultipartUpload)
+	router.GET("/api/files/multipart/:upload_id", handler.ListParts)
 	router.POST("/api/files/multipart/:upload_id/part/:part_number", handler.UploadPart)
 	router.POST("/api/files/multipart/:upload_id/complete", handler.CompleteMultipartUpload)
 	router.DELETE("/api/files/multipart/:upload_id", handler.AbortMultipartUpload)
@@ -278,6 +283,202 @@ func TestUploadFile_MissingFile(t *testing.T) {
 	}
 }
 
+func TestUploadFile_RawBody(t *testing.T) {
+	var metadata *filev1.UploadFileMetadata
+	var received bytes.Buffer
+	mockStream := &mockUploadStream{
+		sendFunc: func(req *filev1.UploadFileRequest) error {
+			if m := req.GetMetadata(); m != nil {
+				metadata = m
+			}
+			received.Write(req.GetChunk())
+			return nil
+		},
+		closeAndRecvFunc: func() (*filev1.FileResponse, error) {
+			return &filev1.FileResponse{File: &filev1.File{Id: "file-123", Filename: "report 1.pdf", Size: 12}}, nil
+		},
+	}
+	mockClient := &mockFileClient{
+		uploadFileFunc: func(ctx context.Context) (filev1.FileService_UploadFileClient, error) {
+			return mockStream, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	req, _ := http.NewRequest("POST", "/api/files", bytes.NewBufferString("test content"))
+	req.Header.Set("Content-Type", "application/pdf")
+	req.Header.Set(FilenameHeader, "..%2Freport%201.pdf")
+	req.Header.Set("Authorization", "Bearer test-token")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if metadata.GetFilename() != "report 1.pdf" || metadata.GetContentType() != "application/pdf" {
+		t.Errorf("unexpected metadata: %v", metadata)
+	}
+	if received.String() != "test content" {
+		t.Errorf("expected file contents to be streamed, got %q", received.String())
+	}
+}
+
+// helloWorldSHA256 is the SHA-256 of "hello world".
+const helloWorldSHA256 = "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+
+func TestUploadFile_Checksum(t *testing.T) {
+	var metadata *filev1.UploadFileMetadata
+	mockClient := &mockFileClient{
+		uploadFileFunc: func(ctx context.Context) (filev1.FileService_UploadFileClient, error) {
+			return &mockUploadStream{
+				sendFunc: func(req *filev1.UploadFileRequest) error {
+					if m := req.GetMetadata(); m != nil {
+						metadata = m
+					}
+					return nil
+				},
+				closeAndRecvFunc: func() (*filev1.FileResponse, error) {
+					return &filev1.FileResponse{File: &filev1.File{Id: "file-123", Size: 11, Sha256: helloWorldSHA256}}, nil
+				},
+			}, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	req, _ := http.NewRequest("POST", "/api/files", bytes.NewBufferString("hello world"))
+	req.Header.Set(FilenameHeader, "hello.txt")
+	req.Header.Set(ChecksumHeader, strings.ToUpper(helloWorldSHA256))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if metadata.GetSha256() != helloWorldSHA256 {
+		t.Errorf("expected checksum forwarded, got %q", metadata.GetSha256())
+	}
+	var resp FileResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if resp.File.SHA256 != helloWorldSHA256 {
+		t.Errorf("expected sha256 in response, got %q", resp.File.SHA256)
+	}
+}
+
+func TestUploadFile_ChecksumMismatch(t *testing.T) {
+	mockClient := &mockFileClient{
+		uploadFileFunc: func(ctx context.Context) (filev1.FileService_UploadFileClient, error) {
+			return &mockUploadStream{
+				sendFunc: func(req *filev1.UploadFileRequest) error { return nil },
+				closeAndRecvFunc: func() (*filev1.FileResponse, error) {
+					t.Error("a mismatched upload should not be committed")
+					return nil, nil
+				},
+			}, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	req, _ := http.NewRequest("POST", "/api/files", bytes.NewBufferString("hello there"))
+	req.Header.Set(FilenameHeader, "hello.txt")
+	req.Header.Set(ChecksumHeader, helloWorldSHA256)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if !strings.Contains(w.Body.String(), errChecksumMismatch) {
+		t.Errorf("expected checksum error, got %s", w.Body.String())
+	}
+}
+
+func TestUploadFile_InvalidChecksum(t *testing.T) {
+	router := setupFileTestRouter(NewFileHandler(&mockFileClient{}))
+
+	req, _ := http.NewRequest("POST", "/api/files", bytes.NewBufferString("hello world"))
+	req.Header.Set(FilenameHeader, "hello.txt")
+	req.Header.Set(ChecksumHeader, "not-a-digest")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestUploadFile_StoredChecksumMismatch(t *testing.T) {
+	deleted := ""
+	mockClient := &mockFileClient{
+		uploadFileFunc: func(ctx context.Context) (filev1.FileService_UploadFileClient, error) {
+			return &mockUploadStream{
+				sendFunc: func(req *filev1.UploadFileRequest) error { return nil },
+				closeAndRecvFunc: func() (*filev1.FileResponse, error) {
+					return &filev1.FileResponse{File: &filev1.File{Id: "file-123", Size: 11, Sha256: strings.Repeat("0", 64)}}, nil
+				},
+			}, nil
+		},
+		deleteFileFunc: func(ctx context.Context, req *filev1.DeleteFileRequest) (*filev1.DeleteFileResponse, error) {
+			deleted = req.Id
+			return &filev1.DeleteFileResponse{Success: true}, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	req, _ := http.NewRequest("POST", "/api/files", bytes.NewBufferString("hello world"))
+	req.Header.Set(FilenameHeader, "hello.txt")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+	if deleted != "file-123" {
+		t.Errorf("expected the mismatched file to be deleted, got %q", deleted)
+	}
+}
+
+func TestUploadFile_TooLarge(t *testing.T) {
+	mockClient := &mockFileClient{
+		uploadFileFunc: func(ctx context.Context) (filev1.FileService_UploadFileClient, error) {
+			t.Fatal("upload stream should not be opened")
+			return nil, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	req, _ := http.NewRequest("POST", "/api/files", bytes.NewBufferString("x"))
+	req.ContentLength = maxFileSize + multipartOverhead + 1
+	req.Header.Set(FilenameHeader, "big.bin")
+	req.Header.Set("Authorization", "Bearer test-token")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected status %d, got %d", http.StatusRequestEntityTooLarge, w.Code)
+	}
+}
+
+func TestUploadFile_MultipartWithoutFileField(t *testing.T) {
+	router := setupFileTestRouter(NewFileHandler(&mockFileClient{}))
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	writer.WriteField("description", "no file here")
+	writer.Close()
+
+	req, _ := http.NewRequest("POST", "/api/files", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer test-token")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
 type mockUploadStream struct {
 	sendFunc         func(*filev1.UploadFileRequest) error
 	closeAndRecvFunc func() (*filev1.FileResponse, error)
@@ -304,6 +505,32 @@ func (m *mockUploadStream) Context() context.Context     { return context.Backgr
 func (m *mockUploadStream) SendMsg(interface{}) error    { return nil }
 func (m *mockUploadStream) RecvMsg(interface{}) error    { return io.EOF }
 
+type mockUploadPartStream struct {
+	ctx            context.Context
+	uploadPartFunc func(ctx context.Context, req *filev1.UploadPartRequest) (*filev1.UploadPartResponse, error)
+	req            filev1.UploadPartRequest
+}
+
+func (m *mockUploadPartStream) Send(req *filev1.UploadPartStreamRequest) error {
+	if md := req.GetMetadata(); md != nil {
+		m.req.UploadId = md.UploadId
+		m.req.PartNumber = md.PartNumber
+	}
+	m.req.Chunk = append(m.req.Chunk, req.GetChunk()...)
+	return nil
+}
+
+func (m *mockUploadPartStream) CloseAndRecv() (*filev1.UploadPartResponse, error) {
+	return m.uploadPartFunc(m.ctx, &m.req)
+}
+
+func (m *mockUploadPartStream) Header() (metadata.MD, error) { return nil, nil }
+func (m *mockUploadPartStream) Trailer() metadata.MD         { return nil }
+func (m *mockUploadPartStream) CloseSend() error             { return nil }
+func (m *mockUploadPartStream) Context() context.Context     { return m.ctx }
+func (m *mockUploadPartStream) SendMsg(interface{}) error    { return nil }
+func (m *mockUploadPartStream) RecvMsg(interface{}) error    { return io.EOF }
+
 type mockDownloadStream struct {
 	recvFunc func() (*filev1.DownloadFileResponse, error)
 }
@@ -400,6 +627,9 @@ func TestUploadPart_Success(t *testing.T) {
 			if req.PartNumber != 1 {
 				t.Errorf("expected part_number 1, got %d", req.PartNumber)
 			}
+			if string(req.Chunk) != "chunk data here" {
+				t.Errorf("expected streamed chunk data, got %q", req.Chunk)
+			}
 			return &filev1.UploadPartResponse{
 				Etag:       "\"etag1\"",
 				PartNumber: 1,
@@ -432,6 +662,32 @@ func TestUploadPart_Success(t *testing.T) {
 	}
 }
 
+func TestUploadPart_ChecksumMismatch(t *testing.T) {
+	mockClient := &mockFileClient{
+		uploadPartFunc: func(ctx context.Context, req *filev1.UploadPartRequest) (*filev1.UploadPartResponse, error) {
+			t.Error("a mismatched part should not be stored")
+			return nil, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("chunk", "chunk.bin")
+	part.Write([]byte("hello there"))
+	writer.Close()
+
+	req, _ := http.NewRequest("POST", "/api/files/multipart/upload-abc/part/1", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set(ChecksumHeader, helloWorldSHA256)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d: %s", http.StatusBadRequest, w.Code, w.Body.String())
+	}
+}
+
 func TestUploadPart_InvalidPartNumber(t *testing.T) {
 	mockClient := &mockFileClient{}
 	handler := NewFileHandler(mockClient)
@@ -467,6 +723,31 @@ func TestUploadPart_MissingChunk(t *testing.T) {
 	}
 }
 
+func TestUploadPart_ChunkTooLarge(t *testing.T) {
+	mockClient := &mockFileClient{
+		uploadPartFunc: func(ctx context.Context, req *filev1.UploadPartRequest) (*filev1.UploadPartResponse, error) {
+			t.Error("oversized part should not be stored")
+			return nil, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("chunk", "chunk.bin")
+	part.Write(bytes.Repeat([]byte("x"), maxPartSize+1))
+	writer.Close()
+
+	req, _ := http.NewRequest("POST", "/api/files/multipart/upload-abc/part/1", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
 func TestCompleteMultipartUpload_Success(t *testing.T) {
 	mockClient := &mockFileClient{
 		completeMultipartFunc: func(ctx context.Context, req *filev1.CompleteMultipartUploadRequest) (*filev1.FileResponse, error) {
@@ -509,6 +790,54 @@ func TestCompleteMultipartUpload_Success(t *testing.T) {
 	}
 }
 
+func TestCompleteMultipartUpload_PartsChecksum(t *testing.T) {
+	const checksum = "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+	mockClient := &mockFileClient{
+		completeMultipartFunc: func(ctx context.Context, req *filev1.CompleteMultipartUploadRequest) (*filev1.FileResponse, error) {
+			if req.PartsSha256 != checksum {
+				t.Errorf("expected parts_sha256 %s, got %q", checksum, req.PartsSha256)
+			}
+			return &filev1.FileResponse{
+				File: &filev1.File{Id: "file-done", PartsSha256: checksum},
+			}, nil
+		},
+	}
+
+	handler := NewFileHandler(mockClient)
+	router := setupFileTestRouter(handler)
+
+	body := bytes.NewBufferString(`{"parts":[{"part_number":1,"etag":"\"e1\""}],"parts_sha256":"` + strings.ToUpper(checksum) + `"}`)
+	req, _ := http.NewRequest("POST", "/api/files/multipart/upload-abc/complete", body)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var resp FileResponse
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if resp.File.PartsSHA256 != checksum {
+		t.Errorf("expected parts_sha256 in response, got %q", resp.File.PartsSHA256)
+	}
+}
+
+func TestCompleteMultipartUpload_InvalidPartsChecksum(t *testing.T) {
+	mockClient := &mockFileClient{}
+	handler := NewFileHandler(mockClient)
+	router := setupFileTestRouter(handler)
+
+	body := bytes.NewBufferString(`{"parts":[{"part_number":1,"etag":"\"e1\""}],"parts_sha256":"abc"}`)
+	req, _ := http.NewRequest("POST", "/api/files/multipart/upload-abc/complete", body)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
 func TestCompleteMultipartUpload_InvalidBody(t *testing.T) {
 	mockClient := &mockFileClient{}
 	handler := NewFileHandler(mockClient)
@@ -591,6 +920,95 @@ func TestAbortMultipartUpload_NoUser(t *testing.T) {
 	}
 }
 
+func TestListMultipartUploads_Success(t *testing.T) {
+	mockClient := &mockFileClient{
+		listMultipartFunc: func(ctx context.Context, req *filev1.ListMultipartUploadsRequest) (*filev1.ListMultipartUploadsResponse, error) {
+			return &filev1.ListMultipartUploadsResponse{
+				Uploads: []*filev1.MultipartUpload{
+					{UploadId: "upload-abc", Filename: "video.mp4", TotalSize: 25, TotalParts: 3, PartsUploaded: 2, UploadedBytes: 20},
+				},
+			}, nil
+		},
+	}
+
+	handler := NewFileHandler(mockClient)
+	router := setupFileTestRouter(handler)
+
+	req, _ := http.NewRequest("GET", "/api/files/multipart", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	var resp ListMultipartUploadsResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(resp.Uploads) != 1 || resp.Uploads[0].UploadID != "upload-abc" || resp.Uploads[0].UploadedBytes != 20 {
+		t.Errorf("unexpected uploads %+v", resp.Uploads)
+	}
+}
+
+func TestListParts_Success(t *testing.T) {
+	mockClient := &mockFileClient{
+		listPartsFunc: func(ctx context.Context, req *filev1.ListPartsRequest) (*filev1.ListPartsResponse, error) {
+			if req.UploadId != "upload-abc" {
+				t.Errorf("expected upload_id upload-abc, got %s", req.UploadId)
+			}
+			return &filev1.ListPartsResponse{
+				Upload: &filev1.MultipartUpload{UploadId: "upload-abc", TotalParts: 3},
+				Parts: []*filev1.UploadedPart{
+					{PartNumber: 1, Etag: `"etag1"`, Size: 10},
+					{PartNumber: 3, Etag: `"etag3"`, Size: 5},
+				},
+			}, nil
+		},
+	}
+
+	handler := NewFileHandler(mockClient)
+	router := setupFileTestRouter(handler)
+
+	req, _ := http.NewRequest("GET", "/api/files/multipart/upload-abc", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	var resp MultipartUploadPartsResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if resp.Upload.UploadID != "upload-abc" || len(resp.Parts) != 2 {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+	if resp.Parts[1] != (UploadedPartResponse{PartNumber: 3, Etag: `"etag3"`, Size: 5}) {
+		t.Errorf("unexpected part %+v", resp.Parts[1])
+	}
+}
+
+func TestListParts_NotFound(t *testing.T) {
+	mockClient := &mockFileClient{
+		listPartsFunc: func(ctx context.Context, req *filev1.ListPartsRequest) (*filev1.ListPartsResponse, error) {
+			return nil, status.Error(codes.NotFound, "Upload session not found or expired")
+		},
+	}
+
+	handler := NewFileHandler(mockClient)
+	router := setupFileTestRouter(handler)
+
+	req, _ := http.NewRequest("GET", "/api/files/multipart/upload-gone", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
 func TestDownloadFile_Success(t *testing.T) {
 	callCount := 0
 	mockStream := &mockDownloadStream{
@@ -618,6 +1036,7 @@ func TestDownloadFile_Success(t *testing.T) {
 	}
 
 	mockClient := &mockFileClient{
+		getFileFunc: stubGetFile,
 		downloadFileFunc: func(ctx context.Context, req *filev1.DownloadFileRequest) (filev1.FileService_DownloadFileClient, error) {
 			return mockStream, nil
 		},
@@ -767,6 +1186,176 @@ func TestUploadFile_NoUser(t *testing.T) {
 	}
 }
 
+func stubGetFile(ctx context.Context, req *filev1.GetFileRequest) (*filev1.FileResponse, error) {
+	return &filev1.FileResponse{
+		File: &filev1.File{
+			Id:          req.Id,
+			Filename:    "test.txt",
+			Size:        12,
+			ContentType: "text/plain",
+			CreatedAt:   1704067200,
+		},
+	}, nil
+}
+
+// rangeDownloadClient serves "test content" and honours the requested range.
+func rangeDownloadClient(t *testing.T, wantOffset, wantLength int64) *mockFileClient {
+	const content = "test content"
+	return &mockFileClient{
+		getFileFunc: stubGetFile,
+		downloadFileFunc: func(ctx context.Context, req *filev1.DownloadFileRequest) (filev1.FileService_DownloadFileClient, error) {
+			if req.Offset != wantOffset || req.Length != wantLength {
+				t.Errorf("expected offset %d length %d, got %d %d", wantOffset, wantLength, req.Offset, req.Length)
+			}
+			body := content[req.Offset:]
+			if req.Length > 0 {
+				body = body[:req.Length]
+			}
+			sent := 0
+			return &mockDownloadStream{
+				recvFunc: func() (*filev1.DownloadFileResponse, error) {
+					sent++
+					switch sent {
+					case 1:
+						return &filev1.DownloadFileResponse{
+							Data: &filev1.DownloadFileResponse_Metadata{
+								Metadata: &filev1.DownloadFileMetadata{Filename: "test.txt", ContentType: "text/plain", Size: int64(len(content))},
+							},
+						}, nil
+					case 2:
+						return &filev1.DownloadFileResponse{Data: &filev1.DownloadFileResponse_Chunk{Chunk: []byte(body)}}, nil
+					}
+					return nil, io.EOF
+				},
+			}, nil
+		},
+	}
+}
+
+func TestDownloadFile_Range(t *testing.T) {
+	router := setupFileTestRouter(NewFileHandler(rangeDownloadClient(t, 5, 7)))
+
+	req, _ := http.NewRequest("GET", "/api/files/file-1/download", nil)
+	req.Header.Set("Range", "bytes=5-")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusPartialContent {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusPartialContent, w.Code, w.Body.String())
+	}
+	if got := w.Header().Get("Content-Range"); got != "bytes 5-11/12" {
+		t.Errorf("expected Content-Range bytes 5-11/12, got %q", got)
+	}
+	if got := w.Header().Get("Content-Length"); got != "7" {
+		t.Errorf("expected Content-Length 7, got %q", got)
+	}
+	if w.Body.String() != "content" {
+		t.Errorf("expected body 'content', got %q", w.Body.String())
+	}
+}
+
+func TestDownloadFile_RangeNotSatisfiable(t *testing.T) {
+	router := setupFileTestRouter(NewFileHandler(&mockFileClient{getFileFunc: stubGetFile}))
+
+	req, _ := http.NewRequest("GET", "/api/files/file-1/download", nil)
+	req.Header.Set("Range", "bytes=100-200")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusRequestedRangeNotSatisfiable {
+		t.Fatalf("expected status %d, got %d", http.StatusRequestedRangeNotSatisfiable, w.Code)
+	}
+	if got := w.Header().Get("Content-Range"); got != "bytes */12" {
+		t.Errorf("expected Content-Range bytes */12, got %q", got)
+	}
+}
+
+func TestDownloadFile_IfRangeMismatchSendsWholeFile(t *testing.T) {
+	router := setupFileTestRouter(NewFileHandler(rangeDownloadClient(t, 0, 0)))
+
+	req, _ := http.NewRequest("GET", "/api/files/file-1/download", nil)
+	req.Header.Set("Range", "bytes=0-3")
+	req.Header.Set("If-Range", `"stale"`)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK || w.Body.String() != "test content" {
+		t.Errorf("expected the whole file, got %d %q", w.Code, w.Body.String())
+	}
+}
+
+func TestDownloadFile_NotModified(t *testing.T) {
+	router := setupFileTestRouter(NewFileHandler(&mockFileClient{getFileFunc: stubGetFile}))
+
+	for name, header := range map[string][2]string{
+		"etag": {"If-None-Match", `W/"other", "file-1-1704067200"`},
+		"date": {"If-Modified-Since", "Mon, 01 Jan 2024 00:00:00 GMT"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", "/api/files/file-1/download", nil)
+			req.Header.Set(header[0], header[1])
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != http.StatusNotModified {
+				t.Errorf("expected status %d, got %d", http.StatusNotModified, w.Code)
+			}
+			if got := w.Header().Get("ETag"); got != `"file-1-1704067200"` {
+				t.Errorf("unexpected ETag %q", got)
+			}
+			if w.Body.Len() != 0 {
+				t.Errorf("expected empty body, got %q", w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHeadFile(t *testing.T) {
+	router := setupFileTestRouter(NewFileHandler(&mockFileClient{getFileFunc: stubGetFile}))
+
+	req, _ := http.NewRequest("HEAD", "/api/files/file-1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	for header, want := range map[string]string{
+		"Content-Length": "12",
+		"Content-Type":   "text/plain",
+		"Accept-Ranges":  "bytes",
+		"ETag":           `"file-1-1704067200"`,
+		"Last-Modified":  "Mon, 01 Jan 2024 00:00:00 GMT",
+	} {
+		if got := w.Header().Get(header); got != want {
+			t.Errorf("expected %s %q, got %q", header, want, got)
+		}
+	}
+}
+
+func TestHeadFile_Digest(t *testing.T) {
+	mockClient := &mockFileClient{
+		getFileFunc: func(ctx context.Context, req *filev1.GetFileRequest) (*filev1.FileResponse, error) {
+			return &filev1.FileResponse{File: &filev1.File{Id: req.Id, Size: 11, CreatedAt: 1704067200, Sha256: helloWorldSHA256}}, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	req, _ := http.NewRequest("HEAD", "/api/files/file-1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if got, want := w.Header().Get("Digest"), "sha-256=uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek="; got != want {
+		t.Errorf("expected Digest %q, got %q", want, got)
+	}
+	if got, want := w.Header().Get("ETag"), `"`+helloWorldSHA256+`"`; got != want {
+		t.Errorf("expected ETag %q, got %q", want, got)
+	}
+}
+
 func TestDownloadFile_NoUser(t *testing.T) {
 	mockClient := &mockFileClient{}
 	handler := NewFileHandler(mockClient)
@@ -785,6 +1374,7 @@ func TestDownloadFile_NoUser(t *testing.T) {
 
 func TestDownloadFile_StreamError(t *testing.T) {
 	mockClient := &mockFileClient{
+		getFileFunc: stubGetFile,
 		downloadFileFunc: func(ctx context.Context, req *filev1.DownloadFileRequest) (filev1.FileService_DownloadFileClient, error) {
 			return nil, status.Error(codes.NotFound, "not found")
 		},
@@ -945,6 +1535,7 @@ func TestDownloadFile_RecvMetadataError(t *testing.T) {
 		},
 	}
 	mockClient := &mockFileClient{
+		getFileFunc: stubGetFile,
 		downloadFileFunc: func(ctx context.Context, req *filev1.DownloadFileRequest) (filev1.FileService_DownloadFileClient, error) {
 			return mockStream, nil
 		},
@@ -964,6 +1555,7 @@ func TestDownloadFile_RecvMetadataError(t *testing.T) {
 
 func TestDownloadFile_PermissionDenied(t *testing.T) {
 	mockClient := &mockFileClient{
+		getFileFunc: stubGetFile,
 		downloadFileFunc: func(ctx context.Context, req *filev1.DownloadFileRequest) (filev1.FileService_DownloadFileClient, error) {
 			return nil, status.Error(codes.PermissionDenied, "not yours")
 		},
@@ -983,6 +1575,7 @@ func TestDownloadFile_PermissionDenied(t *testing.T) {
 
 func TestDownloadFile_InvalidArgument(t *testing.T) {
 	mockClient := &mockFileClient{
+		getFileFunc: stubGetFile,
 		downloadFileFunc: func(ctx context.Context, req *filev1.DownloadFileRequest) (filev1.FileService_DownloadFileClient, error) {
 			return nil, status.Error(codes.InvalidArgument, "invalid file id")
 		},
@@ -999,3 +1592,208 @@ func TestDownloadFile_InvalidArgument(t *testing.T) {
 		t.Errorf("expected %d, got %d", http.StatusBadRequest, w.Code)
 	}
 }
+
+func TestUpdateFile_Rename(t *testing.T) {
+	mockClient := &mockFileClient{
+		updateFileFunc: func(ctx context.Context, req *filev1.UpdateFileRequest) (*filev1.FileResponse, error) {
+			if req.Id != "file-1" || req.Filename != "final.txt" {
+				t.Errorf("unexpected request %v", req)
+			}
+			if paths := req.UpdateMask.GetPaths(); len(paths) != 1 || paths[0] != "filename" {
+				t.Errorf("expected mask [filename], got %v", paths)
+			}
+			if req.OnConflict != filev1.ConflictPolicy_CONFLICT_POLICY_RENAME {
+				t.Errorf("expected rename policy, got %v", req.OnConflict)
+			}
+			return &filev1.FileResponse{File: &filev1.File{Id: req.Id, Filename: "final (1).txt", ContentType: "text/plain"}}, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	req, _ := http.NewRequest("PATCH", "/api/files/file-1", bytes.NewBufferString(`{"filename":"final.txt","on_conflict":"rename"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var resp FileResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if resp.File.Filename != "final (1).txt" {
+		t.Errorf("unexpected file %+v", resp.File)
+	}
+}
+
+func TestUpdateFile_MoveToTopLevel(t *testing.T) {
+	mockClient := &mockFileClient{
+		updateFileFunc: func(ctx context.Context, req *filev1.UpdateFileRequest) (*filev1.FileResponse, error) {
+			if paths := req.UpdateMask.GetPaths(); len(paths) != 2 || paths[0] != "content_type" || paths[1] != "parent_id" {
+				t.Errorf("expected mask [content_type parent_id], got %v", paths)
+			}
+			if req.ParentId != "" || req.ContentType != "text/markdown" {
+				t.Errorf("unexpected request %v", req)
+			}
+			return &filev1.FileResponse{File: &filev1.File{Id: req.Id}}, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	req, _ := http.NewRequest("PATCH", "/api/files/file-1", bytes.NewBufferString(`{"content_type":"text/markdown","folder_id":"root"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+}
+
+func TestUpdateFile_BadRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"empty body", `{}`},
+		{"unknown conflict policy", `{"filename":"a.txt","on_conflict":"overwrite"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := setupFileTestRouter(NewFileHandler(&mockFileClient{}))
+
+			req, _ := http.NewRequest("PATCH", "/api/files/file-1", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+		})
+	}
+}
+
+func TestUpdateFile_Conflict(t *testing.T) {
+	mockClient := &mockFileClient{
+		updateFileFunc: func(ctx context.Context, req *filev1.UpdateFileRequest) (*filev1.FileResponse, error) {
+			return nil, status.Error(codes.AlreadyExists, "A file named final.txt already exists in this folder")
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	req, _ := http.NewRequest("PATCH", "/api/files/file-1", bytes.NewBufferString(`{"filename":"final.txt"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Errorf("expected status %d, got %d", http.StatusConflict, w.Code)
+	}
+}
+
+func TestUpdateFile_TagsAndMetadata(t *testing.T) {
+	mockClient := &mockFileClient{
+		updateFileFunc: func(ctx context.Context, req *filev1.UpdateFileRequest) (*filev1.FileResponse, error) {
+			if paths := req.UpdateMask.GetPaths(); len(paths) != 2 || paths[0] != "tags" || paths[1] != "metadata" {
+				t.Errorf("expected mask [tags metadata], got %v", paths)
+			}
+			if len(req.Tags) != 0 || req.Metadata["project"] != "apollo" {
+				t.Errorf("unexpected request %v", req)
+			}
+			return &filev1.FileResponse{File: &filev1.File{Id: req.Id, Metadata: req.Metadata}}, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	req, _ := http.NewRequest("PATCH", "/api/files/file-1", bytes.NewBufferString(`{"tags":[],"metadata":{"project":"apollo"}}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var resp FileResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if resp.File.Metadata["project"] != "apollo" {
+		t.Errorf("unexpected metadata %v", resp.File.Metadata)
+	}
+}
+
+func TestListFiles_Paging(t *testing.T) {
+	mockClient := &mockFileClient{
+		listFilesFunc: func(ctx context.Context, req *filev1.ListFilesRequest) (*filev1.ListFilesResponse, error) {
+			if req.PageSize != 2 || req.PageToken != "page-2" {
+				t.Errorf("unexpected paging %d %q", req.PageSize, req.PageToken)
+			}
+			if req.SortBy != filev1.FileSortField_FILE_SORT_FIELD_SIZE || !req.Descending {
+				t.Errorf("unexpected sort %v descending=%v", req.SortBy, req.Descending)
+			}
+			return &filev1.ListFilesResponse{
+				Files:         []*filev1.File{{Id: "file-3"}, {Id: "file-4"}},
+				TotalCount:    7,
+				NextPageToken: "page-3",
+				PrevPageToken: "page-1",
+			}, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	req, _ := http.NewRequest("GET", "/api/files?sort=size&order=desc&page_size=2&page_token=page-2", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	want := `</api/files?order=desc&page_size=2&page_token=page-3&sort=size>; rel="next", ` +
+		`</api/files?order=desc&page_size=2&page_token=page-1&sort=size>; rel="prev"`
+	if got := w.Header().Get("Link"); got != want {
+		t.Errorf("unexpected Link header\n got: %s\nwant: %s", got, want)
+	}
+	var resp ListFilesResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if resp.TotalCount != 7 || resp.NextPageToken != "page-3" || resp.PrevPageToken != "page-1" {
+		t.Errorf("unexpected paging in response %+v", resp)
+	}
+}
+
+func TestListFiles_SinglePageHasNoLinks(t *testing.T) {
+	mockClient := &mockFileClient{
+		listFilesFunc: func(ctx context.Context, req *filev1.ListFilesRequest) (*filev1.ListFilesResponse, error) {
+			return &filev1.ListFilesResponse{Files: []*filev1.File{{Id: "file-1"}}, TotalCount: 1}, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	req, _ := http.NewRequest("GET", "/api/files", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if link := w.Header().Get("Link"); link != "" {
+		t.Errorf("expected no Link header, got %q", link)
+	}
+}
+
+func TestListFiles_InvalidSort(t *testing.T) {
+	router := setupFileTestRouter(NewFileHandler(&mockFileClient{}))
+
+	for _, query := range []string{"sort=owner", "order=up", "page_size=ten"} {
+		req, _ := http.NewRequest("GET", "/api/files?"+query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status %d, got %d", query, http.StatusBadRequest, w.Code)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+)
+
+// RootFolderID names the top level wherever the API takes a folder ID. The
+// file service represents it as an empty ID.
+const RootFolderID = "root"
+
+type FolderHandler struct {
+	files *FileHandler
+}
+
+func NewFolderHandler(files *FileHandler) *FolderHandler {
+	return &FolderHandler{files: files}
+}
+
+// CreateFolder godoc
+// @Summary      Create a folder
+// @Description  Create a folder at the top level or inside another folder. Names must be unique within their parent.
+// @Tags         folders
+// @Accept       json
+// @Produce      json
+// @Param        request body CreateFolderRequest true "Folder name and parent"
+// @Success      201 {object} FolderResponse "Folder created"
+// @Failure      400 {object} ErrorResponse "Invalid name or parent ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Parent folder not found"
+// @Failure      409 {object} ErrorResponse "A folder with that name already exists in the parent"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/folders [post]
+func (h *FolderHandler) CreateFolder(c *gin.Context) {
+	if _, err := h.files.getUserFromContext(c); err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req CreateFolderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	folder, err := h.files.client.CreateFolder(h.files.contextWithAuth(c), &filev1.CreateFolderRequest{
+		Name:     req.Name,
+		ParentId: parentFolderID(req.ParentID),
+	})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, folderResponse(folder))
+}
+
+// RenameFolder godoc
+// @Summary      Rename a folder
+// @Tags         folders
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Folder ID"
+// @Param        request body RenameFolderRequest true "New name"
+// @Success      200 {object} FolderResponse "Folder renamed"
+// @Failure      400 {object} ErrorResponse "Invalid name or folder ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Folder not found"
+// @Failure      409 {object} ErrorResponse "A folder with that name already exists in the parent"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/folders/{id} [patch]
+func (h *FolderHandler) RenameFolder(c *gin.Context) {
+	if _, err := h.files.getUserFromContext(c); err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req RenameFolderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	folder, err := h.files.client.RenameFolder(h.files.contextWithAuth(c), &filev1.RenameFolderRequest{
+		Id:   c.Param("id"),
+		Name: req.Name,
+	})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, folderResponse(folder))
+}
+
+// MoveFolder godoc
+// @Summary      Move a folder
+// @Description  Move a folder, with everything in it, under another folder. An empty parent_id or root moves it to the top level.
+// @Tags         folders
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Folder ID"
+// @Param        request body MoveFolderRequest true "New parent"
+// @Success      200 {object} FolderResponse "Folder moved"
+// @Failure      400 {object} ErrorResponse "Invalid folder ID, or moving a folder into itself"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Folder not found"
+// @Failure      409 {object} ErrorResponse "A folder with that name already exists in the new parent"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/folders/{id}/move [post]
+func (h *FolderHandler) MoveFolder(c *gin.Context) {
+	if _, err := h.files.getUserFromContext(c); err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req MoveFolderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	folder, err := h.files.client.MoveFolder(h.files.contextWithAuth(c), &filev1.MoveFolderRequest{
+		Id:       c.Param("id"),
+		ParentId: parentFolderID(req.ParentID),
+	})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, folderResponse(folder))
+}
+
+// DeleteFolder godoc
+// @Summary      Delete a folder
+// @Description  Delete a folder together with all of its subfolders and every file in them
+// @Tags         folders
+// @Produce      json
+// @Param        id path string true "Folder ID"
+// @Success      200 {object} DeleteFolderResponse "Folder deleted"
+// @Failure      400 {object} ErrorResponse "Invalid folder ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Folder not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/folders/{id} [delete]
+func (h *FolderHandler) DeleteFolder(c *gin.Context) {
+	if _, err := h.files.getUserFromContext(c); err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.files.client.DeleteFolder(h.files.contextWithAuth(c), &filev1.DeleteFolderRequest{Id: c.Param("id")})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, DeleteFolderResponse{
+		Success:        resp.Success,
+		FoldersDeleted: resp.FoldersDeleted,
+		FilesDeleted:   resp.FilesDeleted,
+	})
+}
+
+// parentFolderID translates an API folder ID into the file service's form.
+func parentFolderID(id string) string {
+	if id == RootFolderID {
+		return ""
+	}
+	return id
+}
+
+func folderResponse(folder *filev1.Folder) FolderResponse {
+	return FolderResponse{
+		ID:        folder.Id,
+		Name:      folder.Name,
+		ParentID:  folder.ParentId,
+		CreatedAt: folder.CreatedAt,
+	}
+}
+
+func folderResponses(folders []*filev1.Folder) []FolderResponse {
+	out := make([]FolderResponse, len(folders))
+	for i, folder := range folders {
+		out[i] = folderResponse(folder)
+	}
+	return out
+}
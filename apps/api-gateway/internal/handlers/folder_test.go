@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func setupFolderTestRouter(h *FolderHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("user", &userv1.User{Id: "user-123", Username: "testuser", Role: userv1.Role_ROLE_USER})
+		c.Next()
+	})
+	router.POST("/api/folders", h.CreateFolder)
+	router.PATCH("/api/folders/:id", h.RenameFolder)
+	router.POST("/api/folders/:id/move", h.MoveFolder)
+	router.DELETE("/api/folders/:id", h.DeleteFolder)
+
+	return router
+}
+
+func TestCreateFolder_Success(t *testing.T) {
+	mockClient := &mockFileClient{
+		createFolderFunc: func(ctx context.Context, req *filev1.CreateFolderRequest) (*filev1.Folder, error) {
+			if req.Name != "Reports" || req.ParentId != "" {
+				t.Errorf("unexpected request %v", req)
+			}
+			return &filev1.Folder{Id: "folder-1", Name: req.Name, CreatedAt: 1704067200}, nil
+		},
+	}
+	router := setupFolderTestRouter(NewFolderHandler(NewFileHandler(mockClient)))
+
+	req, _ := http.NewRequest("POST", "/api/folders", bytes.NewBufferString(`{"name":"Reports","parent_id":"root"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	var resp FolderResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if resp.ID != "folder-1" || resp.Name != "Reports" {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestCreateFolder_Conflict(t *testing.T) {
+	mockClient := &mockFileClient{
+		createFolderFunc: func(ctx context.Context, req *filev1.CreateFolderRequest) (*filev1.Folder, error) {
+			return nil, status.Error(codes.AlreadyExists, "A folder with that name already exists here")
+		},
+	}
+	router := setupFolderTestRouter(NewFolderHandler(NewFileHandler(mockClient)))
+
+	req, _ := http.NewRequest("POST", "/api/folders", bytes.NewBufferString(`{"name":"Reports"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Errorf("expected status %d, got %d", http.StatusConflict, w.Code)
+	}
+}
+
+func TestMoveFolder_ToTopLevel(t *testing.T) {
+	mockClient := &mockFileClient{
+		moveFolderFunc: func(ctx context.Context, req *filev1.MoveFolderRequest) (*filev1.Folder, error) {
+			if req.Id != "folder-2" || req.ParentId != "" {
+				t.Errorf("unexpected request %v", req)
+			}
+			return &filev1.Folder{Id: req.Id, Name: "b"}, nil
+		},
+	}
+	router := setupFolderTestRouter(NewFolderHandler(NewFileHandler(mockClient)))
+
+	req, _ := http.NewRequest("POST", "/api/folders/folder-2/move", bytes.NewBufferString(`{"parent_id":"root"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+}
+
+func TestDeleteFolder_Success(t *testing.T) {
+	mockClient := &mockFileClient{
+		deleteFolderFunc: func(ctx context.Context, req *filev1.DeleteFolderRequest) (*filev1.DeleteFolderResponse, error) {
+			return &filev1.DeleteFolderResponse{Success: true, FoldersDeleted: 2, FilesDeleted: 3}, nil
+		},
+	}
+	router := setupFolderTestRouter(NewFolderHandler(NewFileHandler(mockClient)))
+
+	req, _ := http.NewRequest("DELETE", "/api/folders/folder-1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	var resp DeleteFolderResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if resp.FoldersDeleted != 2 || resp.FilesDeleted != 3 {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestListFiles_InFolder(t *testing.T) {
+	mockClient := &mockFileClient{
+		listFilesFunc: func(ctx context.Context, req *filev1.ListFilesRequest) (*filev1.ListFilesResponse, error) {
+			if req.FolderId == nil || *req.FolderId != "folder-2" {
+				t.Errorf("expected folder-2, got %v", req.FolderId)
+			}
+			return &filev1.ListFilesResponse{
+				Files:       []*filev1.File{{Id: "file-1", Filename: "notes.txt", ParentId: "folder-2"}},
+				Folders:     []*filev1.Folder{{Id: "folder-3", Name: "c", ParentId: "folder-2"}},
+				Breadcrumbs: []*filev1.Folder{{Id: "folder-1", Name: "a"}, {Id: "folder-2", Name: "b", ParentId: "folder-1"}},
+			}, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	req, _ := http.NewRequest("GET", "/api/files?folder_id=folder-2", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	var resp ListFilesResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(resp.Files) != 1 || resp.Files[0].FolderID != "folder-2" {
+		t.Errorf("unexpected files %+v", resp.Files)
+	}
+	if len(resp.Folders) != 1 || resp.Folders[0].Name != "c" {
+		t.Errorf("unexpected folders %+v", resp.Folders)
+	}
+	if len(resp.Breadcrumbs) != 2 || resp.Breadcrumbs[1].ID != "folder-2" {
+		t.Errorf("unexpected breadcrumbs %+v", resp.Breadcrumbs)
+	}
+}
+
+func TestListFiles_WithoutFolderListsEverything(t *testing.T) {
+	mockClient := &mockFileClient{
+		listFilesFunc: func(ctx context.Context, req *filev1.ListFilesRequest) (*filev1.ListFilesResponse, error) {
+			if req.FolderId != nil {
+				t.Errorf("expected no folder, got %q", *req.FolderId)
+			}
+			return &filev1.ListFilesResponse{}, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	req, _ := http.NewRequest("GET", "/api/files", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	var body map[string]any
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if _, ok := body["breadcrumbs"]; ok {
+		t.Error("expected no breadcrumbs without a folder")
+	}
+}
+
+func TestUploadFile_IntoFolder(t *testing.T) {
+	var metadata *filev1.UploadFileMetadata
+	mockClient := &mockFileClient{
+		uploadFileFunc: func(ctx context.Context) (filev1.FileService_UploadFileClient, error) {
+			return &mockUploadStream{
+				sendFunc: func(req *filev1.UploadFileRequest) error {
+					if m := req.GetMetadata(); m != nil {
+						metadata = m
+					}
+					return nil
+				},
+				closeAndRecvFunc: func() (*filev1.FileResponse, error) {
+					return &filev1.FileResponse{File: &filev1.File{Id: "file-1", ParentId: "folder-2"}}, nil
+				},
+			}, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	req, _ := http.NewRequest("POST", "/api/files?folder_id=folder-2", bytes.NewBufferString("test content"))
+	req.Header.Set(FilenameHeader, "notes.txt")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if metadata.GetParentId() != "folder-2" {
+		t.Errorf("expected upload into folder-2, got %q", metadata.GetParentId())
+	}
+}
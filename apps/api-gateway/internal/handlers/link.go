@@ -0,0 +1,274 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+)
+
+const (
+	defaultLinkTTL = 24 * time.Hour
+	maxLinkTTL     = 30 * 24 * time.Hour
+)
+
+var errInvalidLinkToken = errors.New("invalid or expired download link")
+
+// LinkHandler mints and serves signed download links. A link token carries
+// the link ID and expiry signed with HMAC-SHA256, so forged and expired
+// tokens are turned away without a call to the file service, which still
+// enforces revocation and download limits.
+type LinkHandler struct {
+	files     *FileHandler
+	secret    []byte
+	publicURL string
+	now       func() time.Time
+}
+
+// NewLinkHandler serves links through files. An empty secret disables links.
+// publicURL is the origin links are built on; when empty it is taken from
+// each request.
+func NewLinkHandler(files *FileHandler, secret, publicURL string) *LinkHandler {
+	return &LinkHandler{
+		files:     files,
+		secret:    []byte(secret),
+		publicURL: strings.TrimSuffix(publicURL, "/"),
+		now:       time.Now,
+	}
+}
+
+// CreateLink godoc
+// @Summary      Create a download link
+// @Description  Mint a signed URL that downloads the file without a bearer token.
+// @Description  Links expire after expires_in seconds (default 1 day, at most 30 days); max_downloads of 0 allows any number of downloads.
+// @Tags         files
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "File ID"
+// @Param        body body CreateDownloadLinkRequest false "Link options"
+// @Success      201 {object} DownloadLinkResponse "Link created"
+// @Failure      400 {object} ErrorResponse "Invalid request body"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "File not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Failure      503 {object} ErrorResponse "Download links are not configured"
+// @Security     BearerAuth
+// @Router       /api/files/{id}/links [post]
+func (h *LinkHandler) CreateLink(c *gin.Context) {
+	lookup, ok := h.enabledFor(c)
+	if !ok {
+		return
+	}
+
+	// The body is optional; without one the link gets the defaults.
+	var req CreateDownloadLinkRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	ttl := defaultLinkTTL
+	if req.ExpiresIn > 0 {
+		ttl = time.Duration(req.ExpiresIn) * time.Second
+	}
+	if ttl > maxLinkTTL {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "expires_in must be at most 30 days"})
+		return
+	}
+
+	link, err := h.files.client.CreateDownloadLink(h.files.contextWithAuth(c), &filev1.CreateDownloadLinkRequest{
+		FileId:       lookup.Id,
+		ExpiresAt:    h.now().Add(ttl).Unix(),
+		MaxDownloads: req.MaxDownloads,
+	})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, h.linkResponse(c, link))
+}
+
+// ListLinks godoc
+// @Summary      List download links
+// @Description  List the file's links that have not expired, been revoked or used up their downloads
+// @Tags         files
+// @Produce      json
+// @Param        id path string true "File ID"
+// @Success      200 {object} ListDownloadLinksResponse "Active links"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Failure      503 {object} ErrorResponse "Download links are not configured"
+// @Security     BearerAuth
+// @Router       /api/files/{id}/links [get]
+func (h *LinkHandler) ListLinks(c *gin.Context) {
+	lookup, ok := h.enabledFor(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.files.client.ListDownloadLinks(h.files.contextWithAuth(c), &filev1.ListDownloadLinksRequest{FileId: lookup.Id})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	links := make([]DownloadLinkResponse, len(resp.Links))
+	for i, link := range resp.Links {
+		links[i] = h.linkResponse(c, link)
+	}
+	c.JSON(http.StatusOK, ListDownloadLinksResponse{Links: links})
+}
+
+// RevokeLink godoc
+// @Summary      Revoke a download link
+// @Description  Stop a link from working before it expires
+// @Tags         files
+// @Produce      json
+// @Param        id path string true "File ID"
+// @Param        link_id path string true "Link ID"
+// @Success      200 {object} RevokeDownloadLinkResponse "Link revoked"
+// @Failure      400 {object} ErrorResponse "Invalid link ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Link not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Failure      503 {object} ErrorResponse "Download links are not configured"
+// @Security     BearerAuth
+// @Router       /api/files/{id}/links/{link_id} [delete]
+func (h *LinkHandler) RevokeLink(c *gin.Context) {
+	lookup, ok := h.enabledFor(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.files.client.RevokeDownloadLink(h.files.contextWithAuth(c), &filev1.RevokeDownloadLinkRequest{
+		FileId: lookup.Id,
+		Id:     c.Param("link_id"),
+	})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, RevokeDownloadLinkResponse{Success: resp.Success})
+}
+
+// Download godoc
+// @Summary      Download a file through a link
+// @Description  Public endpoint that downloads the file a signed link points to. No bearer token is needed.
+// @Description  Range and conditional requests work as on the authenticated download endpoint; each download counts towards the link's limit.
+// @Tags         files
+// @Produce      octet-stream
+// @Param        token path string true "Link token"
+// @Success      200 {file} binary "File content"
+// @Success      206 {file} binary "Requested byte range"
+// @Success      304 "File not modified"
+// @Failure      404 {object} ErrorResponse "Link is invalid, expired, revoked or used up"
+// @Failure      416 {object} ErrorResponse "Range not satisfiable"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Failure      503 {object} ErrorResponse "Download links are not configured"
+// @Router       /d/{token} [get]
+func (h *LinkHandler) Download(c *gin.Context) {
+	if !h.enabled(c) {
+		return
+	}
+
+	linkID, err := h.verify(c.Param("token"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Don't forward credentials the caller may have sent; the link alone
+	// decides access.
+	c.Request.Header.Del("Authorization")
+	h.files.serveFile(c, &filev1.GetFileRequest{LinkId: linkID})
+}
+
+func (h *LinkHandler) enabled(c *gin.Context) bool {
+	if len(h.secret) == 0 {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "download links are not configured"})
+		return false
+	}
+	return true
+}
+
+func (h *LinkHandler) enabledFor(c *gin.Context) (*filev1.GetFileRequest, bool) {
+	if !h.enabled(c) {
+		return nil, false
+	}
+	return h.files.requestedFile(c)
+}
+
+// sign returns the token for a link: "<id>.<expires>.<signature>".
+func (h *LinkHandler) sign(linkID string, expiresAt int64) string {
+	payload := linkID + "." + strconv.FormatInt(expiresAt, 10)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(h.mac(payload))
+}
+
+// verify checks a token's signature and expiry and returns its link ID.
+func (h *LinkHandler) verify(token string) (string, error) {
+	payload, sig, ok := cutLast(token, ".")
+	if !ok {
+		return "", errInvalidLinkToken
+	}
+	got, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(got, h.mac(payload)) {
+		return "", errInvalidLinkToken
+	}
+
+	linkID, expires, ok := strings.Cut(payload, ".")
+	if !ok || linkID == "" {
+		return "", errInvalidLinkToken
+	}
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || h.now().Unix() >= expiresAt {
+		return "", errInvalidLinkToken
+	}
+	return linkID, nil
+}
+
+func (h *LinkHandler) mac(payload string) []byte {
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+func (h *LinkHandler) linkResponse(c *gin.Context, link *filev1.DownloadLink) DownloadLinkResponse {
+	return DownloadLinkResponse{
+		ID:            link.Id,
+		URL:           h.origin(c) + "/d/" + h.sign(link.Id, link.ExpiresAt),
+		ExpiresAt:     link.ExpiresAt,
+		MaxDownloads:  link.MaxDownloads,
+		DownloadCount: link.DownloadCount,
+		CreatedAt:     link.CreatedAt,
+	}
+}
+
+func (h *LinkHandler) origin(c *gin.Context) string {
+	if h.publicURL != "" {
+		return h.publicURL
+	}
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + c.Request.Host
+}
+
+func cutLast(s, sep string) (before, after string, found bool) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return s, "", false
+	}
+	return s[:i], s[i+len(sep):], true
+}
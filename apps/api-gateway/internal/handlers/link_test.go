@@ -0,0 +1,250 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+)
+
+var linkTestNow = time.Unix(1704067200, 0)
+
+func newTestLinkHandler(client *mockFileClient, secret string) *LinkHandler {
+	h := NewLinkHandler(NewFileHandler(client), secret, "https://files.example.com/")
+	h.now = func() time.Time { return linkTestNow }
+	return h
+}
+
+func setupLinkTestRouter(h *LinkHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	files := router.Group("/api/files", func(c *gin.Context) {
+		c.Set("user", &userv1.User{Id: "user-123", Username: "testuser", Role: userv1.Role_ROLE_USER})
+		c.Next()
+	})
+	files.POST("/:id/links", h.CreateLink)
+	files.GET("/:id/links", h.ListLinks)
+	files.DELETE("/:id/links/:link_id", h.RevokeLink)
+	router.GET("/d/:token", h.Download)
+
+	return router
+}
+
+func TestLinkToken_RoundTrip(t *testing.T) {
+	h := newTestLinkHandler(&mockFileClient{}, "secret")
+	token := h.sign("link-1", linkTestNow.Add(time.Hour).Unix())
+
+	id, err := h.verify(token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "link-1" {
+		t.Errorf("expected link-1, got %q", id)
+	}
+}
+
+func TestLinkToken_Rejected(t *testing.T) {
+	h := newTestLinkHandler(&mockFileClient{}, "secret")
+	valid := h.sign("link-1", linkTestNow.Add(time.Hour).Unix())
+	other := newTestLinkHandler(&mockFileClient{}, "other-secret")
+
+	tests := map[string]string{
+		"expired":      h.sign("link-1", linkTestNow.Unix()),
+		"other link":   strings.Replace(valid, "link-1", "link-2", 1),
+		"other secret": other.sign("link-1", linkTestNow.Add(time.Hour).Unix()),
+		"no signature": "link-1.1704070800",
+		"garbage":      "not-a-token",
+	}
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := h.verify(token); err == nil {
+				t.Errorf("expected %q to be rejected", token)
+			}
+		})
+	}
+}
+
+func TestCreateLink_Success(t *testing.T) {
+	mockClient := &mockFileClient{
+		createLinkFunc: func(ctx context.Context, req *filev1.CreateDownloadLinkRequest) (*filev1.DownloadLink, error) {
+			if req.FileId != "file-1" {
+				t.Errorf("expected file-1, got %q", req.FileId)
+			}
+			if want := linkTestNow.Add(time.Hour).Unix(); req.ExpiresAt != want {
+				t.Errorf("expected expiry %d, got %d", want, req.ExpiresAt)
+			}
+			if req.MaxDownloads != 3 {
+				t.Errorf("expected 3 max downloads, got %d", req.MaxDownloads)
+			}
+			return &filev1.DownloadLink{
+				Id:           "link-1",
+				FileId:       req.FileId,
+				ExpiresAt:    req.ExpiresAt,
+				MaxDownloads: req.MaxDownloads,
+				CreatedAt:    linkTestNow.Unix(),
+			}, nil
+		},
+	}
+	h := newTestLinkHandler(mockClient, "secret")
+	router := setupLinkTestRouter(h)
+
+	req, _ := http.NewRequest("POST", "/api/files/file-1/links", bytes.NewBufferString(`{"expires_in":3600,"max_downloads":3}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	var resp DownloadLinkResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	token, found := strings.CutPrefix(resp.URL, "https://files.example.com/d/")
+	if !found {
+		t.Fatalf("unexpected link URL %q", resp.URL)
+	}
+	if id, err := h.verify(token); err != nil || id != "link-1" {
+		t.Errorf("expected URL to carry a valid token for link-1, got %q, %v", id, err)
+	}
+}
+
+func TestCreateLink_DefaultsWithoutBody(t *testing.T) {
+	mockClient := &mockFileClient{
+		createLinkFunc: func(ctx context.Context, req *filev1.CreateDownloadLinkRequest) (*filev1.DownloadLink, error) {
+			if want := linkTestNow.Add(defaultLinkTTL).Unix(); req.ExpiresAt != want {
+				t.Errorf("expected expiry %d, got %d", want, req.ExpiresAt)
+			}
+			return &filev1.DownloadLink{Id: "link-1", ExpiresAt: req.ExpiresAt}, nil
+		},
+	}
+	router := setupLinkTestRouter(newTestLinkHandler(mockClient, "secret"))
+
+	req, _ := http.NewRequest("POST", "/api/files/file-1/links", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Errorf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+}
+
+func TestCreateLink_ExpiryTooLong(t *testing.T) {
+	router := setupLinkTestRouter(newTestLinkHandler(&mockFileClient{}, "secret"))
+
+	req, _ := http.NewRequest("POST", "/api/files/file-1/links", bytes.NewBufferString(`{"expires_in":31536000}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestCreateLink_NotConfigured(t *testing.T) {
+	router := setupLinkTestRouter(newTestLinkHandler(&mockFileClient{}, ""))
+
+	req, _ := http.NewRequest("POST", "/api/files/file-1/links", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d, got %d", http.StatusServiceUnavailable, w.Code)
+	}
+}
+
+func TestRevokeLink_Success(t *testing.T) {
+	mockClient := &mockFileClient{
+		revokeLinkFunc: func(ctx context.Context, req *filev1.RevokeDownloadLinkRequest) (*filev1.RevokeDownloadLinkResponse, error) {
+			if req.FileId != "file-1" || req.Id != "link-1" {
+				t.Errorf("unexpected request %v", req)
+			}
+			return &filev1.RevokeDownloadLinkResponse{Success: true}, nil
+		},
+	}
+	router := setupLinkTestRouter(newTestLinkHandler(mockClient, "secret"))
+
+	req, _ := http.NewRequest("DELETE", "/api/files/file-1/links/link-1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestLinkDownload_Success(t *testing.T) {
+	mockClient := &mockFileClient{
+		getFileFunc: func(ctx context.Context, req *filev1.GetFileRequest) (*filev1.FileResponse, error) {
+			if req.LinkId != "link-1" || req.Id != "" {
+				t.Errorf("expected lookup by link-1, got %v", req)
+			}
+			return stubGetFile(ctx, &filev1.GetFileRequest{Id: "file-1"})
+		},
+		downloadFileFunc: func(ctx context.Context, req *filev1.DownloadFileRequest) (filev1.FileService_DownloadFileClient, error) {
+			if req.Id != "file-1" || req.LinkId != "link-1" {
+				t.Errorf("expected file-1 through link-1, got %v", req)
+			}
+			sent := 0
+			return &mockDownloadStream{
+				recvFunc: func() (*filev1.DownloadFileResponse, error) {
+					sent++
+					switch sent {
+					case 1:
+						return &filev1.DownloadFileResponse{
+							Data: &filev1.DownloadFileResponse_Metadata{
+								Metadata: &filev1.DownloadFileMetadata{Filename: "test.txt", ContentType: "text/plain", Size: 12},
+							},
+						}, nil
+					case 2:
+						return &filev1.DownloadFileResponse{Data: &filev1.DownloadFileResponse_Chunk{Chunk: []byte("test content")}}, nil
+					}
+					return nil, io.EOF
+				},
+			}, nil
+		},
+	}
+	h := newTestLinkHandler(mockClient, "secret")
+	router := setupLinkTestRouter(h)
+
+	req, _ := http.NewRequest("GET", "/d/"+h.sign("link-1", linkTestNow.Add(time.Hour).Unix()), nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if w.Body.String() != "test content" {
+		t.Errorf("expected body 'test content', got %q", w.Body.String())
+	}
+}
+
+func TestLinkDownload_InvalidToken(t *testing.T) {
+	mockClient := &mockFileClient{
+		getFileFunc: func(ctx context.Context, req *filev1.GetFileRequest) (*filev1.FileResponse, error) {
+			t.Error("file service should not be called for an invalid token")
+			return nil, nil
+		},
+	}
+	h := newTestLinkHandler(mockClient, "secret")
+	router := setupLinkTestRouter(h)
+
+	req, _ := http.NewRequest("GET", "/d/"+h.sign("link-1", linkTestNow.Add(-time.Minute).Unix()), nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
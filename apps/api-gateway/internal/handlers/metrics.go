@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/provsalt/DOP_P01_Team1/api-gateway/internal/handlers"
+
+const (
+	uploadSingle    = "single"
+	uploadMultipart = "multipart"
+	uploadTus       = "tus"
+)
+
+var (
+	uploadTypeKey     = attribute.Key("file.upload_type")
+	multipartEventKey = attribute.Key("file.multipart_event")
+)
+
+type fileMetrics struct {
+	uploadedBytes     metric.Int64Counter
+	downloadedBytes   metric.Int64Counter
+	uploadSize        metric.Int64Histogram
+	multipartSessions metric.Int64Counter
+}
+
+// newFileMetrics creates the instruments on the global meter provider, which
+// forwards to the SDK provider once telemetry.InitTelemetry has run.
+func newFileMetrics() *fileMetrics {
+	meter := otel.GetMeterProvider().Meter(meterName)
+	m := &fileMetrics{}
+	var err, errs error
+
+	m.uploadedBytes, err = meter.Int64Counter("files.uploaded.bytes",
+		metric.WithDescription("Bytes accepted by the file service, by upload type."),
+		metric.WithUnit("By"))
+	errs = errors.Join(errs, err)
+	m.downloadedBytes, err = meter.Int64Counter("files.downloaded.bytes",
+		metric.WithDescription("Bytes streamed to clients from file downloads."),
+		metric.WithUnit("By"))
+	errs = errors.Join(errs, err)
+	m.uploadSize, err = meter.Int64Histogram("files.upload.size",
+		metric.WithDescription("Size of completed uploads, by upload type."),
+		metric.WithUnit("By"),
+		metric.WithExplicitBucketBoundaries(1<<10, 64<<10, 1<<20, 10<<20, 100<<20, 1<<30))
+	errs = errors.Join(errs, err)
+	m.multipartSessions, err = meter.Int64Counter("files.multipart.sessions",
+		metric.WithDescription("Multipart upload sessions started, completed and aborted."),
+		metric.WithUnit("{session}"))
+	errs = errors.Join(errs, err)
+
+	if errs != nil {
+		otel.Handle(errs)
+	}
+	return m
+}
+
+func (m *fileMetrics) recordUploaded(ctx context.Context, uploadType string, n int64) {
+	m.uploadedBytes.Add(ctx, n, metric.WithAttributes(uploadTypeKey.String(uploadType)))
+}
+
+func (m *fileMetrics) recordDownloaded(ctx context.Context, n int64) {
+	m.downloadedBytes.Add(ctx, n)
+}
+
+func (m *fileMetrics) recordUploadComplete(ctx context.Context, uploadType string, size int64) {
+	m.uploadSize.Record(ctx, size, metric.WithAttributes(uploadTypeKey.String(uploadType)))
+}
+
+func (m *fileMetrics) recordMultipart(ctx context.Context, event string) {
+	m.multipartSessions.Add(ctx, 1, metric.WithAttributes(multipartEventKey.String(event)))
+}
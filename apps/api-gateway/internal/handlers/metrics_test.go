@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestFileMetrics_UploadBytesAndMultipartSessions(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	prev := otel.GetMeterProvider()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	defer otel.SetMeterProvider(prev)
+
+	mockClient := &mockFileClient{
+		uploadFileFunc: func(ctx context.Context) (filev1.FileService_UploadFileClient, error) {
+			return &mockUploadStream{
+				sendFunc: func(req *filev1.UploadFileRequest) error { return nil },
+				closeAndRecvFunc: func() (*filev1.FileResponse, error) {
+					return &filev1.FileResponse{File: &filev1.File{Id: "file-123", Size: 12}}, nil
+				},
+			}, nil
+		},
+		initiateMultipartFunc: func(ctx context.Context, req *filev1.InitiateMultipartUploadRequest) (*filev1.InitiateMultipartUploadResponse, error) {
+			return &filev1.InitiateMultipartUploadResponse{UploadId: "upload-abc"}, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", "upload.txt")
+	part.Write([]byte("test content"))
+	writer.Close()
+	req, _ := http.NewRequest("POST", "/api/files", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	req, _ = http.NewRequest("POST", "/api/files/multipart/initiate",
+		bytes.NewBufferString(`{"filename":"big.mp4","content_type":"video/mp4","total_size":1024}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	sums := make(map[string]metricdata.DataPoint[int64])
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if sum, ok := m.Data.(metricdata.Sum[int64]); ok {
+				sums[m.Name] = sum.DataPoints[0]
+			}
+		}
+	}
+
+	uploaded := sums["files.uploaded.bytes"]
+	if v, _ := uploaded.Attributes.Value(uploadTypeKey); uploaded.Value != 12 || v != attribute.StringValue(uploadSingle) {
+		t.Fatalf("expected 12 single-upload bytes, got %+v", uploaded)
+	}
+	sessions := sums["files.multipart.sessions"]
+	if v, _ := sessions.Attributes.Value(multipartEventKey); sessions.Value != 1 || v != attribute.StringValue("started") {
+		t.Fatalf("expected one started session, got %+v", sessions)
+	}
+}
@@ -47,11 +47,16 @@ type HealthResponse struct {
 
 // FileMetadata represents file metadata
 type FileMetadata struct {
-	ID          string `json:"id" example:"507f1f77bcf86cd799439011"`
-	Filename    string `json:"filename" example:"document.pdf"`
-	Size        int64  `json:"size" example:"1024000"`
-	ContentType string `json:"content_type" example:"application/pdf"`
-	CreatedAt   int64  `json:"created_at" example:"1704067200"`
+	ID          string            `json:"id" example:"507f1f77bcf86cd799439011"`
+	Filename    string            `json:"filename" example:"document.pdf"`
+	Size        int64             `json:"size" example:"1024000"`
+	ContentType string            `json:"content_type" example:"application/pdf"`
+	CreatedAt   int64             `json:"created_at" example:"1704067200"`
+	FolderID    string            `json:"folder_id" example:"65a1b2c3d4e5f60718293a4d"`
+	SHA256      string            `json:"sha256,omitempty" example:"b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"`
+	PartsSHA256 string            `json:"parts_sha256,omitempty" example:"5d41402abc4b2a76b9719d911017c592b94d27b9934d3e08a52e52d7da7dabfa"`
+	Tags        []string          `json:"tags,omitempty" example:"work,q3"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
 }
 
 // FileResponse represents the response for file upload
@@ -61,7 +66,12 @@ type FileResponse struct {
 
 // ListFilesResponse represents the response for listing files
 type ListFilesResponse struct {
-	Files []FileMetadata `json:"files"`
+	Files         []FileMetadata   `json:"files"`
+	Folders       []FolderResponse `json:"folders,omitempty"`
+	Breadcrumbs   []FolderResponse `json:"breadcrumbs,omitempty"`
+	TotalCount    int64            `json:"total_count" example:"137"`
+	NextPageToken string           `json:"next_page_token,omitempty" example:"NTA6YWJjZGVmMDEyMzQ1Njc4OQ"`
+	PrevPageToken string           `json:"prev_page_token,omitempty"`
 }
 
 // GetFileResponse represents the response for getting a single file
@@ -74,11 +84,39 @@ type DeleteFileResponse struct {
 	Success bool `json:"success" example:"true"`
 }
 
+// UpdateFileRequest represents the request to update a file's metadata.
+// Only the fields that are present are changed.
+type UpdateFileRequest struct {
+	Filename    *string           `json:"filename" example:"final-report.pdf"`
+	ContentType *string           `json:"content_type" example:"application/pdf"`
+	FolderID    *string           `json:"folder_id" example:"65a1b2c3d4e5f60718293a4d"`
+	Tags        []string          `json:"tags" example:"work,q3"`
+	Metadata    map[string]string `json:"metadata"`
+	OnConflict  string            `json:"on_conflict" binding:"omitempty,oneof=fail rename replace" enums:"fail,rename,replace" example:"rename"`
+}
+
+// FileTagsRequest represents the tags to add to a file
+type FileTagsRequest struct {
+	Tags []string `json:"tags" binding:"required,min=1" example:"work,q3"`
+}
+
+// TagResponse represents a tag and the number of files carrying it
+type TagResponse struct {
+	Name  string `json:"name" example:"work"`
+	Count int32  `json:"count" example:"4"`
+}
+
+// ListTagsResponse represents the tags in use by the current user
+type ListTagsResponse struct {
+	Tags []TagResponse `json:"tags"`
+}
+
 // InitiateMultipartUploadRequest represents the request to initiate a multipart upload
 type InitiateMultipartUploadRequest struct {
 	Filename    string `json:"filename" binding:"required" example:"large-video.mp4"`
 	ContentType string `json:"content_type" example:"video/mp4"`
 	TotalSize   int64  `json:"total_size" binding:"required" example:"1073741824"`
+	FolderID    string `json:"folder_id" example:"65a1b2c3d4e5f60718293a4d"`
 }
 
 // InitiateMultipartUploadResponse represents the response for initiating a multipart upload
@@ -92,6 +130,7 @@ type InitiateMultipartUploadResponse struct {
 type UploadPartResponse struct {
 	Etag       string `json:"etag" example:"\"etag1\""`
 	PartNumber int32  `json:"part_number" example:"1"`
+	SHA256     string `json:"sha256" example:"b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"`
 }
 
 // PartInfo represents a single part in the complete request
@@ -102,10 +141,208 @@ type PartInfo struct {
 
 // CompleteMultipartUploadRequest represents the request to complete a multipart upload
 type CompleteMultipartUploadRequest struct {
-	Parts []PartInfo `json:"parts" binding:"required"`
+	Parts       []PartInfo `json:"parts" binding:"required"`
+	PartsSHA256 string     `json:"parts_sha256" example:"5d41402abc4b2a76b9719d911017c592b94d27b9934d3e08a52e52d7da7dabfa"`
 }
 
 // AbortMultipartUploadResponse represents the response for aborting a multipart upload
 type AbortMultipartUploadResponse struct {
 	Success bool `json:"success" example:"true"`
 }
+
+// MultipartUploadResponse represents a multipart upload still in progress
+type MultipartUploadResponse struct {
+	UploadID      string `json:"upload_id" example:"abc123"`
+	Filename      string `json:"filename" example:"large-video.mp4"`
+	ContentType   string `json:"content_type" example:"video/mp4"`
+	TotalSize     int64  `json:"total_size" example:"1073741824"`
+	FolderID      string `json:"folder_id" example:"65a1b2c3d4e5f60718293a4d"`
+	ChunkSize     int32  `json:"chunk_size" example:"10485760"`
+	TotalParts    int32  `json:"total_parts" example:"103"`
+	PartsUploaded int32  `json:"parts_uploaded" example:"40"`
+	UploadedBytes int64  `json:"uploaded_bytes" example:"419430400"`
+	CreatedAt     int64  `json:"created_at" example:"1704067200"`
+	ExpiresAt     int64  `json:"expires_at" example:"1704672000"`
+}
+
+// ListMultipartUploadsResponse represents the current user's multipart uploads in progress
+type ListMultipartUploadsResponse struct {
+	Uploads []MultipartUploadResponse `json:"uploads"`
+}
+
+// UploadedPartResponse represents a part already received for a multipart upload
+type UploadedPartResponse struct {
+	PartNumber int32  `json:"part_number" example:"1"`
+	Etag       string `json:"etag" example:"\"etag1\""`
+	Size       int64  `json:"size" example:"10485760"`
+	SHA256     string `json:"sha256,omitempty" example:"b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"`
+}
+
+// MultipartUploadPartsResponse represents a multipart upload and the parts received so far
+type MultipartUploadPartsResponse struct {
+	Upload MultipartUploadResponse `json:"upload"`
+	Parts  []UploadedPartResponse  `json:"parts"`
+}
+
+// CreateDownloadLinkRequest represents the options for a new download link
+type CreateDownloadLinkRequest struct {
+	ExpiresIn    int64 `json:"expires_in" binding:"gte=0" example:"86400"`
+	MaxDownloads int32 `json:"max_downloads" binding:"gte=0" example:"5"`
+}
+
+// DownloadLinkResponse represents a signed download link
+type DownloadLinkResponse struct {
+	ID            string `json:"id" example:"65a1b2c3d4e5f60718293a4b"`
+	URL           string `json:"url" example:"https://files.example.com/d/65a1b2c3d4e5f60718293a4b.1704153600.c2lnbmF0dXJl"`
+	ExpiresAt     int64  `json:"expires_at" example:"1704153600"`
+	MaxDownloads  int32  `json:"max_downloads" example:"5"`
+	DownloadCount int32  `json:"download_count" example:"0"`
+	CreatedAt     int64  `json:"created_at" example:"1704067200"`
+}
+
+// ListDownloadLinksResponse represents the active links for a file
+type ListDownloadLinksResponse struct {
+	Links []DownloadLinkResponse `json:"links"`
+}
+
+// RevokeDownloadLinkResponse represents the response for revoking a link
+type RevokeDownloadLinkResponse struct {
+	Success bool `json:"success" example:"true"`
+}
+
+// ShareFileRequest represents the request to share a file with another user
+type ShareFileRequest struct {
+	Username   string `json:"username" binding:"required" example:"alice"`
+	Permission string `json:"permission" binding:"required,oneof=view download" enums:"view,download" example:"download"`
+}
+
+// ShareResponse represents a user a file is shared with
+type ShareResponse struct {
+	ID         string `json:"id" example:"65a1b2c3d4e5f60718293a4c"`
+	UserID     string `json:"user_id" example:"69654eb7a1135a809430d0b7"`
+	Username   string `json:"username" example:"alice"`
+	Permission string `json:"permission" enums:"view,download" example:"download"`
+	CreatedAt  int64  `json:"created_at" example:"1704067200"`
+}
+
+// ListSharesResponse represents the shares of a file
+type ListSharesResponse struct {
+	Shares []ShareResponse `json:"shares"`
+}
+
+// RevokeShareResponse represents the response for revoking a share
+type RevokeShareResponse struct {
+	Success bool `json:"success" example:"true"`
+}
+
+// SharedFileMetadata represents a file another user has shared
+type SharedFileMetadata struct {
+	FileMetadata
+	OwnerID    string `json:"owner_id" example:"69654eb7a1135a809430d0b7"`
+	Permission string `json:"permission" enums:"view,download" example:"view"`
+}
+
+// ListSharedFilesResponse represents the files shared with the current user
+type ListSharedFilesResponse struct {
+	Files []SharedFileMetadata `json:"files"`
+}
+
+// FolderResponse represents a folder
+type FolderResponse struct {
+	ID        string `json:"id" example:"65a1b2c3d4e5f60718293a4d"`
+	Name      string `json:"name" example:"Reports"`
+	ParentID  string `json:"parent_id" example:""`
+	CreatedAt int64  `json:"created_at" example:"1704067200"`
+}
+
+// CreateFolderRequest represents the request to create a folder
+type CreateFolderRequest struct {
+	Name     string `json:"name" binding:"required" example:"Reports"`
+	ParentID string `json:"parent_id" example:""`
+}
+
+// RenameFolderRequest represents the request to rename a folder
+type RenameFolderRequest struct {
+	Name string `json:"name" binding:"required" example:"Archive"`
+}
+
+// MoveFolderRequest represents the request to move a folder
+type MoveFolderRequest struct {
+	ParentID string `json:"parent_id" example:"65a1b2c3d4e5f60718293a4e"`
+}
+
+// DeleteFolderResponse represents the response for deleting a folder
+type DeleteFolderResponse struct {
+	Success        bool  `json:"success" example:"true"`
+	FoldersDeleted int32 `json:"folders_deleted" example:"2"`
+	FilesDeleted   int32 `json:"files_deleted" example:"5"`
+}
+
+// AdminFileMetadata represents any user's file as seen by an admin
+type AdminFileMetadata struct {
+	FileMetadata
+	OwnerID string `json:"owner_id" example:"69654eb7a1135a809430d0b7"`
+}
+
+// AdminFileResponse represents a single file as seen by an admin
+type AdminFileResponse struct {
+	File AdminFileMetadata `json:"file"`
+}
+
+// AdminListFilesResponse represents a page of files across users
+type AdminListFilesResponse struct {
+	Files         []AdminFileMetadata `json:"files"`
+	TotalCount    int64               `json:"total_count" example:"137"`
+	NextPageToken string              `json:"next_page_token,omitempty"`
+	PrevPageToken string              `json:"prev_page_token,omitempty"`
+}
+
+// AdminActionResponse represents a recorded admin access to a user's files
+type AdminActionResponse struct {
+	ID            string `json:"id" example:"65a1b2c3d4e5f60718293a4f"`
+	AdminID       string `json:"admin_id" example:"69654eb7a1135a809430d0b1"`
+	AdminUsername string `json:"admin_username" example:"admin"`
+	Action        string `json:"action" enums:"list_files,view_file,download_file,delete_file" example:"delete_file"`
+	FileID        string `json:"file_id,omitempty" example:"507f1f77bcf86cd799439011"`
+	OwnerID       string `json:"owner_id,omitempty" example:"69654eb7a1135a809430d0b7"`
+	Filename      string `json:"filename,omitempty" example:"document.pdf"`
+	CreatedAt     int64  `json:"created_at" example:"1704067200"`
+}
+
+// ListAdminActionsResponse represents the recorded admin actions
+type ListAdminActionsResponse struct {
+	Actions []AdminActionResponse `json:"actions"`
+}
+
+// UsageResponse represents a user's storage usage against their quota. A
+// limit of 0 is unlimited. Pending counts are multipart uploads still in
+// progress, which already count against the quota.
+type UsageResponse struct {
+	FileCount      int64 `json:"file_count" example:"12"`
+	MaxFiles       int64 `json:"max_files" example:"20"`
+	TotalBytes     int64 `json:"total_bytes" example:"524288000"`
+	MaxBytes       int64 `json:"max_bytes" example:"10737418240"`
+	PendingUploads int64 `json:"pending_uploads" example:"1"`
+	PendingBytes   int64 `json:"pending_bytes" example:"104857600"`
+}
+
+// QuotaLimits represents a storage quota. A limit of 0 is unlimited.
+type QuotaLimits struct {
+	MaxFiles int64 `json:"max_files" example:"20"`
+	MaxBytes int64 `json:"max_bytes" example:"10737418240"`
+}
+
+// QuotaResponse represents a user's storage quota and the default for their role
+type QuotaResponse struct {
+	UserID      string      `json:"user_id" example:"69654eb7a1135a809430d0b7"`
+	Quota       QuotaLimits `json:"quota"`
+	RoleDefault QuotaLimits `json:"role_default"`
+	Custom      bool        `json:"custom" example:"true"`
+}
+
+// SetQuotaRequest represents the request to override a user's storage quota.
+// A limit left out or null goes back to the role default.
+type SetQuotaRequest struct {
+	MaxFiles *int64 `json:"max_files" binding:"omitempty,min=0" example:"50"`
+	MaxBytes *int64 `json:"max_bytes" binding:"omitempty,min=0" example:"21474836480"`
+}
@@ -0,0 +1,202 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+)
+
+// uploadQuotaKey holds the caller's *uploadQuota once CheckUploadQuota has
+// looked it up.
+const uploadQuotaKey = "upload_quota"
+
+const errQuotaExceeded = "upload exceeds your storage quota"
+
+// QuotaHandler reports storage usage and lets admins change users' quotas.
+// Quotas live in the user service, defaulted per role; usage comes from the
+// file service.
+type QuotaHandler struct {
+	files *FileHandler
+	users UserServiceClient
+}
+
+func NewQuotaHandler(files *FileHandler, users UserServiceClient) *QuotaHandler {
+	return &QuotaHandler{files: files, users: users}
+}
+
+// uploadQuota is what is left of the caller's quota for a new upload.
+type uploadQuota struct {
+	quota     *filev1.StorageQuota
+	bytesLeft int64
+}
+
+// limit caps size at the bytes left, reporting whether the quota was the
+// tighter of the two. A nil uploadQuota leaves size alone.
+func (q *uploadQuota) limit(size int64) (int64, bool) {
+	if q == nil || q.quota.MaxBytes == 0 || q.bytesLeft >= size {
+		return size, false
+	}
+	return max(q.bytesLeft, 0), true
+}
+
+func (q *uploadQuota) storageQuota() *filev1.StorageQuota {
+	if q == nil {
+		return nil
+	}
+	return q.quota
+}
+
+func uploadQuotaFromContext(c *gin.Context) *uploadQuota {
+	quota, _ := c.Get(uploadQuotaKey)
+	q, _ := quota.(*uploadQuota)
+	return q
+}
+
+// CheckUploadQuota rejects uploads from users with no room left for another
+// file before any of the body is read. Otherwise it leaves the quota for the
+// upload handler, which checks the size and passes the quota on to the file
+// service.
+func (h *QuotaHandler) CheckUploadQuota(c *gin.Context) {
+	user, err := h.files.getUserFromContext(c)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	quotaResp, err := h.users.GetQuota(c, &userv1.GetQuotaRequest{UserId: user.Id})
+	if err != nil {
+		c.AbortWithStatusJSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+	usage, err := h.files.client.GetUsage(h.files.contextWithAuth(c), &filev1.GetUsageRequest{})
+	if err != nil {
+		c.AbortWithStatusJSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	quota := &filev1.StorageQuota{
+		MaxFiles: quotaResp.Quota.GetMaxFiles(),
+		MaxBytes: quotaResp.Quota.GetMaxBytes(),
+	}
+	if quota.MaxFiles > 0 && usage.FileCount+usage.PendingUploads >= quota.MaxFiles {
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+			"error": fmt.Sprintf("file quota reached: limit is %d files", quota.MaxFiles),
+		})
+		return
+	}
+
+	c.Set(uploadQuotaKey, &uploadQuota{
+		quota:     quota,
+		bytesLeft: quota.MaxBytes - usage.TotalBytes - usage.PendingBytes,
+	})
+	c.Next()
+}
+
+// Usage godoc
+// @Summary      Get storage usage
+// @Description  Show the authenticated user's file count and bytes stored against their quota. A limit of 0 is unlimited.
+// @Description  Multipart uploads still in progress count against the quota and are reported as pending.
+// @Tags         files
+// @Produce      json
+// @Success      200 {object} UsageResponse "Usage"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/me/usage [get]
+func (h *QuotaHandler) Usage(c *gin.Context) {
+	user, err := h.files.getUserFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	quotaResp, err := h.users.GetQuota(c, &userv1.GetQuotaRequest{UserId: user.Id})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+	usage, err := h.files.client.GetUsage(h.files.contextWithAuth(c), &filev1.GetUsageRequest{})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, UsageResponse{
+		FileCount:      usage.FileCount,
+		MaxFiles:       quotaResp.Quota.GetMaxFiles(),
+		TotalBytes:     usage.TotalBytes,
+		MaxBytes:       quotaResp.Quota.GetMaxBytes(),
+		PendingUploads: usage.PendingUploads,
+		PendingBytes:   usage.PendingBytes,
+	})
+}
+
+// GetQuota godoc
+// @Summary      Get a user's storage quota
+// @Description  Admin-only. Show a user's storage quota alongside the default for their role. A limit of 0 is unlimited.
+// @Tags         admin
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Success      200 {object} QuotaResponse "Quota"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "User not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/admin/users/{id}/quota [get]
+func (h *QuotaHandler) GetQuota(c *gin.Context) {
+	resp, err := h.users.GetQuota(c, &userv1.GetQuotaRequest{UserId: c.Param("id")})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, quotaResponse(c.Param("id"), resp))
+}
+
+// SetQuota godoc
+// @Summary      Set a user's storage quota
+// @Description  Admin-only. Override a user's file count and byte limits. A limit of 0 is unlimited;
+// @Description  leaving one out or null puts it back to the role default, so {} resets the user.
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Param        request body SetQuotaRequest true "New limits"
+// @Success      200 {object} QuotaResponse "Updated quota"
+// @Failure      400 {object} ErrorResponse "Invalid limits"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "User not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/admin/users/{id}/quota [put]
+func (h *QuotaHandler) SetQuota(c *gin.Context) {
+	var req SetQuotaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.users.SetQuota(c, &userv1.SetQuotaRequest{
+		UserId:   c.Param("id"),
+		MaxFiles: req.MaxFiles,
+		MaxBytes: req.MaxBytes,
+	})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, quotaResponse(c.Param("id"), resp))
+}
+
+func quotaResponse(userID string, resp *userv1.GetQuotaResponse) QuotaResponse {
+	return QuotaResponse{
+		UserID:      userID,
+		Quota:       QuotaLimits{MaxFiles: resp.Quota.GetMaxFiles(), MaxBytes: resp.Quota.GetMaxBytes()},
+		RoleDefault: QuotaLimits{MaxFiles: resp.RoleDefault.GetMaxFiles(), MaxBytes: resp.RoleDefault.GetMaxBytes()},
+		Custom:      resp.Custom,
+	}
+}
@@ -0,0 +1,252 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func setupQuotaTestRouter(h *QuotaHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("user", &userv1.User{Id: "user-123", Username: "testuser", Role: userv1.Role_ROLE_USER})
+		c.Next()
+	})
+	router.GET("/api/me/usage", h.Usage)
+	router.GET("/api/admin/users/:id/quota", h.GetQuota)
+	router.PUT("/api/admin/users/:id/quota", h.SetQuota)
+	router.POST("/api/files", h.CheckUploadQuota, h.files.UploadFile)
+	router.POST("/api/files/multipart/initiate", h.CheckUploadQuota, h.files.InitiateMultipartUpload)
+
+	return router
+}
+
+// quotaUsers returns a user client granting user-123 the given quota.
+func quotaUsers(t *testing.T, maxFiles, maxBytes int64) *mockUserClient {
+	return &mockUserClient{
+		getQuotaFunc: func(ctx context.Context, req *userv1.GetQuotaRequest) (*userv1.GetQuotaResponse, error) {
+			if req.UserId != "user-123" {
+				t.Errorf("unexpected user %q", req.UserId)
+			}
+			quota := &userv1.Quota{MaxFiles: maxFiles, MaxBytes: maxBytes}
+			return &userv1.GetQuotaResponse{Quota: quota, RoleDefault: quota}, nil
+		},
+	}
+}
+
+func usageFunc(files, bytes int64) func(context.Context, *filev1.GetUsageRequest) (*filev1.GetUsageResponse, error) {
+	return func(ctx context.Context, req *filev1.GetUsageRequest) (*filev1.GetUsageResponse, error) {
+		return &filev1.GetUsageResponse{FileCount: files, TotalBytes: bytes}, nil
+	}
+}
+
+func TestUsage_Success(t *testing.T) {
+	files := &mockFileClient{
+		getUsageFunc: func(ctx context.Context, req *filev1.GetUsageRequest) (*filev1.GetUsageResponse, error) {
+			return &filev1.GetUsageResponse{FileCount: 3, TotalBytes: 300, PendingUploads: 1, PendingBytes: 50}, nil
+		},
+	}
+	router := setupQuotaTestRouter(NewQuotaHandler(NewFileHandler(files), quotaUsers(t, 20, 1000)))
+
+	req, _ := http.NewRequest("GET", "/api/me/usage", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var resp UsageResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	want := UsageResponse{FileCount: 3, MaxFiles: 20, TotalBytes: 300, MaxBytes: 1000, PendingUploads: 1, PendingBytes: 50}
+	if resp != want {
+		t.Errorf("expected %+v, got %+v", want, resp)
+	}
+}
+
+func TestUploadFile_FileQuotaReached(t *testing.T) {
+	files := &mockFileClient{
+		getUsageFunc: usageFunc(20, 100),
+		uploadFileFunc: func(ctx context.Context) (filev1.FileService_UploadFileClient, error) {
+			t.Error("upload should not start")
+			return nil, nil
+		},
+	}
+	router := setupQuotaTestRouter(NewQuotaHandler(NewFileHandler(files), quotaUsers(t, 20, 0)))
+
+	req, _ := http.NewRequest("POST", "/api/files", bytes.NewBufferString("test content"))
+	req.Header.Set(FilenameHeader, "notes.txt")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusTooManyRequests {
+		t.Errorf("expected status %d, got %d", http.StatusTooManyRequests, w.Code)
+	}
+}
+
+func TestUploadFile_DeclaredSizeOverQuota(t *testing.T) {
+	files := &mockFileClient{
+		getUsageFunc: usageFunc(1, 95),
+		uploadFileFunc: func(ctx context.Context) (filev1.FileService_UploadFileClient, error) {
+			t.Error("upload should not start")
+			return nil, nil
+		},
+	}
+	router := setupQuotaTestRouter(NewQuotaHandler(NewFileHandler(files), quotaUsers(t, 20, 100)))
+
+	req, _ := http.NewRequest("POST", "/api/files", bytes.NewBufferString("test content"))
+	req.Header.Set(FilenameHeader, "notes.txt")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected status %d, got %d", http.StatusRequestEntityTooLarge, w.Code)
+	}
+	if !bytes.Contains(w.Body.Bytes(), []byte(errQuotaExceeded)) {
+		t.Errorf("expected quota error, got %s", w.Body.String())
+	}
+}
+
+func TestUploadFile_ForwardsQuota(t *testing.T) {
+	var metadata *filev1.UploadFileMetadata
+	files := &mockFileClient{
+		getUsageFunc: usageFunc(1, 10),
+		uploadFileFunc: func(ctx context.Context) (filev1.FileService_UploadFileClient, error) {
+			return &mockUploadStream{
+				sendFunc: func(req *filev1.UploadFileRequest) error {
+					if m := req.GetMetadata(); m != nil {
+						metadata = m
+					}
+					return nil
+				},
+				closeAndRecvFunc: func() (*filev1.FileResponse, error) {
+					return &filev1.FileResponse{File: &filev1.File{Id: "file-1", Size: 12}}, nil
+				},
+			}, nil
+		},
+	}
+	router := setupQuotaTestRouter(NewQuotaHandler(NewFileHandler(files), quotaUsers(t, 20, 100)))
+
+	req, _ := http.NewRequest("POST", "/api/files", bytes.NewBufferString("test content"))
+	req.Header.Set(FilenameHeader, "notes.txt")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if metadata.GetQuota().GetMaxFiles() != 20 || metadata.GetQuota().GetMaxBytes() != 100 {
+		t.Errorf("expected quota forwarded, got %v", metadata.GetQuota())
+	}
+}
+
+func TestInitiateMultipartUpload_OverQuota(t *testing.T) {
+	files := &mockFileClient{
+		getUsageFunc: usageFunc(1, 1<<30),
+		initiateMultipartFunc: func(ctx context.Context, req *filev1.InitiateMultipartUploadRequest) (*filev1.InitiateMultipartUploadResponse, error) {
+			t.Error("upload should not be initiated")
+			return nil, nil
+		},
+	}
+	router := setupQuotaTestRouter(NewQuotaHandler(NewFileHandler(files), quotaUsers(t, 0, 1<<30+1<<20)))
+
+	req, _ := http.NewRequest("POST", "/api/files/multipart/initiate", bytes.NewBufferString(`{"filename":"big.mp4","total_size":52428800}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected status %d, got %d: %s", http.StatusRequestEntityTooLarge, w.Code, w.Body.String())
+	}
+}
+
+func TestSetQuota_Success(t *testing.T) {
+	users := &mockUserClient{
+		setQuotaFunc: func(ctx context.Context, req *userv1.SetQuotaRequest) (*userv1.GetQuotaResponse, error) {
+			if req.UserId != "user-456" || req.MaxFiles == nil || *req.MaxFiles != 50 || req.MaxBytes != nil {
+				t.Errorf("unexpected request %v", req)
+			}
+			return &userv1.GetQuotaResponse{
+				Quota:       &userv1.Quota{MaxFiles: 50, MaxBytes: 1000},
+				RoleDefault: &userv1.Quota{MaxFiles: 20, MaxBytes: 1000},
+				Custom:      true,
+			}, nil
+		},
+	}
+	router := setupQuotaTestRouter(NewQuotaHandler(NewFileHandler(&mockFileClient{}), users))
+
+	req, _ := http.NewRequest("PUT", "/api/admin/users/user-456/quota", bytes.NewBufferString(`{"max_files":50}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var resp QuotaResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if !resp.Custom || resp.Quota.MaxFiles != 50 || resp.RoleDefault.MaxFiles != 20 {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestSetQuota_Negative(t *testing.T) {
+	router := setupQuotaTestRouter(NewQuotaHandler(NewFileHandler(&mockFileClient{}), &mockUserClient{}))
+
+	req, _ := http.NewRequest("PUT", "/api/admin/users/user-456/quota", bytes.NewBufferString(`{"max_bytes":-1}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestGetQuota_UnknownUser(t *testing.T) {
+	users := &mockUserClient{
+		getQuotaFunc: func(ctx context.Context, req *userv1.GetQuotaRequest) (*userv1.GetQuotaResponse, error) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		},
+	}
+	router := setupQuotaTestRouter(NewQuotaHandler(NewFileHandler(&mockFileClient{}), users))
+
+	req, _ := http.NewRequest("GET", "/api/admin/users/nobody/quota", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestCheckUploadQuota_NoUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := NewQuotaHandler(NewFileHandler(&mockFileClient{}), &mockUserClient{})
+	router := gin.New()
+	router.POST("/api/files", h.CheckUploadQuota, func(c *gin.Context) {
+		t.Error("expected the upload to be rejected before reaching the handler")
+	})
+
+	req, _ := http.NewRequest("POST", "/api/files", bytes.NewBufferString("data"))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+}
@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Share permissions as they appear in the API.
+const (
+	SharePermissionView     = "view"
+	SharePermissionDownload = "download"
+)
+
+var sharePermissions = map[string]filev1.SharePermission{
+	SharePermissionView:     filev1.SharePermission_SHARE_PERMISSION_VIEW,
+	SharePermissionDownload: filev1.SharePermission_SHARE_PERMISSION_DOWNLOAD,
+}
+
+// ShareHandler shares files with other users. Usernames are resolved through
+// the user service; the file service records the share and checks it when the
+// recipient reads the file.
+type ShareHandler struct {
+	files *FileHandler
+	users UserServiceClient
+}
+
+func NewShareHandler(files *FileHandler, users UserServiceClient) *ShareHandler {
+	return &ShareHandler{files: files, users: users}
+}
+
+// ShareFile godoc
+// @Summary      Share a file
+// @Description  Share a file with another user. "view" lets them see the file's metadata; "download" also lets them download it.
+// @Description  Sharing again with the same user replaces the permission.
+// @Tags         files
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "File ID"
+// @Param        request body ShareFileRequest true "Recipient and permission"
+// @Success      201 {object} ShareResponse "File shared"
+// @Failure      400 {object} ErrorResponse "Invalid request body or sharing with yourself"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "File or user not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/files/{id}/shares [post]
+func (h *ShareHandler) ShareFile(c *gin.Context) {
+	lookup, ok := h.files.requestedFile(c)
+	if !ok {
+		return
+	}
+
+	var req ShareFileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Check ownership before resolving the username, so only the file's owner
+	// learns whether an account exists.
+	if !h.ownsFile(c, lookup) {
+		return
+	}
+
+	userResp, err := h.users.GetUserByUsername(c, &userv1.GetUserByUsernameRequest{Username: req.Username})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+			return
+		}
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	share, err := h.files.client.ShareFile(h.files.contextWithAuth(c), &filev1.ShareFileRequest{
+		FileId:     lookup.Id,
+		UserId:     userResp.User.Id,
+		Username:   userResp.User.Username,
+		Permission: sharePermissions[req.Permission],
+	})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, shareResponse(share))
+}
+
+// ListShares godoc
+// @Summary      List a file's shares
+// @Description  List the users a file is shared with
+// @Tags         files
+// @Produce      json
+// @Param        id path string true "File ID"
+// @Success      200 {object} ListSharesResponse "Shares"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/files/{id}/shares [get]
+func (h *ShareHandler) ListShares(c *gin.Context) {
+	lookup, ok := h.files.requestedFile(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.files.client.ListShares(h.files.contextWithAuth(c), &filev1.ListSharesRequest{FileId: lookup.Id})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	shares := make([]ShareResponse, len(resp.Shares))
+	for i, share := range resp.Shares {
+		shares[i] = shareResponse(share)
+	}
+	c.JSON(http.StatusOK, ListSharesResponse{Shares: shares})
+}
+
+// RevokeShare godoc
+// @Summary      Revoke a share
+// @Description  Stop sharing a file with a user
+// @Tags         files
+// @Produce      json
+// @Param        id path string true "File ID"
+// @Param        share_id path string true "Share ID"
+// @Success      200 {object} RevokeShareResponse "Share revoked"
+// @Failure      400 {object} ErrorResponse "Invalid share ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Share not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/files/{id}/shares/{share_id} [delete]
+func (h *ShareHandler) RevokeShare(c *gin.Context) {
+	lookup, ok := h.files.requestedFile(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.files.client.RevokeShare(h.files.contextWithAuth(c), &filev1.RevokeShareRequest{
+		FileId: lookup.Id,
+		Id:     c.Param("share_id"),
+	})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, RevokeShareResponse{Success: resp.Success})
+}
+
+// SharedWithMe godoc
+// @Summary      List files shared with me
+// @Description  List files other users have shared with the authenticated user, with the permission each share grants
+// @Tags         files
+// @Produce      json
+// @Success      200 {object} ListSharedFilesResponse "Shared files"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/files/shared-with-me [get]
+func (h *ShareHandler) SharedWithMe(c *gin.Context) {
+	if _, err := h.files.getUserFromContext(c); err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.files.client.ListSharedWithMe(h.files.contextWithAuth(c), &filev1.ListSharedWithMeRequest{})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	files := make([]SharedFileMetadata, len(resp.Files))
+	for i, shared := range resp.Files {
+		file := shared.File
+		files[i] = SharedFileMetadata{
+			FileMetadata: fileMetadata(file),
+			OwnerID:      file.UserId,
+			Permission:   permissionName(shared.Permission),
+		}
+	}
+	c.JSON(http.StatusOK, ListSharedFilesResponse{Files: files})
+}
+
+func shareResponse(share *filev1.Share) ShareResponse {
+	return ShareResponse{
+		ID:         share.Id,
+		UserID:     share.UserId,
+		Username:   share.Username,
+		Permission: permissionName(share.Permission),
+		CreatedAt:  share.CreatedAt,
+	}
+}
+
+func permissionName(p filev1.SharePermission) string {
+	for name, value := range sharePermissions {
+		if value == p {
+			return name
+		}
+	}
+	return ""
+}
+
+// ownsFile reports whether the requesting user owns the file. It writes a 404
+// and returns false when they don't, the same as for a file that doesn't exist.
+func (h *ShareHandler) ownsFile(c *gin.Context, lookup *filev1.GetFileRequest) bool {
+	user, err := h.files.getUserFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return false
+	}
+
+	resp, err := h.files.client.GetFile(h.files.contextWithAuth(c), lookup)
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return false
+	}
+	if resp.GetFile().GetUserId() != user.Id {
+		c.JSON(http.StatusNotFound, gin.H{"error": "file not found"})
+		return false
+	}
+	return true
+}
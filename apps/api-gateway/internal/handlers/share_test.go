@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func setupShareTestRouter(h *ShareHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("user", &userv1.User{Id: "user-123", Username: "testuser", Role: userv1.Role_ROLE_USER})
+		c.Next()
+	})
+	router.GET("/api/files/shared-with-me", h.SharedWithMe)
+	router.POST("/api/files/:id/shares", h.ShareFile)
+	router.GET("/api/files/:id/shares", h.ListShares)
+	router.DELETE("/api/files/:id/shares/:share_id", h.RevokeShare)
+
+	return router
+}
+
+// ownedFile returns file-1 as owned by userID.
+func ownedFile(userID string) func(context.Context, *filev1.GetFileRequest) (*filev1.FileResponse, error) {
+	return func(ctx context.Context, req *filev1.GetFileRequest) (*filev1.FileResponse, error) {
+		return &filev1.FileResponse{File: &filev1.File{Id: req.Id, UserId: userID}}, nil
+	}
+}
+
+func TestShareFile_Success(t *testing.T) {
+	users := &mockUserClient{
+		getUserByUsernameFunc: func(ctx context.Context, req *userv1.GetUserByUsernameRequest) (*userv1.GetUserByUsernameResponse, error) {
+			return &userv1.GetUserByUsernameResponse{User: &userv1.User{Id: "user-456", Username: req.Username}}, nil
+		},
+	}
+	files := &mockFileClient{
+		getFileFunc: ownedFile("user-123"),
+		shareFileFunc: func(ctx context.Context, req *filev1.ShareFileRequest) (*filev1.Share, error) {
+			if req.FileId != "file-1" || req.UserId != "user-456" || req.Username != "alice" {
+				t.Errorf("unexpected request %v", req)
+			}
+			if req.Permission != filev1.SharePermission_SHARE_PERMISSION_DOWNLOAD {
+				t.Errorf("expected download permission, got %v", req.Permission)
+			}
+			return &filev1.Share{
+				Id:         "share-1",
+				FileId:     req.FileId,
+				UserId:     req.UserId,
+				Username:   req.Username,
+				Permission: req.Permission,
+				CreatedAt:  1704067200,
+			}, nil
+		},
+	}
+	router := setupShareTestRouter(NewShareHandler(NewFileHandler(files), users))
+
+	req, _ := http.NewRequest("POST", "/api/files/file-1/shares", bytes.NewBufferString(`{"username":"alice","permission":"download"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	var resp ShareResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if resp.ID != "share-1" || resp.Permission != SharePermissionDownload {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestShareFile_InvalidPermission(t *testing.T) {
+	router := setupShareTestRouter(NewShareHandler(NewFileHandler(&mockFileClient{}), &mockUserClient{}))
+
+	req, _ := http.NewRequest("POST", "/api/files/file-1/shares", bytes.NewBufferString(`{"username":"alice","permission":"owner"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestShareFile_UserNotFound(t *testing.T) {
+	users := &mockUserClient{
+		getUserByUsernameFunc: func(ctx context.Context, req *userv1.GetUserByUsernameRequest) (*userv1.GetUserByUsernameResponse, error) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		},
+	}
+	router := setupShareTestRouter(NewShareHandler(NewFileHandler(&mockFileClient{getFileFunc: ownedFile("user-123")}), users))
+
+	req, _ := http.NewRequest("POST", "/api/files/file-1/shares", bytes.NewBufferString(`{"username":"nobody","permission":"view"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestShareFile_NotOwnerSkipsUserLookup(t *testing.T) {
+	users := &mockUserClient{
+		getUserByUsernameFunc: func(ctx context.Context, req *userv1.GetUserByUsernameRequest) (*userv1.GetUserByUsernameResponse, error) {
+			t.Error("expected the username not to be looked up for a file the caller doesn't own")
+			return nil, status.Error(codes.NotFound, "user not found")
+		},
+	}
+	// A file shared with the caller is readable but not theirs to share.
+	files := &mockFileClient{getFileFunc: ownedFile("user-456")}
+	router := setupShareTestRouter(NewShareHandler(NewFileHandler(files), users))
+
+	req, _ := http.NewRequest("POST", "/api/files/file-1/shares", bytes.NewBufferString(`{"username":"nobody","permission":"view"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestRevokeShare_Success(t *testing.T) {
+	files := &mockFileClient{
+		revokeShareFunc: func(ctx context.Context, req *filev1.RevokeShareRequest) (*filev1.RevokeShareResponse, error) {
+			if req.FileId != "file-1" || req.Id != "share-1" {
+				t.Errorf("unexpected request %v", req)
+			}
+			return &filev1.RevokeShareResponse{Success: true}, nil
+		},
+	}
+	router := setupShareTestRouter(NewShareHandler(NewFileHandler(files), &mockUserClient{}))
+
+	req, _ := http.NewRequest("DELETE", "/api/files/file-1/shares/share-1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestSharedWithMe_Success(t *testing.T) {
+	files := &mockFileClient{
+		sharedWithMeFunc: func(ctx context.Context, req *filev1.ListSharedWithMeRequest) (*filev1.ListSharedWithMeResponse, error) {
+			return &filev1.ListSharedWithMeResponse{
+				Files: []*filev1.SharedFile{
+					{
+						File:       &filev1.File{Id: "file-9", UserId: "user-456", Filename: "report.pdf", Size: 2048},
+						Permission: filev1.SharePermission_SHARE_PERMISSION_VIEW,
+					},
+				},
+			}, nil
+		},
+	}
+	router := setupShareTestRouter(NewShareHandler(NewFileHandler(files), &mockUserClient{}))
+
+	req, _ := http.NewRequest("GET", "/api/files/shared-with-me", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var resp ListSharedFilesResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(resp.Files) != 1 {
+		t.Fatalf("expected 1 file, got %d", len(resp.Files))
+	}
+	got := resp.Files[0]
+	if got.ID != "file-9" || got.OwnerID != "user-456" || got.Permission != SharePermissionView {
+		t.Errorf("unexpected file %+v", got)
+	}
+}
+
+func TestDownloadFile_ViewOnlyShare(t *testing.T) {
+	files := &mockFileClient{
+		getFileFunc: stubGetFile,
+		downloadFileFunc: func(ctx context.Context, req *filev1.DownloadFileRequest) (filev1.FileService_DownloadFileClient, error) {
+			return nil, status.Error(codes.PermissionDenied, "File is shared with you for viewing only")
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(files))
+
+	req, _ := http.NewRequest("GET", "/api/files/file-1/download", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+)
+
+// TagHandler manages the tags on a user's files. Tags are stored trimmed and
+// lower-cased, so "Work" and "work " are the same tag.
+type TagHandler struct {
+	files *FileHandler
+}
+
+func NewTagHandler(files *FileHandler) *TagHandler {
+	return &TagHandler{files: files}
+}
+
+// ListTags godoc
+// @Summary      List tags
+// @Description  List every tag on the authenticated user's files with the number of files carrying it, sorted by name
+// @Tags         files
+// @Produce      json
+// @Success      200 {object} ListTagsResponse "Tags"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/files/tags [get]
+func (h *TagHandler) ListTags(c *gin.Context) {
+	if _, err := h.files.getUserFromContext(c); err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.files.client.ListTags(h.files.contextWithAuth(c), &filev1.ListTagsRequest{})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	tags := make([]TagResponse, len(resp.Tags))
+	for i, tag := range resp.Tags {
+		tags[i] = TagResponse{Name: tag.Name, Count: tag.Count}
+	}
+	c.JSON(http.StatusOK, ListTagsResponse{Tags: tags})
+}
+
+// AddTags godoc
+// @Summary      Tag a file
+// @Description  Add tags to a file. Tags it already has are left alone; a file can carry at most 20.
+// @Tags         files
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "File ID"
+// @Param        request body FileTagsRequest true "Tags to add"
+// @Success      200 {object} FileResponse "File with its new tags"
+// @Failure      400 {object} ErrorResponse "Invalid tag, or too many tags"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "File not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/files/{id}/tags [post]
+func (h *TagHandler) AddTags(c *gin.Context) {
+	lookup, ok := h.files.requestedFile(c)
+	if !ok {
+		return
+	}
+
+	var req FileTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.files.client.AddFileTags(h.files.contextWithAuth(c), &filev1.FileTagsRequest{
+		FileId: lookup.Id,
+		Tags:   req.Tags,
+	})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"file": fileMetadata(resp.File)})
+}
+
+// RemoveTag godoc
+// @Summary      Untag a file
+// @Description  Remove a tag from a file. Removing a tag the file doesn't have is not an error.
+// @Tags         files
+// @Produce      json
+// @Param        id path string true "File ID"
+// @Param        tag path string true "Tag"
+// @Success      200 {object} FileResponse "File with its remaining tags"
+// @Failure      400 {object} ErrorResponse "Invalid file ID or tag"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "File not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/files/{id}/tags/{tag} [delete]
+func (h *TagHandler) RemoveTag(c *gin.Context) {
+	lookup, ok := h.files.requestedFile(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.files.client.RemoveFileTags(h.files.contextWithAuth(c), &filev1.FileTagsRequest{
+		FileId: lookup.Id,
+		Tags:   []string{c.Param("tag")},
+	})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"file": fileMetadata(resp.File)})
+}
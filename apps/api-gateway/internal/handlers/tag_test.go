@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func setupTagTestRouter(h *TagHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		c.Set("user", &userv1.User{Id: "user-123", Username: "testuser", Role: userv1.Role_ROLE_USER})
+		c.Next()
+	})
+	router.GET("/api/files/tags", h.ListTags)
+	router.POST("/api/files/:id/tags", h.AddTags)
+	router.DELETE("/api/files/:id/tags/:tag", h.RemoveTag)
+
+	return router
+}
+
+func TestListTags_Success(t *testing.T) {
+	mockClient := &mockFileClient{
+		listTagsFunc: func(ctx context.Context, req *filev1.ListTagsRequest) (*filev1.ListTagsResponse, error) {
+			return &filev1.ListTagsResponse{Tags: []*filev1.Tag{{Name: "q3", Count: 1}, {Name: "work", Count: 4}}}, nil
+		},
+	}
+	router := setupTagTestRouter(NewTagHandler(NewFileHandler(mockClient)))
+
+	req, _ := http.NewRequest("GET", "/api/files/tags", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	var resp ListTagsResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(resp.Tags) != 2 || resp.Tags[1].Name != "work" || resp.Tags[1].Count != 4 {
+		t.Errorf("unexpected tags %+v", resp.Tags)
+	}
+}
+
+func TestAddTags_Success(t *testing.T) {
+	mockClient := &mockFileClient{
+		addTagsFunc: func(ctx context.Context, req *filev1.FileTagsRequest) (*filev1.FileResponse, error) {
+			if req.FileId != "file-1" || len(req.Tags) != 2 {
+				t.Errorf("unexpected request %v", req)
+			}
+			return &filev1.FileResponse{File: &filev1.File{Id: req.FileId, Tags: req.Tags}}, nil
+		},
+	}
+	router := setupTagTestRouter(NewTagHandler(NewFileHandler(mockClient)))
+
+	req, _ := http.NewRequest("POST", "/api/files/file-1/tags", bytes.NewBufferString(`{"tags":["work","q3"]}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var resp FileResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(resp.File.Tags) != 2 || resp.File.Tags[0] != "work" {
+		t.Errorf("unexpected tags %v", resp.File.Tags)
+	}
+}
+
+func TestAddTags_EmptyList(t *testing.T) {
+	router := setupTagTestRouter(NewTagHandler(NewFileHandler(&mockFileClient{})))
+
+	req, _ := http.NewRequest("POST", "/api/files/file-1/tags", bytes.NewBufferString(`{"tags":[]}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestAddTags_TooMany(t *testing.T) {
+	mockClient := &mockFileClient{
+		addTagsFunc: func(ctx context.Context, req *filev1.FileTagsRequest) (*filev1.FileResponse, error) {
+			return nil, status.Error(codes.InvalidArgument, "A file can have at most 20 tags")
+		},
+	}
+	router := setupTagTestRouter(NewTagHandler(NewFileHandler(mockClient)))
+
+	req, _ := http.NewRequest("POST", "/api/files/file-1/tags", bytes.NewBufferString(`{"tags":["one-more"]}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestRemoveTag_Success(t *testing.T) {
+	mockClient := &mockFileClient{
+		removeTagsFunc: func(ctx context.Context, req *filev1.FileTagsRequest) (*filev1.FileResponse, error) {
+			if req.FileId != "file-1" || len(req.Tags) != 1 || req.Tags[0] != "q3" {
+				t.Errorf("unexpected request %v", req)
+			}
+			return &filev1.FileResponse{File: &filev1.File{Id: req.FileId, Tags: []string{"work"}}}, nil
+		},
+	}
+	router := setupTagTestRouter(NewTagHandler(NewFileHandler(mockClient)))
+
+	req, _ := http.NewRequest("DELETE", "/api/files/file-1/tags/q3", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+}
+
+func TestListFiles_Search(t *testing.T) {
+	mockClient := &mockFileClient{
+		listFilesFunc: func(ctx context.Context, req *filev1.ListFilesRequest) (*filev1.ListFilesResponse, error) {
+			if req.Query != "report" || req.ContentType != "image" {
+				t.Errorf("unexpected request %v", req)
+			}
+			if len(req.Tags) != 2 || req.Tags[0] != "work" || req.Tags[1] != "q3" {
+				t.Errorf("expected tags [work q3], got %v", req.Tags)
+			}
+			if req.MinSize == nil || *req.MinSize != 1024 || req.MaxSize != nil {
+				t.Errorf("unexpected size range %v-%v", req.MinSize, req.MaxSize)
+			}
+			if req.CreatedAfter == nil || *req.CreatedAfter != 1700000000 {
+				t.Errorf("unexpected created_after %v", req.CreatedAfter)
+			}
+			return &filev1.ListFilesResponse{
+				Files: []*filev1.File{{Id: "file-1", Filename: "report.png", Tags: []string{"work", "q3"}}},
+			}, nil
+		},
+	}
+	router := setupFileTestRouter(NewFileHandler(mockClient))
+
+	req, _ := http.NewRequest("GET", "/api/files?q=report&tag=work&tag=q3&type=image&min_size=1024&created_after=1700000000", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var resp ListFilesResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(resp.Files) != 1 || len(resp.Files[0].Tags) != 2 {
+		t.Errorf("unexpected files %+v", resp.Files)
+	}
+}
+
+func TestListFiles_InvalidSizeFilter(t *testing.T) {
+	router := setupFileTestRouter(NewFileHandler(&mockFileClient{}))
+
+	for _, query := range []string{"min_size=big", "max_size=-1", "created_before=yesterday"} {
+		req, _ := http.NewRequest("GET", "/api/files?"+query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status %d, got %d", query, http.StatusBadRequest, w.Code)
+		}
+	}
+}
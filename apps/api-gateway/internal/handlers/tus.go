@@ -0,0 +1,569 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	tusVersion    = "1.0.0"
+	tusExtensions = "creation,termination,checksum"
+	// tusUploadTTL is how long a tus upload may take. It ends well before the
+	// file service drops the multipart session, so an expired upload can
+	// still be aborted when its owner is next seen.
+	tusUploadTTL = 24 * time.Hour
+	// tusExpiredRetention is how long expired uploads are kept for aborting:
+	// until the file service's 7-day multipart session ends.
+	tusExpiredRetention = 7*24*time.Hour - tusUploadTTL
+	// tusOffsetContentType is the only body PATCH accepts.
+	tusOffsetContentType = "application/offset+octet-stream"
+	// statusChecksumMismatch is the tus checksum extension's status for a
+	// body that doesn't match its Upload-Checksum.
+	statusChecksumMismatch = 460
+)
+
+// tusChecksums are the Upload-Checksum algorithms PATCH accepts.
+var tusChecksums = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+}
+
+// TusHandler serves the tus 1.0 resumable upload protocol with the creation,
+// termination and checksum extensions. Each tus upload is a multipart upload
+// in the file service; the handler cuts the bytes it receives into parts and
+// completes the upload once the last byte arrives.
+type TusHandler struct {
+	files   *FileHandler
+	uploads TusStore
+}
+
+func NewTusHandler(files *FileHandler, uploads TusStore) *TusHandler {
+	return &TusHandler{files: files, uploads: uploads}
+}
+
+// Options godoc
+// @Summary      Describe the tus server
+// @Description  Report the tus version, extensions, checksum algorithms and maximum upload size this server supports.
+// @Tags         tus
+// @Success      204 "Server capabilities in the Tus-* headers"
+// @Header       204 {string} Tus-Version "Supported protocol versions"
+// @Header       204 {string} Tus-Extension "Supported extensions"
+// @Header       204 {string} Tus-Checksum-Algorithm "Supported checksum algorithms"
+// @Header       204 {integer} Tus-Max-Size "Largest upload accepted, in bytes"
+// @Router       /api/files/tus [options]
+func (h *TusHandler) Options(c *gin.Context) {
+	c.Header("Tus-Resumable", tusVersion)
+	c.Header("Tus-Version", tusVersion)
+	c.Header("Tus-Extension", tusExtensions)
+	c.Header("Tus-Checksum-Algorithm", "md5,sha1,sha256")
+	c.Header("Tus-Max-Size", strconv.FormatInt(maxFileSize, 10))
+	c.Status(http.StatusNoContent)
+}
+
+// CreateUpload godoc
+// @Summary      Create a tus upload
+// @Description  Start a resumable upload of Upload-Length bytes, then send them with PATCH to the returned Location.
+// @Description  Upload-Metadata must carry filename (or name); filetype (or type) and folder_id are optional.
+// @Tags         tus
+// @Param        Tus-Resumable header string true "Protocol version" Enums(1.0.0)
+// @Param        Upload-Length header int true "Size of the file in bytes"
+// @Param        Upload-Metadata header string false "Comma-separated key and base64 value pairs"
+// @Success      201 "Upload created"
+// @Header       201 {string} Location "URL of the new upload"
+// @Failure      400 {object} ErrorResponse "Missing or invalid Upload-Length or Upload-Metadata"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      412 {object} ErrorResponse "Unsupported tus version"
+// @Failure      413 {object} ErrorResponse "Upload exceeds the maximum size of 2GB or the storage quota"
+// @Failure      429 {object} ErrorResponse "File quota reached, or too many uploads in progress"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/files/tus [post]
+func (h *TusHandler) CreateUpload(c *gin.Context) {
+	if !tusResumable(c) {
+		return
+	}
+	user, err := h.files.getUserFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	if c.GetHeader("Upload-Defer-Length") != "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Upload-Defer-Length is not supported"})
+		return
+	}
+	length, err := strconv.ParseInt(c.GetHeader("Upload-Length"), 10, 64)
+	if err != nil || length <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Upload-Length must be a positive integer"})
+		return
+	}
+	if length > maxFileSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": errFileTooLarge})
+		return
+	}
+	quota := uploadQuotaFromContext(c)
+	if _, overQuota := quota.limit(length); overQuota {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": errQuotaExceeded})
+		return
+	}
+
+	metadata, err := parseTusMetadata(c.GetHeader("Upload-Metadata"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	filename := firstNonEmpty(metadata["filename"], metadata["name"])
+	if filename == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Upload-Metadata must include filename"})
+		return
+	}
+
+	ctx := h.files.contextWithAuth(c)
+	h.abortExpired(ctx, user.Id)
+	resp, err := h.files.client.InitiateMultipartUpload(ctx, &filev1.InitiateMultipartUploadRequest{
+		Filename:    filename,
+		ContentType: firstNonEmpty(metadata["filetype"], metadata["type"]),
+		TotalSize:   length,
+		ParentId:    parentFolderID(metadata["folder_id"]),
+		Quota:       quota.storageQuota(),
+	})
+	if err != nil {
+		c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	id, err := newTusID()
+	if err == nil {
+		err = h.uploads.Create(ctx, &TusUpload{
+			ID:       id,
+			UserID:   user.Id,
+			UploadID: resp.UploadId,
+			Length:   length,
+			Metadata: c.GetHeader("Upload-Metadata"),
+			PartSize: int64(resp.ChunkSize),
+			Expires:  time.Now().Add(tusUploadTTL),
+		})
+	}
+	if err != nil {
+		h.abort(ctx, resp.UploadId)
+		if errors.Is(err, ErrTusTooManyUploads) {
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create upload"})
+		return
+	}
+
+	h.files.metrics.recordMultipart(ctx, "started")
+	c.Header("Location", "/api/files/tus/"+id)
+	c.Status(http.StatusCreated)
+}
+
+// GetOffset godoc
+// @Summary      Get a tus upload's offset
+// @Description  Report how many bytes of the upload have been received, so an interrupted upload can resume from there.
+// @Tags         tus
+// @Param        id path string true "Upload ID"
+// @Param        Tus-Resumable header string true "Protocol version" Enums(1.0.0)
+// @Success      200 "Upload state in the Upload-* headers"
+// @Header       200 {integer} Upload-Offset "Bytes received"
+// @Header       200 {integer} Upload-Length "Size of the file in bytes"
+// @Header       200 {string} Content-Location "The stored file, once the upload is complete"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Upload not found"
+// @Failure      412 {object} ErrorResponse "Unsupported tus version"
+// @Security     BearerAuth
+// @Router       /api/files/tus/{id} [head]
+func (h *TusHandler) GetOffset(c *gin.Context) {
+	if !tusResumable(c) {
+		return
+	}
+	upload, ok := h.upload(c, false)
+	if !ok {
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Header("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	c.Header("Upload-Length", strconv.FormatInt(upload.Length, 10))
+	if upload.Metadata != "" {
+		c.Header("Upload-Metadata", upload.Metadata)
+	}
+	if upload.FileID != "" {
+		c.Header("Content-Location", "/api/files/"+upload.FileID)
+	}
+	c.Status(http.StatusOK)
+}
+
+// AppendUpload godoc
+// @Summary      Append to a tus upload
+// @Description  Send the next bytes of the upload, starting at Upload-Offset. The upload is stored as a file once its last byte arrives.
+// @Description  With Upload-Checksum, the bytes are kept only if they match it.
+// @Tags         tus
+// @Accept       application/offset+octet-stream
+// @Param        id path string true "Upload ID"
+// @Param        Tus-Resumable header string true "Protocol version" Enums(1.0.0)
+// @Param        Upload-Offset header int true "Offset of the first byte sent"
+// @Param        Upload-Checksum header string false "Checksum algorithm and base64 digest of the body"
+// @Success      204 "Bytes received"
+// @Header       204 {integer} Upload-Offset "Bytes received in total"
+// @Header       204 {string} Content-Location "The stored file, once the upload is complete"
+// @Failure      400 {object} ErrorResponse "Invalid Upload-Offset or Upload-Checksum"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Upload not found"
+// @Failure      409 {object} ErrorResponse "Upload-Offset doesn't match, or another request is writing to the upload"
+// @Failure      412 {object} ErrorResponse "Unsupported tus version"
+// @Failure      413 {object} ErrorResponse "Body runs past Upload-Length"
+// @Failure      415 {object} ErrorResponse "Content-Type is not application/offset+octet-stream"
+// @Failure      460 {object} ErrorResponse "Body doesn't match Upload-Checksum"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/files/tus/{id} [patch]
+func (h *TusHandler) AppendUpload(c *gin.Context) {
+	if !tusResumable(c) {
+		return
+	}
+	if c.ContentType() != tusOffsetContentType {
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Content-Type must be " + tusOffsetContentType})
+		return
+	}
+	offset, err := strconv.ParseInt(c.GetHeader("Upload-Offset"), 10, 64)
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Upload-Offset must be a non-negative integer"})
+		return
+	}
+	checksum, want, err := parseUploadChecksum(c.GetHeader("Upload-Checksum"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	upload, ok := h.upload(c, true)
+	if !ok {
+		return
+	}
+	ctx := h.files.contextWithAuth(c)
+	defer h.uploads.Release(context.WithoutCancel(ctx), upload)
+
+	if upload.FileID != "" || offset != upload.Offset {
+		c.JSON(http.StatusConflict, gin.H{"error": "Upload-Offset does not match the upload"})
+		return
+	}
+
+	// The bytes only count once the whole body has arrived and matched its
+	// checksum. Parts already sent for them are overwritten when the client
+	// sends the bytes again.
+	before := *upload
+	var body io.Reader = http.MaxBytesReader(c.Writer, c.Request.Body, upload.Length-offset)
+	if checksum != nil {
+		body = io.TeeReader(body, checksum)
+	}
+	received, err := h.appendBody(ctx, upload, body)
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxBytesErr):
+		*upload = before
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "body runs past Upload-Length"})
+		return
+	case errors.Is(err, errSendChunk):
+		*upload = before
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to upload part"})
+		return
+	case err != nil:
+		// The client went away; keep what arrived unless it can't be checked.
+		if checksum != nil {
+			*upload = before
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read body"})
+		return
+	}
+	if checksum != nil && !bytes.Equal(checksum.Sum(nil), want) {
+		*upload = before
+		c.JSON(statusChecksumMismatch, gin.H{"error": "body does not match Upload-Checksum"})
+		return
+	}
+	h.files.metrics.recordUploaded(ctx, uploadTus, received)
+
+	if upload.Offset == upload.Length {
+		if err := h.complete(ctx, upload); err != nil {
+			c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+			return
+		}
+		c.Header("Content-Location", "/api/files/"+upload.FileID)
+	}
+
+	c.Header("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	c.Status(http.StatusNoContent)
+}
+
+// TerminateUpload godoc
+// @Summary      Terminate a tus upload
+// @Description  Abandon an upload and discard the bytes received. A completed upload's file is kept.
+// @Tags         tus
+// @Param        id path string true "Upload ID"
+// @Param        Tus-Resumable header string true "Protocol version" Enums(1.0.0)
+// @Success      204 "Upload terminated"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Upload not found"
+// @Failure      409 {object} ErrorResponse "Another request is writing to the upload"
+// @Failure      412 {object} ErrorResponse "Unsupported tus version"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Security     BearerAuth
+// @Router       /api/files/tus/{id} [delete]
+func (h *TusHandler) TerminateUpload(c *gin.Context) {
+	if !tusResumable(c) {
+		return
+	}
+	upload, ok := h.upload(c, true)
+	if !ok {
+		return
+	}
+	ctx := h.files.contextWithAuth(c)
+	defer h.uploads.Release(context.WithoutCancel(ctx), upload)
+
+	if upload.FileID == "" {
+		_, err := h.files.client.AbortMultipartUpload(ctx, &filev1.AbortMultipartUploadRequest{UploadId: upload.UploadID})
+		if err != nil && status.Code(err) != codes.NotFound {
+			c.JSON(mapGRPCError(err), gin.H{"error": err.Error()})
+			return
+		}
+		h.files.metrics.recordMultipart(ctx, "aborted")
+	}
+	if err := h.uploads.Delete(ctx, upload.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to terminate upload"})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// upload loads the requested upload, locking it if lock is set, and answers
+// 404 for uploads that belong to someone else.
+func (h *TusHandler) upload(c *gin.Context, lock bool) (*TusUpload, bool) {
+	user, err := h.files.getUserFromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	h.abortExpired(h.files.contextWithAuth(c), user.Id)
+
+	get := h.uploads.Get
+	if lock {
+		get = h.uploads.Lock
+	}
+	upload, err := get(c, c.Param("id"))
+	switch {
+	case errors.Is(err, ErrTusUploadNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return nil, false
+	case errors.Is(err, ErrTusUploadLocked):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return nil, false
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	if upload.UserID != user.Id {
+		if lock {
+			_ = h.uploads.Release(c, upload)
+		}
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrTusUploadNotFound.Error()})
+		return nil, false
+	}
+	return upload, true
+}
+
+// abortExpired aborts the multipart uploads behind the user's expired tus
+// uploads, so their parts are deleted and stop counting against the quota.
+// It needs the owner's credentials, so it runs on the owner's requests.
+func (h *TusHandler) abortExpired(ctx context.Context, userID string) {
+	expired, err := h.uploads.Expired(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list expired tus uploads", "error", err)
+		return
+	}
+	for _, upload := range expired {
+		if upload.FileID == "" {
+			h.abort(ctx, upload.UploadID)
+		}
+	}
+}
+
+// abort abandons a multipart upload the client can no longer finish.
+func (h *TusHandler) abort(ctx context.Context, uploadID string) {
+	_, err := h.files.client.AbortMultipartUpload(ctx, &filev1.AbortMultipartUploadRequest{UploadId: uploadID})
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			slog.ErrorContext(ctx, "Failed to abort tus upload", "upload_id", uploadID, "error", err)
+		}
+		return
+	}
+	h.files.metrics.recordMultipart(ctx, "aborted")
+}
+
+// appendBody reads body onto the end of upload, sending each part to the file
+// service as it fills and the last one once the upload is complete.
+func (h *TusHandler) appendBody(ctx context.Context, upload *TusUpload, body io.Reader) (int64, error) {
+	var received int64
+	buffer := make([]byte, 64*1024)
+	for {
+		room := upload.PartSize - int64(len(upload.Pending))
+		n, err := body.Read(buffer[:min(int64(len(buffer)), room)])
+		if n > 0 {
+			received += int64(n)
+			upload.Offset += int64(n)
+			upload.Pending = append(upload.Pending, buffer[:n]...)
+			if int64(len(upload.Pending)) == upload.PartSize || upload.Offset == upload.Length {
+				if sendErr := h.sendPart(ctx, upload); sendErr != nil {
+					return received, sendErr
+				}
+			}
+		}
+		if err == io.EOF {
+			return received, nil
+		}
+		if err != nil {
+			return received, err
+		}
+	}
+}
+
+// sendPart stores upload's pending bytes as its next part.
+func (h *TusHandler) sendPart(ctx context.Context, upload *TusUpload) error {
+	partNumber := int32(len(upload.Parts) + 1)
+	stream, err := h.files.client.UploadPartStream(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errSendChunk, err)
+	}
+	err = stream.Send(&filev1.UploadPartStreamRequest{
+		Data: &filev1.UploadPartStreamRequest_Metadata{
+			Metadata: &filev1.UploadPartMetadata{
+				UploadId:   upload.UploadID,
+				PartNumber: partNumber,
+				Sha256:     fmt.Sprintf("%x", sha256.Sum256(upload.Pending)),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errSendChunk, err)
+	}
+	_, err = streamChunks(bytes.NewReader(upload.Pending), upload.PartSize, func(chunk []byte) error {
+		return stream.Send(&filev1.UploadPartStreamRequest{
+			Data: &filev1.UploadPartStreamRequest_Chunk{Chunk: chunk},
+		})
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errSendChunk, err)
+	}
+
+	upload.Parts = append(upload.Parts[:len(upload.Parts):len(upload.Parts)], &filev1.PartInfo{PartNumber: partNumber, Etag: resp.Etag})
+	upload.Pending = nil
+	return nil
+}
+
+func (h *TusHandler) complete(ctx context.Context, upload *TusUpload) error {
+	resp, err := h.files.client.CompleteMultipartUpload(ctx, &filev1.CompleteMultipartUploadRequest{
+		UploadId: upload.UploadID,
+		Parts:    upload.Parts,
+	})
+	if err != nil {
+		return err
+	}
+	upload.FileID = resp.File.Id
+	h.files.metrics.recordMultipart(ctx, "completed")
+	h.files.metrics.recordUploadComplete(ctx, uploadTus, resp.File.Size)
+	return nil
+}
+
+// tusResumable rejects requests for a tus version other than 1.0.0, and
+// otherwise marks the response as tus.
+func tusResumable(c *gin.Context) bool {
+	c.Header("Tus-Resumable", tusVersion)
+	if c.GetHeader("Tus-Resumable") != tusVersion {
+		c.Header("Tus-Version", tusVersion)
+		c.JSON(http.StatusPreconditionFailed, gin.H{"error": "unsupported tus version"})
+		return false
+	}
+	return true
+}
+
+// parseTusMetadata decodes an Upload-Metadata header: comma-separated pairs
+// of a key and an optional base64 value.
+func parseTusMetadata(header string) (map[string]string, error) {
+	metadata := make(map[string]string)
+	if strings.TrimSpace(header) == "" {
+		return metadata, nil
+	}
+	for _, pair := range strings.Split(header, ",") {
+		key, encoded, _ := strings.Cut(strings.TrimSpace(pair), " ")
+		if key == "" {
+			return nil, errors.New("invalid Upload-Metadata")
+		}
+		value, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.New("invalid Upload-Metadata value for " + key)
+		}
+		metadata[key] = string(value)
+	}
+	return metadata, nil
+}
+
+// parseUploadChecksum reads an Upload-Checksum header, returning a hash to
+// feed the body through and the digest it must end with. An empty header
+// returns a nil hash.
+func parseUploadChecksum(header string) (hash.Hash, []byte, error) {
+	if header == "" {
+		return nil, nil, nil
+	}
+	algorithm, encoded, _ := strings.Cut(header, " ")
+	newHash, ok := tusChecksums[algorithm]
+	if !ok {
+		return nil, nil, errors.New("unsupported Upload-Checksum algorithm")
+	}
+	want, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, nil, errors.New("invalid Upload-Checksum digest")
+	}
+	return newHash(), want, nil
+}
+
+func newTusID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
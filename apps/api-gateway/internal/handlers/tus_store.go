@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+)
+
+var (
+	// ErrTusUploadNotFound is returned for unknown or expired tus uploads.
+	ErrTusUploadNotFound = errors.New("upload not found")
+	// ErrTusUploadLocked is returned by TusStore.Lock while another request
+	// holds the upload.
+	ErrTusUploadLocked = errors.New("upload is locked by another request")
+	// ErrTusTooManyUploads is returned by TusStore.Create when the user
+	// already has MaxTusUploadsPerUser uploads in progress.
+	ErrTusTooManyUploads = errors.New("too many uploads in progress")
+)
+
+// MaxTusUploadsPerUser bounds a user's unfinished tus uploads. Each may hold
+// up to a part's worth of bytes in gateway memory between requests.
+const MaxTusUploadsPerUser = 10
+
+// TusUpload is a tus upload in progress, backed by a multipart upload in the
+// file service. Parts are always PartSize bytes except the last, so bytes
+// received past the last full part are held in Pending until the next part
+// fills up or the upload ends.
+type TusUpload struct {
+	ID       string
+	UserID   string
+	UploadID string
+	Length   int64
+	// Offset is how many bytes of the upload have been received.
+	Offset int64
+	// Metadata is the Upload-Metadata header as sent, echoed back on HEAD.
+	Metadata string
+	PartSize int64
+	Parts    []*filev1.PartInfo
+	Pending  []byte
+	// FileID is set once the multipart upload has been completed.
+	FileID  string
+	Expires time.Time
+}
+
+// TusStore keeps tus uploads between requests. Lock hands out an upload for
+// changing and holds off other Locks until it is passed back to Release.
+// Expired uploads are hidden from Get and Lock but kept, until Expired hands
+// them over, so their multipart uploads can still be aborted.
+// Implementations must be safe for concurrent use.
+type TusStore interface {
+	Create(ctx context.Context, upload *TusUpload) error
+	Get(ctx context.Context, id string) (*TusUpload, error)
+	Lock(ctx context.Context, id string) (*TusUpload, error)
+	Release(ctx context.Context, upload *TusUpload) error
+	Delete(ctx context.Context, id string) error
+	// Expired removes and returns the user's expired uploads.
+	Expired(ctx context.Context, userID string) ([]*TusUpload, error)
+}
+
+// TusMemoryStore keeps tus uploads in process memory, so uploads can only be
+// resumed through the gateway instance that created them. Periodic sweeps
+// free the pending bytes of expired uploads, and forget them once the file
+// service would have dropped their multipart sessions anyway.
+type TusMemoryStore struct {
+	mu        sync.Mutex
+	uploads   map[string]*tusEntry
+	now       func() time.Time
+	lastSweep time.Time
+}
+
+type tusEntry struct {
+	upload TusUpload
+	locked bool
+}
+
+// NewTusMemoryStore returns an empty in-memory store.
+func NewTusMemoryStore() *TusMemoryStore {
+	return &TusMemoryStore{uploads: make(map[string]*tusEntry), now: time.Now}
+}
+
+// Create implements TusStore.
+func (s *TusMemoryStore) Create(_ context.Context, upload *TusUpload) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.lastSweep) >= time.Minute {
+		for id, e := range s.uploads {
+			if now.After(e.upload.Expires.Add(tusExpiredRetention)) {
+				delete(s.uploads, id)
+			} else if now.After(e.upload.Expires) {
+				e.upload.Pending = nil
+			}
+		}
+		s.lastSweep = now
+	}
+
+	inProgress := 0
+	for _, e := range s.uploads {
+		if e.upload.UserID == upload.UserID && e.upload.FileID == "" && !now.After(e.upload.Expires) {
+			inProgress++
+		}
+	}
+	if inProgress >= MaxTusUploadsPerUser {
+		return ErrTusTooManyUploads
+	}
+
+	s.uploads[upload.ID] = &tusEntry{upload: *upload}
+	return nil
+}
+
+// Get implements TusStore. The upload returned is a copy.
+func (s *TusMemoryStore) Get(_ context.Context, id string) (*TusUpload, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.entry(id)
+	if err != nil {
+		return nil, err
+	}
+	upload := e.upload
+	return &upload, nil
+}
+
+// Lock implements TusStore.
+func (s *TusMemoryStore) Lock(_ context.Context, id string) (*TusUpload, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.entry(id)
+	if err != nil {
+		return nil, err
+	}
+	if e.locked {
+		return nil, ErrTusUploadLocked
+	}
+	e.locked = true
+	upload := e.upload
+	return &upload, nil
+}
+
+// Release implements TusStore. Releasing an upload deleted while it was
+// locked does nothing.
+func (s *TusMemoryStore) Release(_ context.Context, upload *TusUpload) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.uploads[upload.ID]; ok {
+		s.uploads[upload.ID] = &tusEntry{upload: *upload}
+	}
+	return nil
+}
+
+// Delete implements TusStore.
+func (s *TusMemoryStore) Delete(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.uploads, id)
+	return nil
+}
+
+// Expired implements TusStore. Uploads locked by a request are left for a
+// later call.
+func (s *TusMemoryStore) Expired(_ context.Context, userID string) ([]*TusUpload, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	var expired []*TusUpload
+	for id, e := range s.uploads {
+		if e.upload.UserID == userID && !e.locked && now.After(e.upload.Expires) {
+			upload := e.upload
+			expired = append(expired, &upload)
+			delete(s.uploads, id)
+		}
+	}
+	return expired, nil
+}
+
+func (s *TusMemoryStore) entry(id string) (*tusEntry, error) {
+	e, ok := s.uploads[id]
+	if !ok || s.now().After(e.upload.Expires) {
+		return nil, ErrTusUploadNotFound
+	}
+	return e, nil
+}
@@ -0,0 +1,355 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	filev1 "github.com/provsalt/DOP_P01_Team1/common/file/v1"
+	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+)
+
+// tusTestPartSize keeps parts small enough to split a short body across them.
+const tusTestPartSize = 4
+
+func setupTusTestRouter(h *TusHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	router.Use(func(c *gin.Context) {
+		id := c.GetHeader("X-Test-User")
+		if id == "" {
+			id = "user-123"
+		}
+		c.Set("user", &userv1.User{Id: id, Username: "testuser", Role: userv1.Role_ROLE_USER})
+		c.Next()
+	})
+	router.OPTIONS("/api/files/tus", h.Options)
+	router.POST("/api/files/tus", h.CreateUpload)
+	router.HEAD("/api/files/tus/:id", h.GetOffset)
+	router.PATCH("/api/files/tus/:id", h.AppendUpload)
+	router.DELETE("/api/files/tus/:id", h.TerminateUpload)
+
+	return router
+}
+
+// tusFiles is a file service that records the parts it is sent.
+type tusFiles struct {
+	parts     map[int32][]byte
+	completed []*filev1.PartInfo
+	aborted   bool
+}
+
+func (f *tusFiles) client() *mockFileClient {
+	f.parts = make(map[int32][]byte)
+	return &mockFileClient{
+		initiateMultipartFunc: func(ctx context.Context, req *filev1.InitiateMultipartUploadRequest) (*filev1.InitiateMultipartUploadResponse, error) {
+			return &filev1.InitiateMultipartUploadResponse{UploadId: "upload-1", ChunkSize: tusTestPartSize}, nil
+		},
+		uploadPartFunc: func(ctx context.Context, req *filev1.UploadPartRequest) (*filev1.UploadPartResponse, error) {
+			f.parts[req.PartNumber] = req.Chunk
+			return &filev1.UploadPartResponse{PartNumber: req.PartNumber, Etag: "etag-" + string(req.Chunk)}, nil
+		},
+		completeMultipartFunc: func(ctx context.Context, req *filev1.CompleteMultipartUploadRequest) (*filev1.FileResponse, error) {
+			f.completed = req.Parts
+			return &filev1.FileResponse{File: &filev1.File{Id: "file-1", Size: 11}}, nil
+		},
+		abortMultipartFunc: func(ctx context.Context, req *filev1.AbortMultipartUploadRequest) (*filev1.AbortMultipartUploadResponse, error) {
+			f.aborted = true
+			return &filev1.AbortMultipartUploadResponse{Success: true}, nil
+		},
+	}
+}
+
+func tusRequest(method, target string, body string) *http.Request {
+	req, _ := http.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Tus-Resumable", tusVersion)
+	if method == http.MethodPatch {
+		req.Header.Set("Content-Type", tusOffsetContentType)
+	}
+	return req
+}
+
+// createTusUpload creates an upload of length bytes and returns its URL.
+func createTusUpload(t *testing.T, router *gin.Engine, length string) string {
+	t.Helper()
+	req := tusRequest(http.MethodPost, "/api/files/tus", "")
+	req.Header.Set("Upload-Length", length)
+	req.Header.Set("Upload-Metadata", "filename "+base64.StdEncoding.EncodeToString([]byte("hello.txt"))+",folder_id")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	return w.Header().Get("Location")
+}
+
+func patchTus(router *gin.Engine, location, offset, body string) *httptest.ResponseRecorder {
+	req := tusRequest(http.MethodPatch, location, body)
+	req.Header.Set("Upload-Offset", offset)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestTusOptions(t *testing.T) {
+	router := setupTusTestRouter(NewTusHandler(NewFileHandler(&mockFileClient{}), NewTusMemoryStore()))
+
+	req, _ := http.NewRequest(http.MethodOptions, "/api/files/tus", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, w.Code)
+	}
+	if got := w.Header().Get("Tus-Extension"); got != tusExtensions {
+		t.Errorf("expected Tus-Extension %q, got %q", tusExtensions, got)
+	}
+}
+
+func TestTusUpload_ResumesAcrossParts(t *testing.T) {
+	files := &tusFiles{}
+	router := setupTusTestRouter(NewTusHandler(NewFileHandler(files.client()), NewTusMemoryStore()))
+	location := createTusUpload(t, router, "11")
+
+	if w := patchTus(router, location, "0", "hello"); w.Code != http.StatusNoContent || w.Header().Get("Upload-Offset") != "5" {
+		t.Fatalf("first PATCH: got %d with offset %q", w.Code, w.Header().Get("Upload-Offset"))
+	}
+
+	req := tusRequest(http.MethodHead, location, "")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || w.Header().Get("Upload-Offset") != "5" || w.Header().Get("Upload-Length") != "11" {
+		t.Fatalf("HEAD: got %d with offset %q of %q", w.Code, w.Header().Get("Upload-Offset"), w.Header().Get("Upload-Length"))
+	}
+
+	w = patchTus(router, location, "5", " world")
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("second PATCH: expected status %d, got %d: %s", http.StatusNoContent, w.Code, w.Body.String())
+	}
+	if got := w.Header().Get("Content-Location"); got != "/api/files/file-1" {
+		t.Errorf("expected Content-Location of the stored file, got %q", got)
+	}
+
+	var stored []byte
+	for i, part := range files.completed {
+		if part.PartNumber != int32(i+1) {
+			t.Errorf("expected part %d, got %d", i+1, part.PartNumber)
+		}
+		stored = append(stored, files.parts[part.PartNumber]...)
+	}
+	if len(files.completed) != 3 || string(stored) != "hello world" {
+		t.Errorf("expected 3 parts holding %q, got %d holding %q", "hello world", len(files.completed), stored)
+	}
+}
+
+func TestTusUpload_ChecksumMismatch(t *testing.T) {
+	files := &tusFiles{}
+	router := setupTusTestRouter(NewTusHandler(NewFileHandler(files.client()), NewTusMemoryStore()))
+	location := createTusUpload(t, router, "11")
+
+	sum := sha1.Sum([]byte("something else"))
+	req := tusRequest(http.MethodPatch, location, "hello")
+	req.Header.Set("Upload-Offset", "0")
+	req.Header.Set("Upload-Checksum", "sha1 "+base64.StdEncoding.EncodeToString(sum[:]))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != statusChecksumMismatch {
+		t.Fatalf("expected status %d, got %d", statusChecksumMismatch, w.Code)
+	}
+	// The rejected bytes must be sent again from the start.
+	if w := patchTus(router, location, "5", " world"); w.Code != http.StatusConflict {
+		t.Errorf("expected status %d, got %d", http.StatusConflict, w.Code)
+	}
+	if w := patchTus(router, location, "0", "hello world"); w.Code != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d: %s", http.StatusNoContent, w.Code, w.Body.String())
+	}
+	if len(files.completed) != 3 {
+		t.Errorf("expected 3 parts completed, got %d", len(files.completed))
+	}
+}
+
+func TestTusUpload_BodyPastLength(t *testing.T) {
+	router := setupTusTestRouter(NewTusHandler(NewFileHandler((&tusFiles{}).client()), NewTusMemoryStore()))
+	location := createTusUpload(t, router, "3")
+
+	if w := patchTus(router, location, "0", "hello"); w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected status %d, got %d", http.StatusRequestEntityTooLarge, w.Code)
+	}
+}
+
+func TestTusUpload_OtherUser(t *testing.T) {
+	router := setupTusTestRouter(NewTusHandler(NewFileHandler((&tusFiles{}).client()), NewTusMemoryStore()))
+	location := createTusUpload(t, router, "11")
+
+	req := tusRequest(http.MethodPatch, location, "hello")
+	req.Header.Set("Upload-Offset", "0")
+	req.Header.Set("X-Test-User", "user-456")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+	// The owner can still write to it.
+	if w := patchTus(router, location, "0", "hello"); w.Code != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d", http.StatusNoContent, w.Code)
+	}
+}
+
+func TestTusCreate_Validation(t *testing.T) {
+	router := setupTusTestRouter(NewTusHandler(NewFileHandler(&mockFileClient{}), NewTusMemoryStore()))
+
+	tests := []struct {
+		name     string
+		headers  map[string]string
+		expected int
+	}{
+		{"wrong version", map[string]string{"Tus-Resumable": "0.2.2", "Upload-Length": "5"}, http.StatusPreconditionFailed},
+		{"missing length", map[string]string{}, http.StatusBadRequest},
+		{"deferred length", map[string]string{"Upload-Defer-Length": "1"}, http.StatusBadRequest},
+		{"too large", map[string]string{"Upload-Length": "3000000000"}, http.StatusRequestEntityTooLarge},
+		{"missing filename", map[string]string{"Upload-Length": "5"}, http.StatusBadRequest},
+		{"bad metadata", map[string]string{"Upload-Length": "5", "Upload-Metadata": "filename !!!"}, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tusRequest(http.MethodPost, "/api/files/tus", "")
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.expected {
+				t.Errorf("expected status %d, got %d: %s", tt.expected, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestTusTerminate(t *testing.T) {
+	files := &tusFiles{}
+	router := setupTusTestRouter(NewTusHandler(NewFileHandler(files.client()), NewTusMemoryStore()))
+	location := createTusUpload(t, router, "11")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, tusRequest(http.MethodDelete, location, ""))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, w.Code)
+	}
+	if !files.aborted {
+		t.Error("expected the multipart upload to be aborted")
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, tusRequest(http.MethodHead, location, ""))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestTusMemoryStore_Lock(t *testing.T) {
+	ctx := context.Background()
+	store := NewTusMemoryStore()
+	_ = store.Create(ctx, &TusUpload{ID: "a", Length: 10, PartSize: 4, Expires: store.now().Add(tusUploadTTL)})
+
+	upload, err := store.Lock(ctx, "a")
+	if err != nil {
+		t.Fatalf("Lock: %v", err)
+	}
+	if _, err := store.Lock(ctx, "a"); err != ErrTusUploadLocked {
+		t.Errorf("expected ErrTusUploadLocked, got %v", err)
+	}
+
+	upload.Offset, upload.Pending = 3, []byte("abc")
+	_ = store.Release(ctx, upload)
+	got, err := store.Lock(ctx, "a")
+	if err != nil {
+		t.Fatalf("Lock after Release: %v", err)
+	}
+	if got.Offset != 3 {
+		t.Errorf("expected offset 3, got %d", got.Offset)
+	}
+}
+
+func TestTusUpload_ExpiredIsAborted(t *testing.T) {
+	files := &tusFiles{}
+	store := NewTusMemoryStore()
+	router := setupTusTestRouter(NewTusHandler(NewFileHandler(files.client()), store))
+	location := createTusUpload(t, router, "11")
+	if w := patchTus(router, location, "0", "hello"); w.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, w.Code)
+	}
+
+	store.now = func() time.Time { return time.Now().Add(tusUploadTTL + time.Minute) }
+
+	// Another user's requests leave the upload alone; its owner's abort it.
+	req := tusRequest(http.MethodHead, location, "")
+	req.Header.Set("X-Test-User", "user-456")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+	if files.aborted {
+		t.Fatal("expected another user's request not to abort the upload")
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, tusRequest(http.MethodHead, location, ""))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+	if !files.aborted {
+		t.Error("expected the expired upload's multipart upload to be aborted")
+	}
+}
+
+func TestTusCreate_TooManyUploads(t *testing.T) {
+	files := &tusFiles{}
+	router := setupTusTestRouter(NewTusHandler(NewFileHandler(files.client()), NewTusMemoryStore()))
+	for range MaxTusUploadsPerUser {
+		createTusUpload(t, router, "11")
+	}
+
+	req := tusRequest(http.MethodPost, "/api/files/tus", "")
+	req.Header.Set("Upload-Length", "11")
+	req.Header.Set("Upload-Metadata", "filename "+base64.StdEncoding.EncodeToString([]byte("hello.txt")))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, w.Code)
+	}
+	if !files.aborted {
+		t.Error("expected the rejected upload's multipart upload to be aborted")
+	}
+}
+
+func TestTusMemoryStore_ExpiredSweep(t *testing.T) {
+	ctx := context.Background()
+	store := NewTusMemoryStore()
+	start := store.now()
+	_ = store.Create(ctx, &TusUpload{ID: "a", UserID: "user-123", Pending: []byte("abc"), Expires: start.Add(tusUploadTTL)})
+
+	// A sweep past expiry frees the pending bytes but keeps the upload to abort.
+	store.now = func() time.Time { return start.Add(tusUploadTTL + time.Minute) }
+	_ = store.Create(ctx, &TusUpload{ID: "b", UserID: "user-456", Expires: store.now().Add(tusUploadTTL)})
+	if e := store.uploads["a"]; e == nil || e.upload.Pending != nil {
+		t.Fatalf("expected the expired upload kept without pending bytes, got %+v", e)
+	}
+
+	expired, _ := store.Expired(ctx, "user-123")
+	if len(expired) != 1 || expired[0].ID != "a" {
+		t.Fatalf("expected upload a to have expired, got %v", expired)
+	}
+	if expired, _ := store.Expired(ctx, "user-123"); len(expired) != 0 {
+		t.Errorf("expected expired uploads to be handed over once, got %v", expired)
+	}
+}
@@ -11,8 +11,11 @@ import (
 
 type UserServiceClient interface {
 	GetUser(ctx context.Context, req *userv1.GetUserRequest) (*userv1.GetUserResponse, error)
+	GetUserByUsername(ctx context.Context, req *userv1.GetUserByUsernameRequest) (*userv1.GetUserByUsernameResponse, error)
 	DeleteAccount(ctx context.Context, req *userv1.DeleteUserByIdRequest) (*userv1.DeleteUserByIdResponse, error)
 	ListUsers(ctx context.Context, req *userv1.ListUsersRequest) (*userv1.ListUsersResponse, error)
+	GetQuota(ctx context.Context, req *userv1.GetQuotaRequest) (*userv1.GetQuotaResponse, error)
+	SetQuota(ctx context.Context, req *userv1.SetQuotaRequest) (*userv1.GetQuotaResponse, error)
 	Close() error
 }
 
@@ -21,11 +24,11 @@ type grpcUserClient struct {
 	client userv1.UserServiceClient
 }
 
-func NewGRPCUserClient(addr string) (UserServiceClient, error) {
-	conn, err := grpc.NewClient(addr,
+func NewGRPCUserClient(addr string, opts ...grpc.DialOption) (UserServiceClient, error) {
+	conn, err := grpc.NewClient(addr, append([]grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
-	)
+	}, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -40,6 +43,10 @@ func (c *grpcUserClient) GetUser(ctx context.Context, req *userv1.GetUserRequest
 	return c.client.GetUser(ctx, req)
 }
 
+func (c *grpcUserClient) GetUserByUsername(ctx context.Context, req *userv1.GetUserByUsernameRequest) (*userv1.GetUserByUsernameResponse, error) {
+	return c.client.GetUserByUsername(ctx, req)
+}
+
 func (c *grpcUserClient) DeleteAccount(ctx context.Context, req *userv1.DeleteUserByIdRequest) (*userv1.DeleteUserByIdResponse, error) {
 	return c.client.DeleteUser(ctx, req)
 }
@@ -48,6 +55,14 @@ func (c *grpcUserClient) ListUsers(ctx context.Context, req *userv1.ListUsersReq
 	return c.client.ListUsers(ctx, req)
 }
 
+func (c *grpcUserClient) GetQuota(ctx context.Context, req *userv1.GetQuotaRequest) (*userv1.GetQuotaResponse, error) {
+	return c.client.GetQuota(ctx, req)
+}
+
+func (c *grpcUserClient) SetQuota(ctx context.Context, req *userv1.SetQuotaRequest) (*userv1.GetQuotaResponse, error) {
+	return c.client.SetQuota(ctx, req)
+}
+
 func (c *grpcUserClient) Close() error {
 	return c.conn.Close()
 }
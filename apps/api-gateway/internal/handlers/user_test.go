@@ -16,9 +16,12 @@ import (
 )
 
 type mockUserClient struct {
-	getUserFunc       func(ctx context.Context, req *userv1.GetUserRequest) (*userv1.GetUserResponse, error)
-	deleteAccountFunc func(ctx context.Context, req *userv1.DeleteUserByIdRequest) (*userv1.DeleteUserByIdResponse, error)
-	listUsersFunc     func(ctx context.Context, req *userv1.ListUsersRequest) (*userv1.ListUsersResponse, error)
+	getUserFunc           func(ctx context.Context, req *userv1.GetUserRequest) (*userv1.GetUserResponse, error)
+	getUserByUsernameFunc func(ctx context.Context, req *userv1.GetUserByUsernameRequest) (*userv1.GetUserByUsernameResponse, error)
+	deleteAccountFunc     func(ctx context.Context, req *userv1.DeleteUserByIdRequest) (*userv1.DeleteUserByIdResponse, error)
+	listUsersFunc         func(ctx context.Context, req *userv1.ListUsersRequest) (*userv1.ListUsersResponse, error)
+	getQuotaFunc          func(ctx context.Context, req *userv1.GetQuotaRequest) (*userv1.GetQuotaResponse, error)
+	setQuotaFunc          func(ctx context.Context, req *userv1.SetQuotaRequest) (*userv1.GetQuotaResponse, error)
 }
 
 func (m *mockUserClient) GetUser(ctx context.Context, req *userv1.GetUserRequest) (*userv1.GetUserResponse, error) {
@@ -28,6 +31,13 @@ func (m *mockUserClient) GetUser(ctx context.Context, req *userv1.GetUserRequest
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockUserClient) GetUserByUsername(ctx context.Context, req *userv1.GetUserByUsernameRequest) (*userv1.GetUserByUsernameResponse, error) {
+	if m.getUserByUsernameFunc != nil {
+		return m.getUserByUsernameFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
 func (m *mockUserClient) DeleteAccount(ctx context.Context, req *userv1.DeleteUserByIdRequest) (*userv1.DeleteUserByIdResponse, error) {
 	if m.deleteAccountFunc != nil {
 		return m.deleteAccountFunc(ctx, req)
@@ -42,6 +52,20 @@ func (m *mockUserClient) ListUsers(ctx context.Context, req *userv1.ListUsersReq
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockUserClient) GetQuota(ctx context.Context, req *userv1.GetQuotaRequest) (*userv1.GetQuotaResponse, error) {
+	if m.getQuotaFunc != nil {
+		return m.getQuotaFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockUserClient) SetQuota(ctx context.Context, req *userv1.SetQuotaRequest) (*userv1.GetQuotaResponse, error) {
+	if m.setQuotaFunc != nil {
+		return m.setQuotaFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
 func (m *mockUserClient) Close() error {
 	return nil
 }
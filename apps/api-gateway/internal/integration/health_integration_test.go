@@ -1,10 +1,13 @@
 package integration_test
 
 import (
+	"context"
 	"encoding/json"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/provsalt/DOP_P01_Team1/api-gateway/internal/config"
@@ -41,3 +44,106 @@ func TestHealthEndpoint_Integration(t *testing.T) {
 		t.Fatalf("unexpected body.status: got %q want %q", body.Status, "ok")
 	}
 }
+
+func TestHealthEndpoint_ShuttingDown_Integration(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := &config.Config{Environment: "test"}
+	srv := server.New(nil, nil, nil, cfg)
+
+	ts := httptest.NewServer(srv.Router)
+	defer ts.Close()
+
+	if err := srv.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown returned error: %v", err)
+	}
+
+	resp, err := http.Get(ts.URL + "/health")
+	if err != nil {
+		t.Fatalf("GET /health failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("unexpected status code: got %d want %d", resp.StatusCode, http.StatusServiceUnavailable)
+	}
+
+	var body struct {
+		Status string `json:"status"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode JSON: %v", err)
+	}
+
+	if body.Status != "shutting_down" {
+		t.Fatalf("unexpected body.status: got %q want %q", body.Status, "shutting_down")
+	}
+}
+
+func TestHealthEndpoint_DrainDelay_Integration(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const drainDelay = time.Second
+	cfg := &config.Config{Environment: "test", ShutdownDrainDelay: drainDelay}
+	srv := server.New(nil, nil, nil, cfg)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	served := make(chan error, 1)
+	go func() { served <- srv.Serve(lis) }()
+
+	// A fresh connection per request, so each one proves the server still
+	// accepts them rather than reusing a kept-alive one.
+	client := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+	url := "http://" + lis.Addr().String() + "/health"
+
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatalf("GET /health failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code before shutdown: got %d want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	start := time.Now()
+	stopped := make(chan error, 1)
+	go func() { stopped <- srv.Shutdown(context.Background()) }()
+
+	deadline := time.Now().Add(drainDelay / 2)
+	for {
+		resp, err := client.Get(url)
+		if err != nil {
+			t.Fatalf("GET /health during drain failed: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusServiceUnavailable {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("health still reports %d during drain", resp.StatusCode)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	select {
+	case err := <-stopped:
+		t.Fatalf("Shutdown returned before the drain delay: %v", err)
+	default:
+	}
+
+	if err := <-stopped; err != nil {
+		t.Fatalf("Shutdown returned error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < drainDelay {
+		t.Fatalf("Shutdown took %s, want at least %s", elapsed, drainDelay)
+	}
+	if err := <-served; err != nil {
+		t.Fatalf("Serve returned error: %v", err)
+	}
+	if _, err := client.Get(url); err == nil {
+		t.Fatal("expected connections to be refused after shutdown")
+	}
+}
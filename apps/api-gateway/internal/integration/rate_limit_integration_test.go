@@ -0,0 +1,63 @@
+package integration_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/provsalt/DOP_P01_Team1/api-gateway/internal/config"
+	"github.com/provsalt/DOP_P01_Team1/api-gateway/internal/server"
+)
+
+func login(t *testing.T, url, forwardedFor string) int {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodPost, url+"/api/login", nil)
+	req.Header.Set("Content-Type", "application/json")
+	if forwardedFor != "" {
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("POST /api/login failed: %v", err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestLoginRateLimit_IgnoresSpoofedForwardedFor_Integration(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := &config.Config{Environment: "test", RateLimitLogin: "1/m"}
+	srv := server.New(nil, nil, nil, cfg)
+
+	ts := httptest.NewServer(srv.Router)
+	defer ts.Close()
+
+	// The empty body is rejected before reaching auth-service but still
+	// counts against the limit.
+	if code := login(t, ts.URL, "203.0.113.1"); code != http.StatusBadRequest {
+		t.Fatalf("unexpected status code: got %d want %d", code, http.StatusBadRequest)
+	}
+	if code := login(t, ts.URL, "203.0.113.2"); code != http.StatusTooManyRequests {
+		t.Fatalf("unexpected status code with a new X-Forwarded-For: got %d want %d", code, http.StatusTooManyRequests)
+	}
+}
+
+func TestLoginRateLimit_TrustedProxy_Integration(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := &config.Config{Environment: "test", RateLimitLogin: "1/m", TrustedProxies: []string{"127.0.0.1"}}
+	srv := server.New(nil, nil, nil, cfg)
+
+	ts := httptest.NewServer(srv.Router)
+	defer ts.Close()
+
+	// Behind a trusted proxy each forwarded client gets its own bucket.
+	if code := login(t, ts.URL, "203.0.113.1"); code != http.StatusBadRequest {
+		t.Fatalf("unexpected status code: got %d want %d", code, http.StatusBadRequest)
+	}
+	if code := login(t, ts.URL, "203.0.113.2"); code != http.StatusBadRequest {
+		t.Fatalf("unexpected status code for another client: got %d want %d", code, http.StatusBadRequest)
+	}
+}
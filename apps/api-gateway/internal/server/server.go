@@ -1,11 +1,20 @@
 package server
 
 import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/provsalt/DOP_P01_Team1/api-gateway/internal/config"
 	"github.com/provsalt/DOP_P01_Team1/api-gateway/internal/handlers"
 	"github.com/provsalt/DOP_P01_Team1/api-gateway/middleware"
+	"github.com/provsalt/DOP_P01_Team1/common/telemetry"
 	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -17,12 +26,32 @@ type Server struct {
 	authClient handlers.AuthServiceClient
 	userClient handlers.UserServiceClient
 	fileClient handlers.FileServiceClient
+
+	limits      middleware.RateLimitStore
+	idempotency middleware.IdempotencyStore
+	tus         handlers.TusStore
+
+	mu           sync.Mutex
+	httpServer   *http.Server
+	shuttingDown atomic.Bool
+	drainDelay   time.Duration
 }
 
 func New(authClient handlers.AuthServiceClient, userClient handlers.UserServiceClient, fileClient handlers.FileServiceClient, cfg *config.Config) *Server {
-	router := gin.Default()
+	router := gin.New()
+	// ClientIP keys the login rate limit, so X-Forwarded-For is only believed
+	// from configured proxies. config.Load has validated the list.
+	_ = router.SetTrustedProxies(cfg.TrustedProxies)
+	router.Use(gin.Recovery())
 	router.MaxMultipartMemory = 20 << 20
-	router.Use(otelgin.Middleware("api-gateway"))
+	// Handlers pass *gin.Context straight to gRPC clients; fall back to the
+	// request context so trace spans and request IDs reach the backends.
+	router.ContextWithFallback = true
+	router.Use(otelgin.Middleware("api-gateway", otelgin.WithGinFilter(func(c *gin.Context) bool {
+		return c.FullPath() != "/metrics"
+	})))
+	router.Use(middleware.RequestID())
+	router.Use(middleware.AccessLog())
 
 	allowOrigins := []string{"http://localhost:5173"}
 	if cfg.FrontendURL != "" {
@@ -31,17 +60,21 @@ func New(authClient handlers.AuthServiceClient, userClient handlers.UserServiceC
 
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     allowOrigins,
-		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowMethods:     []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", middleware.RequestIDHeader, middleware.IdempotencyKeyHeader, handlers.FilenameHeader, handlers.ChecksumHeader, "Range", "If-Range", "If-None-Match", "If-Modified-Since", "Tus-Resumable", "Upload-Length", "Upload-Offset", "Upload-Metadata", "Upload-Checksum", "Upload-Defer-Length"},
+		ExposeHeaders:    []string{"Content-Length", "Content-Range", "Accept-Ranges", "ETag", "Digest", "Last-Modified", middleware.RequestIDHeader, middleware.IdempotentReplayedHeader, "Retry-After", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Link", "Location", "Content-Location", "Tus-Resumable", "Tus-Version", "Tus-Extension", "Tus-Max-Size", "Tus-Checksum-Algorithm", "Upload-Offset", "Upload-Length", "Upload-Metadata"},
 		AllowCredentials: true,
 	}))
 
 	s := &Server{
-		Router:     router,
-		authClient: authClient,
-		userClient: userClient,
-		fileClient: fileClient,
+		Router:      router,
+		authClient:  authClient,
+		userClient:  userClient,
+		fileClient:  fileClient,
+		limits:      middleware.NewMemoryStore(),
+		idempotency: middleware.NewIdempotencyMemoryStore(),
+		tus:         handlers.NewTusMemoryStore(),
+		drainDelay:  cfg.ShutdownDrainDelay,
 	}
 
 	s.setupRoutes(cfg)
@@ -53,42 +86,171 @@ func (s *Server) setupRoutes(cfg *config.Config) {
 	userHandler := handlers.NewUserHandler(s.userClient)
 	fileHandler := handlers.NewFileHandler(s.fileClient)
 
-	s.Router.POST("/api/login", authHandler.Login)
+	// Rates were validated by config.Load; literal configs in tests leave
+	// them empty, which disables the limiters.
+	var loginRate, apiRate, uploadRate middleware.Rate
+	var idempotencyTTL time.Duration
+	var linkSecret, publicURL string
+	if cfg != nil {
+		idempotencyTTL = cfg.IdempotencyTTL
+		linkSecret, publicURL = cfg.DownloadLinkSecret, cfg.PublicURL
+		loginRate, _ = middleware.ParseRate(cfg.RateLimitLogin)
+		apiRate, _ = middleware.ParseRate(cfg.RateLimitAPI)
+		uploadRate, _ = middleware.ParseRate(cfg.RateLimitUpload)
+	}
+	loginLimit := middleware.RateLimit(s.limits, "login", loginRate, middleware.KeyByIP)
+	apiLimit := middleware.RateLimit(s.limits, "api", apiRate, middleware.KeyByUser)
+	uploadLimit := middleware.RateLimit(s.limits, "upload", uploadRate, middleware.KeyByUser)
+	idempotent := middleware.Idempotency(s.idempotency, idempotencyTTL)
+	linkHandler := handlers.NewLinkHandler(fileHandler, linkSecret, publicURL)
+	shareHandler := handlers.NewShareHandler(fileHandler, s.userClient)
+	folderHandler := handlers.NewFolderHandler(fileHandler)
+	tagHandler := handlers.NewTagHandler(fileHandler)
+	adminFileHandler := handlers.NewAdminFileHandler(fileHandler, s.userClient)
+	quotaHandler := handlers.NewQuotaHandler(fileHandler, s.userClient)
+	tusHandler := handlers.NewTusHandler(fileHandler, s.tus)
+
+	s.Router.POST("/api/login", loginLimit, authHandler.Login)
 
-	s.Router.POST("/api/admin/create_user", middleware.ValidateRole(s.authClient, []userv1.Role{userv1.Role_ROLE_ADMIN}), authHandler.SignUp)
-	s.Router.DELETE("/api/admin/delete_user", middleware.ValidateRole(s.authClient, []userv1.Role{userv1.Role_ROLE_ADMIN}), userHandler.DeleteUser)
-	s.Router.GET("/api/admin/list_users", middleware.ValidateRole(s.authClient, []userv1.Role{userv1.Role_ROLE_ADMIN}), userHandler.ListUsers)
+	s.Router.POST("/api/admin/create_user", middleware.ValidateRole(s.authClient, []userv1.Role{userv1.Role_ROLE_ADMIN}), apiLimit, idempotent, authHandler.SignUp)
+	s.Router.DELETE("/api/admin/delete_user", middleware.ValidateRole(s.authClient, []userv1.Role{userv1.Role_ROLE_ADMIN}), apiLimit, userHandler.DeleteUser)
+	s.Router.GET("/api/admin/list_users", middleware.ValidateRole(s.authClient, []userv1.Role{userv1.Role_ROLE_ADMIN}), apiLimit, userHandler.ListUsers)
+
+	s.Router.GET("/api/admin/users/:id/quota", middleware.ValidateRole(s.authClient, []userv1.Role{userv1.Role_ROLE_ADMIN}), apiLimit, quotaHandler.GetQuota)
+	s.Router.PUT("/api/admin/users/:id/quota", middleware.ValidateRole(s.authClient, []userv1.Role{userv1.Role_ROLE_ADMIN}), apiLimit, quotaHandler.SetQuota)
+
+	s.Router.GET("/api/me/usage", middleware.ValidateRole(s.authClient, []userv1.Role{userv1.Role_ROLE_USER, userv1.Role_ROLE_ADMIN}), apiLimit, quotaHandler.Usage)
+
+	adminFiles := s.Router.Group("/api/admin/files")
+	adminFiles.Use(middleware.ValidateRole(s.authClient, []userv1.Role{userv1.Role_ROLE_ADMIN}), apiLimit)
+	{
+		adminFiles.GET("", adminFileHandler.ListFiles)
+		adminFiles.GET("/actions", adminFileHandler.ListActions)
+		adminFiles.GET("/:id", adminFileHandler.GetFile)
+		adminFiles.GET("/:id/download", adminFileHandler.DownloadFile)
+		adminFiles.DELETE("/:id", adminFileHandler.DeleteFile)
+	}
 
 	files := s.Router.Group("/api/files")
-	files.Use(middleware.ValidateRole(s.authClient, []userv1.Role{userv1.Role_ROLE_USER, userv1.Role_ROLE_ADMIN}))
+	files.Use(middleware.ValidateRole(s.authClient, []userv1.Role{userv1.Role_ROLE_USER, userv1.Role_ROLE_ADMIN}), apiLimit)
 	{
 		files.GET("", fileHandler.ListFiles)
-		files.POST("", fileHandler.UploadFile)
+		files.GET("/shared-with-me", shareHandler.SharedWithMe)
+		files.GET("/tags", tagHandler.ListTags)
+		files.POST("", uploadLimit, idempotent, quotaHandler.CheckUploadQuota, fileHandler.UploadFile)
 		files.GET("/:id", fileHandler.GetFile)
+		files.HEAD("/:id", fileHandler.HeadFile)
 		files.GET("/:id/download", fileHandler.DownloadFile)
+		files.PATCH("/:id", fileHandler.UpdateFile)
 		files.DELETE("/:id", fileHandler.DeleteFile)
+		files.POST("/:id/links", linkHandler.CreateLink)
+		files.GET("/:id/links", linkHandler.ListLinks)
+		files.DELETE("/:id/links/:link_id", linkHandler.RevokeLink)
+		files.POST("/:id/shares", shareHandler.ShareFile)
+		files.GET("/:id/shares", shareHandler.ListShares)
+		files.DELETE("/:id/shares/:share_id", shareHandler.RevokeShare)
+		files.POST("/:id/tags", tagHandler.AddTags)
+		files.DELETE("/:id/tags/:tag", tagHandler.RemoveTag)
+	}
+
+	// Signed links authorize themselves, so this route skips ValidateRole.
+	s.Router.GET("/d/:token", apiLimit, linkHandler.Download)
+
+	folders := s.Router.Group("/api/folders")
+	folders.Use(middleware.ValidateRole(s.authClient, []userv1.Role{userv1.Role_ROLE_USER, userv1.Role_ROLE_ADMIN}), apiLimit)
+	{
+		folders.POST("", folderHandler.CreateFolder)
+		folders.PATCH("/:id", folderHandler.RenameFolder)
+		folders.POST("/:id/move", folderHandler.MoveFolder)
+		folders.DELETE("/:id", folderHandler.DeleteFolder)
 	}
 
 	multipart := s.Router.Group("/api/files/multipart")
-	multipart.Use(middleware.ValidateRole(s.authClient, []userv1.Role{userv1.Role_ROLE_USER, userv1.Role_ROLE_ADMIN}))
+	multipart.Use(middleware.ValidateRole(s.authClient, []userv1.Role{userv1.Role_ROLE_USER, userv1.Role_ROLE_ADMIN}), apiLimit)
 	{
-		multipart.POST("/initiate", fileHandler.InitiateMultipartUpload)
-		multipart.POST("/:upload_id/part/:part_number", fileHandler.UploadPart)
+		multipart.GET("", fileHandler.ListMultipartUploads)
+		multipart.POST("/initiate", quotaHandler.CheckUploadQuota, fileHandler.InitiateMultipartUpload)
+		multipart.GET("/:upload_id", fileHandler.ListParts)
+		multipart.POST("/:upload_id/part/:part_number", uploadLimit, fileHandler.UploadPart)
 		multipart.POST("/:upload_id/complete", fileHandler.CompleteMultipartUpload)
 		multipart.DELETE("/:upload_id", fileHandler.AbortMultipartUpload)
 	}
 
+	// tus clients discover the server with an unauthenticated OPTIONS.
+	s.Router.OPTIONS("/api/files/tus", tusHandler.Options)
+	tus := s.Router.Group("/api/files/tus")
+	tus.Use(middleware.ValidateRole(s.authClient, []userv1.Role{userv1.Role_ROLE_USER, userv1.Role_ROLE_ADMIN}), apiLimit)
+	{
+		tus.POST("", quotaHandler.CheckUploadQuota, tusHandler.CreateUpload)
+		tus.HEAD("/:id", tusHandler.GetOffset)
+		tus.PATCH("/:id", uploadLimit, tusHandler.AppendUpload)
+		tus.DELETE("/:id", tusHandler.TerminateUpload)
+	}
+
 	s.Router.GET("/health", func(c *gin.Context) {
+		if s.shuttingDown.Load() {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"status": "shutting_down"})
+			return
+		}
 		c.JSON(200, gin.H{"status": "ok"})
 	})
 
+	if cfg != nil && cfg.PrometheusEnabled {
+		s.Router.GET("/metrics", gin.WrapH(telemetry.MetricsHandler()))
+	}
+
 	if cfg != nil && cfg.Environment == "development" {
 		s.Router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 	}
 }
 
+// Run serves HTTP on addr until Shutdown is called. It returns nil after a
+// clean shutdown.
 func (s *Server) Run(addr string) error {
-	return s.Router.Run(addr)
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(lis)
+}
+
+// Serve is Run on an existing listener.
+func (s *Server) Serve(lis net.Listener) error {
+	s.mu.Lock()
+	s.httpServer = &http.Server{
+		Addr:    lis.Addr().String(),
+		Handler: s.Router.Handler(),
+	}
+	srv := s.httpServer
+	s.mu.Unlock()
+
+	if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Shutdown flips /health to 503 so load balancers stop sending traffic, keeps
+// accepting requests for the configured drain delay while they notice, then
+// waits for in-flight requests to complete or ctx to expire.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.shuttingDown.Store(true)
+
+	s.mu.Lock()
+	srv := s.httpServer
+	s.mu.Unlock()
+
+	if srv == nil {
+		return nil
+	}
+
+	delay := time.NewTimer(s.drainDelay)
+	defer delay.Stop()
+	select {
+	case <-delay.C:
+	case <-ctx.Done():
+	}
+	return srv.Shutdown(ctx)
 }
 
 func (s *Server) Close() error {
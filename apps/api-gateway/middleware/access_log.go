@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AccessLog writes one structured log line per HTTP request. It replaces gin's
+// text logger so gateway requests land in the same pipeline as the services.
+func AccessLog() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		switch c.FullPath() {
+		case "/health", "/metrics":
+			return
+		}
+
+		status := c.Writer.Status()
+		level := slog.LevelInfo
+		if status >= 500 {
+			level = slog.LevelError
+		}
+
+		attrs := []any{
+			"http.method", c.Request.Method,
+			"http.route", c.FullPath(),
+			"http.status", status,
+			"duration_ms", float64(time.Since(start).Microseconds()) / 1000,
+			"client_ip", c.ClientIP(),
+			"request_id", c.GetString("request_id"),
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, "error", c.Errors.String())
+		}
+		slog.Log(c.Request.Context(), level, "http request", attrs...)
+	}
+}
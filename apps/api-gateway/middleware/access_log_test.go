@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestAccessLog_LogsRouteStatusAndRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&buf, nil)))
+	defer slog.SetDefault(prev)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(RequestID(), AccessLog())
+	r.GET("/api/files/:id", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+
+	req, _ := http.NewRequest("GET", "/api/files/abc", nil)
+	req.Header.Set(RequestIDHeader, "req-1")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("expected one JSON log line, got %q: %v", buf.String(), err)
+	}
+	if entry["http.route"] != "/api/files/:id" || entry["http.status"] != float64(http.StatusNotFound) || entry["request_id"] != "req-1" {
+		t.Fatalf("unexpected access log entry: %v", entry)
+	}
+}
+
+func TestAccessLog_SkipsHealthAndMetrics(t *testing.T) {
+	var buf bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&buf, nil)))
+	defer slog.SetDefault(prev)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(AccessLog())
+	for _, path := range []string{"/health", "/metrics"} {
+		r.GET(path, func(c *gin.Context) { c.Status(http.StatusOK) })
+		req, _ := http.NewRequest("GET", path, nil)
+		r.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	if buf.Len() != 0 {
+		t.Fatalf("expected probes and scrapes to be skipped, got %q", buf.String())
+	}
+}
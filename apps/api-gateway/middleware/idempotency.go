@@ -0,0 +1,313 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// IdempotencyKeyHeader lets clients retry a POST without repeating its effect.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentReplayedHeader is set to "true" on responses served from the store.
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+const (
+	maxIdempotencyKeyLength = 255
+	// Responses larger than this are not stored; the key is released instead.
+	maxStoredResponseSize = 1 << 20
+	// How much of the request body is read after the handler to finish its
+	// fingerprint. A handler that rejected a large upload early left the rest
+	// unread, and the key is released rather than reading it all.
+	maxUnreadBodySize = 64 << 10
+)
+
+// ErrIdempotencyInFlight is returned by IdempotencyStore.Begin while another
+// request holds the key.
+var ErrIdempotencyInFlight = errors.New("idempotency key in use")
+
+// StoredResponse is the first response recorded for an idempotency key.
+type StoredResponse struct {
+	Fingerprint string
+	Status      int
+	ContentType string
+	Body        []byte
+}
+
+// IdempotencyStore tracks idempotency keys. Begin either returns a completed
+// response, reserves the key (nil, nil) or fails with ErrIdempotencyInFlight.
+// A reservation must be finished with Complete or Release. Implementations
+// must be safe for concurrent use.
+type IdempotencyStore interface {
+	Begin(ctx context.Context, key string, ttl time.Duration) (*StoredResponse, error)
+	Complete(ctx context.Context, key string, resp StoredResponse, ttl time.Duration) error
+	Release(ctx context.Context, key string) error
+}
+
+// Idempotency replays the stored response when a request repeats an
+// Idempotency-Key for the same user. Keys are bound to a fingerprint of the
+// method, path, query, content type, upload headers and body: reusing one for
+// a different request gets 422, and a duplicate that arrives while the first
+// is still running gets 409. Server errors and 429s are not stored so the
+// client can retry them, nor are rejections that left a large body unread.
+// Requests without the header pass straight through.
+func Idempotency(store IdempotencyStore, ttl time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" || ttl <= 0 {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Idempotency-Key must be at most 255 characters"})
+			return
+		}
+
+		ctx := c.Request.Context()
+		storeKey := KeyByUser(c) + ":" + key
+		stored, err := store.Begin(ctx, storeKey, ttl)
+		switch {
+		case errors.Is(err, ErrIdempotencyInFlight):
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "a request with this Idempotency-Key is still in progress"})
+			return
+		case err != nil:
+			slog.WarnContext(ctx, "idempotency store unavailable", "error", err)
+			c.Next()
+			return
+		case stored != nil:
+			replay(c, stored)
+			return
+		}
+
+		fp := newFingerprint(c.Request)
+		body := &fingerprintBody{Reader: io.TeeReader(c.Request.Body, fp), Closer: c.Request.Body}
+		c.Request.Body = body
+		rec := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = rec
+
+		completed := false
+		defer func() {
+			if !completed {
+				if err := store.Release(context.WithoutCancel(ctx), storeKey); err != nil {
+					slog.WarnContext(ctx, "failed to release idempotency key", "error", err)
+				}
+			}
+		}()
+
+		c.Next()
+
+		status := c.Writer.Status()
+		if status >= http.StatusInternalServerError || status == http.StatusTooManyRequests || rec.overflow {
+			return
+		}
+		// The handler may not have read the whole body; finish hashing it if
+		// little is left.
+		_, _ = io.CopyN(io.Discard, body, maxUnreadBodySize)
+		if !body.eof {
+			return
+		}
+
+		err = store.Complete(context.WithoutCancel(ctx), storeKey, StoredResponse{
+			Fingerprint: fp.sum(),
+			Status:      status,
+			ContentType: c.Writer.Header().Get("Content-Type"),
+			Body:        rec.body.Bytes(),
+		}, ttl)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to store idempotent response", "error", err)
+			return
+		}
+		completed = true
+	}
+}
+
+func replay(c *gin.Context, stored *StoredResponse) {
+	fp := newFingerprint(c.Request)
+	if _, err := io.Copy(fp, c.Request.Body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+		return
+	}
+	if fp.sum() != stored.Fingerprint {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"error": "Idempotency-Key was already used for a different request"})
+		return
+	}
+
+	c.Header(IdempotentReplayedHeader, "true")
+	c.Data(stored.Status, stored.ContentType, stored.Body)
+	c.Abort()
+}
+
+// fingerprintHeaders change what an upload stores, so they are part of its
+// fingerprint along with the query.
+var fingerprintHeaders = []string{"X-Filename", "X-Checksum-SHA256"}
+
+// fingerprint hashes what decides a request's effect. Multipart bodies are
+// hashed with their boundary blanked out, since a client picks a new random
+// one each time it sends the same form.
+type fingerprint struct {
+	h        hash.Hash
+	boundary []byte
+	pending  []byte
+}
+
+func newFingerprint(r *http.Request) *fingerprint {
+	fp := &fingerprint{h: sha256.New()}
+	mediaType, params, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if boundary := params["boundary"]; strings.HasPrefix(mediaType, "multipart/") && boundary != "" {
+		fp.boundary = []byte("--" + boundary)
+		delete(params, "boundary")
+	}
+
+	io.WriteString(fp.h, r.Method+"\n"+r.URL.Path+"\n"+r.URL.Query().Encode()+"\n")
+	io.WriteString(fp.h, mime.FormatMediaType(mediaType, params)+"\n")
+	for _, name := range fingerprintHeaders {
+		io.WriteString(fp.h, r.Header.Get(name)+"\n")
+	}
+	return fp
+}
+
+// Write hashes body bytes, holding back any that may start a boundary split
+// across writes.
+func (fp *fingerprint) Write(p []byte) (int, error) {
+	if fp.boundary == nil {
+		return fp.h.Write(p)
+	}
+	fp.pending = append(fp.pending, p...)
+	for {
+		i := bytes.Index(fp.pending, fp.boundary)
+		if i < 0 {
+			break
+		}
+		fp.h.Write(fp.pending[:i])
+		io.WriteString(fp.h, "--")
+		fp.pending = fp.pending[i+len(fp.boundary):]
+	}
+	if keep := len(fp.boundary) - 1; len(fp.pending) > keep {
+		fp.h.Write(fp.pending[:len(fp.pending)-keep])
+		fp.pending = append(fp.pending[:0], fp.pending[len(fp.pending)-keep:]...)
+	}
+	return len(p), nil
+}
+
+func (fp *fingerprint) sum() string {
+	fp.h.Write(fp.pending)
+	fp.pending = nil
+	return hex.EncodeToString(fp.h.Sum(nil))
+}
+
+// fingerprintBody is the request body as the handler sees it, noting whether
+// it was read to the end.
+type fingerprintBody struct {
+	io.Reader
+	io.Closer
+	eof bool
+}
+
+func (b *fingerprintBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	if err == io.EOF {
+		b.eof = true
+	}
+	return n, err
+}
+
+// recordingWriter keeps a copy of the response body so it can be replayed.
+type recordingWriter struct {
+	gin.ResponseWriter
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	w.record(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	w.record([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *recordingWriter) record(b []byte) {
+	if w.overflow {
+		return
+	}
+	if w.body.Len()+len(b) > maxStoredResponseSize {
+		w.overflow = true
+		w.body.Reset()
+		return
+	}
+	w.body.Write(b)
+}
+
+// IdempotencyMemoryStore keeps idempotency records in process memory. Expired
+// records are dropped during periodic sweeps.
+type IdempotencyMemoryStore struct {
+	mu        sync.Mutex
+	entries   map[string]*idempotencyEntry
+	now       func() time.Time
+	lastSweep time.Time
+}
+
+type idempotencyEntry struct {
+	resp    *StoredResponse
+	expires time.Time
+}
+
+// NewIdempotencyMemoryStore returns an empty in-memory store.
+func NewIdempotencyMemoryStore() *IdempotencyMemoryStore {
+	return &IdempotencyMemoryStore{entries: make(map[string]*idempotencyEntry), now: time.Now}
+}
+
+// Begin implements IdempotencyStore.
+func (s *IdempotencyMemoryStore) Begin(_ context.Context, key string, ttl time.Duration) (*StoredResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.lastSweep) >= sweepInterval {
+		for k, e := range s.entries {
+			if now.After(e.expires) {
+				delete(s.entries, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	if e, ok := s.entries[key]; ok && !now.After(e.expires) {
+		if e.resp == nil {
+			return nil, ErrIdempotencyInFlight
+		}
+		return e.resp, nil
+	}
+	s.entries[key] = &idempotencyEntry{expires: now.Add(ttl)}
+	return nil, nil
+}
+
+// Complete implements IdempotencyStore.
+func (s *IdempotencyMemoryStore) Complete(_ context.Context, key string, resp StoredResponse, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = &idempotencyEntry{resp: &resp, expires: s.now().Add(ttl)}
+	return nil
+}
+
+// Release implements IdempotencyStore.
+func (s *IdempotencyMemoryStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+	return nil
+}
@@ -0,0 +1,251 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+)
+
+func newIdempotentRouter(store IdempotencyStore, status int, calls *int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if id := c.GetHeader("X-Test-User"); id != "" {
+			c.Set("user", &userv1.User{Id: id})
+		}
+	})
+	r.POST("/api/files", Idempotency(store, time.Hour), func(c *gin.Context) {
+		*calls++
+		body, _ := c.GetRawData()
+		c.JSON(status, gin.H{"call": *calls, "body": string(body)})
+	})
+	return r
+}
+
+func idempotentPost(r *gin.Engine, user, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", "/api/files", strings.NewReader(body))
+	req.Header.Set(IdempotencyKeyHeader, key)
+	req.Header.Set("X-Test-User", user)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestIdempotency_ReplaysFirstResponse(t *testing.T) {
+	calls := 0
+	r := newIdempotentRouter(NewIdempotencyMemoryStore(), http.StatusCreated, &calls)
+
+	first := idempotentPost(r, "u1", "key-1", "payload")
+	retry := idempotentPost(r, "u1", "key-1", "payload")
+
+	if calls != 1 {
+		t.Fatalf("expected handler to run once, ran %d times", calls)
+	}
+	if retry.Code != http.StatusCreated || retry.Body.String() != first.Body.String() {
+		t.Fatalf("expected replay of %d %q, got %d %q", first.Code, first.Body.String(), retry.Code, retry.Body.String())
+	}
+	if retry.Header().Get(IdempotentReplayedHeader) != "true" || first.Header().Get(IdempotentReplayedHeader) != "" {
+		t.Fatal("expected only the replay to carry Idempotent-Replayed")
+	}
+	if !strings.HasPrefix(retry.Header().Get("Content-Type"), "application/json") {
+		t.Fatalf("expected stored content type, got %q", retry.Header().Get("Content-Type"))
+	}
+}
+
+func TestIdempotency_RejectsKeyReuseWithDifferentBody(t *testing.T) {
+	calls := 0
+	r := newIdempotentRouter(NewIdempotencyMemoryStore(), http.StatusOK, &calls)
+
+	idempotentPost(r, "u1", "key-1", "payload")
+	w := idempotentPost(r, "u1", "key-1", "other payload")
+
+	if w.Code != http.StatusUnprocessableEntity || calls != 1 {
+		t.Fatalf("expected 422 without running the handler, got %d after %d calls", w.Code, calls)
+	}
+}
+
+func TestIdempotency_ConflictWhileInFlight(t *testing.T) {
+	calls := 0
+	store := NewIdempotencyMemoryStore()
+	r := newIdempotentRouter(store, http.StatusOK, &calls)
+	if _, err := store.Begin(context.Background(), "user:u1:key-1", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	w := idempotentPost(r, "u1", "key-1", "payload")
+	if w.Code != http.StatusConflict || calls != 0 {
+		t.Fatalf("expected 409 without running the handler, got %d after %d calls", w.Code, calls)
+	}
+}
+
+func TestIdempotency_DoesNotStoreServerErrors(t *testing.T) {
+	calls := 0
+	r := newIdempotentRouter(NewIdempotencyMemoryStore(), http.StatusBadGateway, &calls)
+
+	idempotentPost(r, "u1", "key-1", "payload")
+	idempotentPost(r, "u1", "key-1", "payload")
+
+	if calls != 2 {
+		t.Fatalf("expected retries of a 5xx to reach the handler, ran %d times", calls)
+	}
+}
+
+// countingReader is an endless request body that counts what was read of it.
+type countingReader struct{ n int64 }
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	r.n += int64(len(p))
+	return len(p), nil
+}
+
+func TestIdempotency_EarlyRejectDoesNotReadBody(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	calls := 0
+	r := gin.New()
+	r.POST("/api/files", Idempotency(NewIdempotencyMemoryStore(), time.Hour), func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "too large"})
+	})
+
+	for i := 0; i < 2; i++ {
+		body := &countingReader{}
+		req := httptest.NewRequest("POST", "/api/files", body)
+		req.Header.Set(IdempotencyKeyHeader, "key-1")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != http.StatusRequestEntityTooLarge {
+			t.Fatalf("expected 413, got %d", w.Code)
+		}
+		if body.n > maxUnreadBodySize+64<<10 {
+			t.Fatalf("expected the unread body to be left alone, read %d bytes", body.n)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("expected the released key to let the retry through, ran %d times", calls)
+	}
+}
+
+func TestIdempotency_StoresWhenSmallBodyIsUnread(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	calls := 0
+	r := gin.New()
+	r.POST("/api/files", Idempotency(NewIdempotencyMemoryStore(), time.Hour), func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusBadRequest, gin.H{"error": "bad request"})
+	})
+
+	idempotentPost(r, "", "key-1", "payload")
+	w := idempotentPost(r, "", "key-1", "other payload")
+
+	if w.Code != http.StatusUnprocessableEntity || calls != 1 {
+		t.Fatalf("expected the body to be fingerprinted and the key kept, got %d after %d calls", w.Code, calls)
+	}
+}
+
+func TestIdempotency_FingerprintsQueryAndUploadHeaders(t *testing.T) {
+	calls := 0
+	r := newIdempotentRouter(NewIdempotencyMemoryStore(), http.StatusCreated, &calls)
+
+	send := func(target, filename string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", target, strings.NewReader("payload"))
+		req.Header.Set(IdempotencyKeyHeader, "key-1")
+		req.Header.Set("X-Filename", filename)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	send("/api/files?folder_id=a", "a.txt")
+	if w := send("/api/files?folder_id=b", "a.txt"); w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422 for a different query, got %d", w.Code)
+	}
+	if w := send("/api/files?folder_id=a", "b.txt"); w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422 for a different filename, got %d", w.Code)
+	}
+	if w := send("/api/files?folder_id=a", "a.txt"); w.Header().Get(IdempotentReplayedHeader) != "true" {
+		t.Fatalf("expected the same request to be replayed, got %d", w.Code)
+	}
+	if calls != 1 {
+		t.Fatalf("expected handler to run once, ran %d times", calls)
+	}
+}
+
+func TestIdempotency_MultipartRetryWithNewBoundaryReplays(t *testing.T) {
+	calls := 0
+	r := newIdempotentRouter(NewIdempotencyMemoryStore(), http.StatusCreated, &calls)
+
+	send := func(contents string) *httptest.ResponseRecorder {
+		var body bytes.Buffer
+		form := multipart.NewWriter(&body)
+		part, _ := form.CreateFormFile("file", "a.txt")
+		part.Write([]byte(contents))
+		form.Close()
+
+		req := httptest.NewRequest("POST", "/api/files", &body)
+		req.Header.Set(IdempotencyKeyHeader, "key-1")
+		req.Header.Set("Content-Type", form.FormDataContentType())
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	send("payload")
+	if w := send("payload"); w.Header().Get(IdempotentReplayedHeader) != "true" {
+		t.Fatalf("expected a retry with a new boundary to be replayed, got %d", w.Code)
+	}
+	if w := send("other payload"); w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422 for a different file, got %d", w.Code)
+	}
+}
+
+func TestFingerprint_BoundarySplitAcrossWrites(t *testing.T) {
+	req := httptest.NewRequest("POST", "/api/files", nil)
+	req.Header.Set("Content-Type", "multipart/form-data; boundary=abcdef")
+	whole := newFingerprint(req)
+	whole.Write([]byte("x--abcdef\r\ny--abcdef--"))
+
+	req.Header.Set("Content-Type", "multipart/form-data; boundary=uvwxyz")
+	split := newFingerprint(req)
+	for _, chunk := range []string{"x--uv", "wxyz\r\ny-", "-uvwxyz--"} {
+		split.Write([]byte(chunk))
+	}
+
+	if whole.sum() != split.sum() {
+		t.Fatal("expected the same fingerprint whatever the boundary and write sizes")
+	}
+}
+
+func TestIdempotency_ScopesKeysPerUser(t *testing.T) {
+	calls := 0
+	r := newIdempotentRouter(NewIdempotencyMemoryStore(), http.StatusOK, &calls)
+
+	idempotentPost(r, "u1", "key-1", "payload")
+	w := idempotentPost(r, "u2", "key-1", "payload")
+
+	if calls != 2 || w.Header().Get(IdempotentReplayedHeader) != "" {
+		t.Fatalf("expected another user's key not to replay, ran %d times", calls)
+	}
+}
+
+func TestIdempotencyMemoryStore_ExpiresEntries(t *testing.T) {
+	store := NewIdempotencyMemoryStore()
+	now := time.Unix(1700000000, 0)
+	store.now = func() time.Time { return now }
+
+	store.Begin(context.Background(), "k", time.Minute)
+	store.Complete(context.Background(), "k", StoredResponse{Status: http.StatusOK}, time.Minute)
+	now = now.Add(2 * time.Minute)
+
+	if resp, err := store.Begin(context.Background(), "k", time.Minute); resp != nil || err != nil {
+		t.Fatalf("expected expired entry to be reserved afresh, got %+v, %v", resp, err)
+	}
+}
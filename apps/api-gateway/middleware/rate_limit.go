@@ -0,0 +1,219 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+)
+
+// Rate is a token bucket: Burst requests may be made at once, refilled at
+// Requests per Period.
+type Rate struct {
+	Requests int
+	Period   time.Duration
+	Burst    int
+}
+
+// ParseRate parses "N/unit" where unit is s, m or h, e.g. "10/m". An optional
+// ",burst" suffix overrides the bucket size, which otherwise equals N. The
+// empty string parses to the zero Rate, which disables limiting.
+func ParseRate(s string) (Rate, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Rate{}, nil
+	}
+
+	spec, burstStr, hasBurst := strings.Cut(s, ",")
+	countStr, unit, ok := strings.Cut(spec, "/")
+	if !ok {
+		return Rate{}, fmt.Errorf("rate limit %q: expected N/unit", s)
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(countStr))
+	if err != nil || count <= 0 {
+		return Rate{}, fmt.Errorf("rate limit %q: invalid request count", s)
+	}
+
+	var period time.Duration
+	switch strings.TrimSpace(unit) {
+	case "s":
+		period = time.Second
+	case "m":
+		period = time.Minute
+	case "h":
+		period = time.Hour
+	default:
+		return Rate{}, fmt.Errorf("rate limit %q: unit must be s, m or h", s)
+	}
+
+	burst := count
+	if hasBurst {
+		burst, err = strconv.Atoi(strings.TrimSpace(burstStr))
+		if err != nil || burst <= 0 {
+			return Rate{}, fmt.Errorf("rate limit %q: invalid burst", s)
+		}
+	}
+	return Rate{Requests: count, Period: period, Burst: burst}, nil
+}
+
+// Enabled reports whether r limits anything.
+func (r Rate) Enabled() bool {
+	return r.Requests > 0 && r.Period > 0 && r.Burst > 0
+}
+
+// RateLimitResult is a store's decision for one request.
+type RateLimitResult struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset is how long until the bucket is full again.
+	Reset time.Duration
+	// RetryAfter is how long until the next request would be allowed. It is
+	// zero when Allowed is true.
+	RetryAfter time.Duration
+}
+
+// RateLimitStore takes a token from the bucket for key. Implementations must
+// be safe for concurrent use; a shared backend such as Redis can replace the
+// in-memory store without changing the middleware.
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, rate Rate) (RateLimitResult, error)
+}
+
+// RateLimitKeyFunc identifies the caller a request is counted against.
+type RateLimitKeyFunc func(c *gin.Context) string
+
+// KeyByIP counts requests per client IP.
+func KeyByIP(c *gin.Context) string {
+	return "ip:" + c.ClientIP()
+}
+
+// KeyByUser counts requests per authenticated user, falling back to the client
+// IP before ValidateRole has set a user.
+func KeyByUser(c *gin.Context) string {
+	if v, ok := c.Get("user"); ok {
+		if user, ok := v.(*userv1.User); ok && user.GetId() != "" {
+			return "user:" + user.GetId()
+		}
+	}
+	return KeyByIP(c)
+}
+
+// KeyByRouteGroup counts every request to the group against one shared bucket.
+func KeyByRouteGroup(*gin.Context) string {
+	return "group"
+}
+
+// RateLimit rejects requests with 429 once the caller's bucket for name is
+// empty. Every response carries RateLimit-Limit, RateLimit-Remaining and
+// RateLimit-Reset headers; rejections also carry Retry-After. If the store
+// fails, the request is let through so an outage does not take the API down.
+func RateLimit(store RateLimitStore, name string, rate Rate, key RateLimitKeyFunc) gin.HandlerFunc {
+	if !rate.Enabled() {
+		return func(c *gin.Context) { c.Next() }
+	}
+
+	return func(c *gin.Context) {
+		res, err := store.Take(c.Request.Context(), name+":"+key(c), rate)
+		if err != nil {
+			slog.WarnContext(c.Request.Context(), "rate limit store unavailable", "limiter", name, "error", err)
+			c.Next()
+			return
+		}
+
+		c.Header("RateLimit-Limit", strconv.Itoa(res.Limit))
+		c.Header("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		c.Header("RateLimit-Reset", strconv.Itoa(ceilSeconds(res.Reset)))
+
+		if !res.Allowed {
+			c.Header("Retry-After", strconv.Itoa(ceilSeconds(res.RetryAfter)))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "rate limit exceeded"})
+			return
+		}
+		c.Next()
+	}
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// MemoryStore keeps token buckets in process memory. Buckets that have
+// refilled completely are dropped during periodic sweeps.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	now       func() time.Time
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	rate    Rate
+}
+
+const sweepInterval = time.Minute
+
+// NewMemoryStore returns an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*bucket), now: time.Now}
+}
+
+// Take implements RateLimitStore.
+func (s *MemoryStore) Take(_ context.Context, key string, rate Rate) (RateLimitResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.lastSweep) >= sweepInterval {
+		s.sweep(now)
+	}
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(rate.Burst), updated: now}
+		s.buckets[key] = b
+	}
+	b.rate = rate
+	b.refill(now)
+
+	perToken := rate.Period / time.Duration(rate.Requests)
+	res := RateLimitResult{Limit: rate.Burst}
+	if b.tokens >= 1 {
+		b.tokens--
+		res.Allowed = true
+	} else {
+		res.RetryAfter = time.Duration((1 - b.tokens) * float64(perToken))
+	}
+	res.Remaining = int(b.tokens)
+	res.Reset = time.Duration((float64(rate.Burst) - b.tokens) * float64(perToken))
+	return res, nil
+}
+
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.updated)
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens = math.Min(float64(b.rate.Burst), b.tokens+elapsed.Seconds()*float64(b.rate.Requests)/b.rate.Period.Seconds())
+	b.updated = now
+}
+
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, b := range s.buckets {
+		b.refill(now)
+		if b.tokens >= float64(b.rate.Burst) {
+			delete(s.buckets, key)
+		}
+	}
+	s.lastSweep = now
+}
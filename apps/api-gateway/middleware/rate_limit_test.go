@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+)
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Rate
+		err  bool
+	}{
+		{in: "", want: Rate{}},
+		{in: "10/m", want: Rate{Requests: 10, Period: time.Minute, Burst: 10}},
+		{in: "5/s,20", want: Rate{Requests: 5, Period: time.Second, Burst: 20}},
+		{in: "100/h", want: Rate{Requests: 100, Period: time.Hour, Burst: 100}},
+		{in: "10", err: true},
+		{in: "0/m", err: true},
+		{in: "10/d", err: true},
+		{in: "10/m,x", err: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseRate(tt.in)
+		if (err != nil) != tt.err {
+			t.Fatalf("ParseRate(%q) error = %v, want error %v", tt.in, err, tt.err)
+		}
+		if got != tt.want {
+			t.Fatalf("ParseRate(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func newLimitedRouter(store RateLimitStore, rate Rate, key RateLimitKeyFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/limited", RateLimit(store, "test", rate, key), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return r
+}
+
+func TestRateLimit_RejectsWithHeadersOnceBucketIsEmpty(t *testing.T) {
+	store := NewMemoryStore()
+	r := newLimitedRouter(store, Rate{Requests: 2, Period: time.Minute, Burst: 2}, KeyByIP)
+
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/limited", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("request %d: expected 200, got %d", i, w.Code)
+		}
+		if w.Header().Get("RateLimit-Limit") != "2" {
+			t.Fatalf("expected RateLimit-Limit 2, got %q", w.Header().Get("RateLimit-Limit"))
+		}
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/limited", nil))
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429, got %d", w.Code)
+	}
+	if w.Header().Get("Retry-After") != "30" || w.Header().Get("RateLimit-Remaining") != "0" || w.Header().Get("RateLimit-Reset") != "60" {
+		t.Fatalf("unexpected rate limit headers: %v", w.Header())
+	}
+}
+
+func TestMemoryStore_RefillsOverTime(t *testing.T) {
+	store := NewMemoryStore()
+	now := time.Unix(1700000000, 0)
+	store.now = func() time.Time { return now }
+	rate := Rate{Requests: 1, Period: time.Second, Burst: 1}
+
+	if res, _ := store.Take(context.Background(), "k", rate); !res.Allowed {
+		t.Fatal("expected first request to be allowed")
+	}
+	if res, _ := store.Take(context.Background(), "k", rate); res.Allowed || res.RetryAfter != time.Second {
+		t.Fatalf("expected rejection with 1s retry, got %+v", res)
+	}
+	now = now.Add(time.Second)
+	if res, _ := store.Take(context.Background(), "k", rate); !res.Allowed {
+		t.Fatal("expected request to be allowed after refill")
+	}
+}
+
+func TestMemoryStore_SweepsFullBuckets(t *testing.T) {
+	store := NewMemoryStore()
+	now := time.Unix(1700000000, 0)
+	store.now = func() time.Time { return now }
+	rate := Rate{Requests: 10, Period: time.Second, Burst: 10}
+
+	store.Take(context.Background(), "a", rate)
+	now = now.Add(2 * sweepInterval)
+	store.Take(context.Background(), "b", rate)
+
+	if _, ok := store.buckets["a"]; ok {
+		t.Fatal("expected idle bucket to be swept")
+	}
+}
+
+func TestKeyByUser_FallsBackToIP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/", nil)
+	c.Request.RemoteAddr = "203.0.113.7:1234"
+
+	if got := KeyByUser(c); got != "ip:203.0.113.7" {
+		t.Fatalf("expected IP key, got %q", got)
+	}
+	c.Set("user", &userv1.User{Id: "u1"})
+	if got := KeyByUser(c); got != "user:u1" {
+		t.Fatalf("expected user key, got %q", got)
+	}
+}
+
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, Rate) (RateLimitResult, error) {
+	return RateLimitResult{}, errors.New("store down")
+}
+
+func TestRateLimit_FailsOpenWhenStoreErrors(t *testing.T) {
+	r := newLimitedRouter(failingStore{}, Rate{Requests: 1, Period: time.Second, Burst: 1}, KeyByRouteGroup)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/limited", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected request to pass when the store fails, got %d", w.Code)
+	}
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/provsalt/DOP_P01_Team1/common/interceptor"
+)
+
+// RequestIDHeader is the canonical form of the request ID header.
+const RequestIDHeader = "X-Request-ID"
+
+// RequestID reuses a well-formed X-Request-ID from the client or generates one,
+// echoes it in the response and stores it in the request context so outgoing
+// gRPC calls forward it to backend services.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !interceptor.ValidRequestID(id) {
+			id = interceptor.NewRequestID()
+		}
+
+		c.Set("request_id", id)
+		c.Header(RequestIDHeader, id)
+		c.Request = c.Request.WithContext(interceptor.WithRequestID(c.Request.Context(), id))
+		c.Next()
+	}
+}
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/provsalt/DOP_P01_Team1/common/interceptor"
+)
+
+func setupRequestIDRoute(seen *string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(RequestID())
+	r.GET("/ping", func(c *gin.Context) {
+		*seen = interceptor.RequestIDFromContext(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+	return r
+}
+
+func TestRequestID_ReusesClientHeader(t *testing.T) {
+	var seen string
+	r := setupRequestIDRoute(&seen)
+
+	req, _ := http.NewRequest("GET", "/ping", nil)
+	req.Header.Set(RequestIDHeader, "client-id-1")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if seen != "client-id-1" {
+		t.Fatalf("expected request context to carry client-id-1, got %q", seen)
+	}
+	if got := w.Header().Get(RequestIDHeader); got != "client-id-1" {
+		t.Fatalf("expected response header client-id-1, got %q", got)
+	}
+}
+
+func TestRequestID_GeneratesWhenMissingOrInvalid(t *testing.T) {
+	for _, incoming := range []string{"", "not valid"} {
+		var seen string
+		r := setupRequestIDRoute(&seen)
+
+		req, _ := http.NewRequest("GET", "/ping", nil)
+		if incoming != "" {
+			req.Header.Set(RequestIDHeader, incoming)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if seen == "" || seen == incoming {
+			t.Fatalf("expected a generated request ID for %q, got %q", incoming, seen)
+		}
+		if got := w.Header().Get(RequestIDHeader); got != seen {
+			t.Fatalf("expected response header %q, got %q", seen, got)
+		}
+	}
+}
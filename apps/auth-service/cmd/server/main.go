@@ -2,9 +2,15 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/provsalt/DOP_P01_Team1/auth-service/internal/client"
 	"github.com/provsalt/DOP_P01_Team1/auth-service/internal/config"
@@ -12,7 +18,11 @@ import (
 	"github.com/provsalt/DOP_P01_Team1/auth-service/internal/jwt"
 	"github.com/provsalt/DOP_P01_Team1/auth-service/internal/service"
 	authv1 "github.com/provsalt/DOP_P01_Team1/common/auth/v1"
+	"github.com/provsalt/DOP_P01_Team1/common/interceptor"
+	"github.com/provsalt/DOP_P01_Team1/common/logging"
+	"github.com/provsalt/DOP_P01_Team1/common/serviceauth"
 	"github.com/provsalt/DOP_P01_Team1/common/telemetry"
+	"github.com/provsalt/DOP_P01_Team1/common/tlsconfig"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health/grpc_health_v1"
@@ -22,51 +32,146 @@ import (
 func main() {
 	cfg, err := config.Load()
 	if err != nil {
-		log.Fatalf("Failed to load configuration: %v", err)
-	}
-
-	if cfg.AxiomToken == "" {
-		log.Printf("Tracing disabled: AXIOM_API_TOKEN is empty")
-	} else {
-		shutdown, err := telemetry.InitTelemetry(context.Background(), telemetry.Config{
-			ServiceName:    "auth-service",
-			Environment:    cfg.Environment,
-			Token:          cfg.AxiomToken,
-			Endpoint:       cfg.AxiomEndpoint,
-			Dataset:        cfg.AxiomDataset,
-			MetricsDataset: cfg.AxiomMetricsDataset,
-		})
-		if err != nil {
-			log.Printf("Tracing disabled: failed to initialize tracer: %v", err)
-		} else {
-			defer shutdown(context.Background())
-		}
+		fatal("Failed to load configuration", "error", err)
+	}
+
+	if _, err := logging.Setup(logging.Config{
+		ServiceName: "auth-service",
+		Environment: cfg.Environment,
+		Level:       cfg.LogLevel,
+		Format:      cfg.LogFormat,
+	}); err != nil {
+		fatal("Failed to configure logging", "error", err)
 	}
 
-	log.Printf("Using user-service at: %s", cfg.UserServiceAddr)
+	telemetryCfg := telemetry.Config{
+		ServiceName: "auth-service",
+		Environment: cfg.Environment,
+		Prometheus:  cfg.PrometheusEnabled,
+	}
+	// Axiom is kept as a shortcut; otherwise the OTEL_* variables decide where
+	// telemetry goes, and nothing is exported when none are set.
+	if cfg.AxiomToken != "" {
+		telemetryCfg.Token = cfg.AxiomToken
+		telemetryCfg.Endpoint = cfg.AxiomEndpoint
+		telemetryCfg.Dataset = cfg.AxiomDataset
+		telemetryCfg.MetricsDataset = cfg.AxiomMetricsDataset
+	}
+	shutdownTelemetry, err := telemetry.InitTelemetry(context.Background(), telemetryCfg)
+	if err != nil {
+		slog.Warn("Telemetry disabled: failed to initialize", "error", err)
+		shutdownTelemetry = func(context.Context) error { return nil }
+	}
+
+	var adminServer *http.Server
+	if cfg.PrometheusEnabled {
+		adminServer = startAdminServer(cfg.AdminPort)
+	}
+
+	slog.Info("Using user-service", "addr", cfg.UserServiceAddr)
+
+	clientCreds, err := tlsconfig.ClientCredentials(cfg.TLS())
+	if err != nil {
+		fatal("Failed to load client TLS configuration", "error", err)
+	}
+	serverCreds, err := tlsconfig.ServerCredentials(cfg.TLS())
+	if err != nil {
+		fatal("Failed to load server TLS configuration", "error", err)
+	}
+
+	userDialOpts := append([]grpc.DialOption{grpc.WithTransportCredentials(clientCreds)}, interceptor.ClientOptions()...)
+	if cfg.ServiceAuthSecret != "" {
+		userDialOpts = append(userDialOpts, grpc.WithPerRPCCredentials(
+			serviceauth.NewSigner("auth-service", "user-service", cfg.ServiceAuthSecret),
+		))
+	}
 
-	userClient, err := client.NewUserServiceClient(cfg.UserServiceAddr)
+	userClient, err := client.NewUserServiceClient(cfg.UserServiceAddr, userDialOpts...)
 	if err != nil {
-		log.Fatalf("Failed to create user service client: %v", err)
+		fatal("Failed to create user service client", "error", err)
 	}
-	defer userClient.Close()
 
 	jwtManager := jwt.NewJWTManager(cfg.JWTSecret, cfg.JWTExpiry)
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.Port))
 	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
+		fatal("Failed to listen", "error", err)
 	}
 
-	grpcServer := grpc.NewServer(
+	serverOpts := append([]grpc.ServerOption{
+		grpc.Creds(serverCreds),
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-	)
+	}, interceptor.ServerOptions()...)
+	grpcServer := grpc.NewServer(serverOpts...)
 	authv1.RegisterAuthServiceServer(grpcServer, service.NewAuthServiceServer(userClient, jwtManager))
-	grpc_health_v1.RegisterHealthServer(grpcServer, health.NewHealthServer())
+	healthServer := health.NewHealthServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
 	reflection.Register(grpcServer)
 
-	log.Printf("Auth service listening on :%s", cfg.Port)
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		slog.Info("Auth service listening", "port", cfg.Port, "tls", cfg.TLS().ServerEnabled())
+		serveErr <- grpcServer.Serve(lis)
+	}()
+
+	exitCode := 0
+	select {
+	case err := <-serveErr:
+		slog.Error("Failed to serve", "error", err)
+		exitCode = 1
+	case <-ctx.Done():
+		slog.Info("Shutdown signal received, draining in-flight RPCs", "delay", cfg.ShutdownDrainDelay.String(), "timeout", cfg.ShutdownTimeout.String())
+		healthServer.Drain(grpcServer, cfg.ShutdownDrainDelay, cfg.ShutdownTimeout)
+	}
+
+	if err := userClient.Close(); err != nil {
+		slog.Error("Failed to close user service client", "error", err)
+	}
+
+	if adminServer != nil {
+		adminCtx, cancelAdmin := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := adminServer.Shutdown(adminCtx); err != nil {
+			slog.Error("Failed to stop admin server", "error", err)
+		}
+		cancelAdmin()
+	}
+
+	flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTelemetry(flushCtx); err != nil {
+		slog.Error("Failed to flush telemetry", "error", err)
 	}
+
+	slog.Info("Auth service stopped")
+	if exitCode != 0 {
+		os.Exit(exitCode)
+	}
+}
+
+// startAdminServer serves /metrics on port. Scrapes go over plain HTTP so they
+// need neither client certificates nor service tokens.
+func startAdminServer(port string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", telemetry.MetricsHandler())
+	srv := &http.Server{
+		Addr:              ":" + port,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		slog.Info("Admin server listening", "port", port)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Admin server failed", "error", err)
+		}
+	}()
+	return srv
+}
+
+// fatal logs msg at error level and exits, mirroring log.Fatal for slog.
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
 }
@@ -15,11 +15,11 @@ type UserServiceClient struct {
 	conn   *grpc.ClientConn
 }
 
-func NewUserServiceClient(address string) (*UserServiceClient, error) {
-	conn, err := grpc.NewClient(address,
+func NewUserServiceClient(address string, opts ...grpc.DialOption) (*UserServiceClient, error) {
+	conn, err := grpc.NewClient(address, append([]grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
-	)
+	}, opts...)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to user service: %w", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/provsalt/DOP_P01_Team1/common/tlsconfig"
 )
 
 type Config struct {
@@ -11,12 +12,33 @@ type Config struct {
 	UserServiceAddr string        `env:"USER_SERVICE_ADDR" env-default:"localhost:8080"`
 	JWTSecret       string        `env:"JWT_SECRET" env-required:"true"`
 	JWTExpiry       time.Duration `env:"JWT_EXPIRY" env-default:"24h"`
+	// ShutdownTimeout bounds how long in-flight RPCs may drain after SIGTERM.
+	ShutdownTimeout time.Duration `env:"SHUTDOWN_TIMEOUT" env-default:"30s"`
+	// ShutdownDrainDelay is how long health reports NOT_SERVING while new RPCs
+	// are still accepted, so clients can stop routing here first.
+	ShutdownDrainDelay time.Duration `env:"SHUTDOWN_DRAIN_DELAY" env-default:"5s"`
 	// Telemetry
 	AxiomToken          string `env:"AXIOM_API_TOKEN"`
 	AxiomEndpoint       string `env:"AXIOM_ENDPOINT" env-default:"us-east-1.aws.edge.axiom.co"`
 	AxiomDataset        string `env:"AXIOM_DATASET" env-default:"traces"`
 	AxiomMetricsDataset string `env:"AXIOM_METRICS_DATASET" env-default:"metrics"`
 	Environment         string `env:"ENVIRONMENT" env-default:"development"`
+	// Logging
+	LogLevel  string `env:"LOG_LEVEL" env-default:"info"`
+	LogFormat string `env:"LOG_FORMAT" env-default:"json"`
+	// ServiceAuthSecret signs the service tokens internal services present to
+	// each other. It must match across services.
+	ServiceAuthSecret string `env:"SERVICE_AUTH_SECRET"`
+	// TLS for gRPC. CERT/KEY are this service's identity; CA verifies peers and,
+	// on servers, requires client certificates. Files are reloaded on change.
+	TLSCertFile string `env:"TLS_CERT_FILE"`
+	TLSKeyFile  string `env:"TLS_KEY_FILE"`
+	TLSCAFile   string `env:"TLS_CA_FILE"`
+	TLSDevMode  bool   `env:"TLS_DEV_MODE" env-default:"false"`
+	// PrometheusEnabled serves /metrics for scraping on AdminPort, an HTTP
+	// listener kept apart from the gRPC port.
+	PrometheusEnabled bool   `env:"PROMETHEUS_ENABLED" env-default:"false"`
+	AdminPort         string `env:"ADMIN_PORT" env-default:"9081"`
 }
 
 func Load() (*Config, error) {
@@ -26,3 +48,13 @@ func Load() (*Config, error) {
 	}
 	return &cfg, nil
 }
+
+// TLS returns the gRPC TLS settings in the form the tlsconfig package expects.
+func (c *Config) TLS() tlsconfig.Config {
+	return tlsconfig.Config{
+		CertFile: c.TLSCertFile,
+		KeyFile:  c.TLSKeyFile,
+		CAFile:   c.TLSCAFile,
+		DevMode:  c.TLSDevMode,
+	}
+}
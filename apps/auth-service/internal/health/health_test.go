@@ -0,0 +1,74 @@
+package health
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestHealthServer_Drain_ReportsNotServingBeforeStopping(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	grpcServer := grpc.NewServer()
+	healthServer := NewHealthServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+	go grpcServer.Serve(lis)
+
+	const delay = time.Second
+	start := time.Now()
+	drained := make(chan struct{})
+	go func() {
+		healthServer.Drain(grpcServer, delay, time.Second)
+		close(drained)
+	}()
+
+	// A fresh connection per check, so each one proves the listener still
+	// accepts them.
+	check := func() (grpc_health_v1.HealthCheckResponse_ServingStatus, error) {
+		conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return 0, err
+		}
+		defer conn.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+		return resp.GetStatus(), err
+	}
+
+	deadline := time.Now().Add(delay / 2)
+	for {
+		status, err := check()
+		if err != nil {
+			t.Fatalf("health check during drain failed: %v", err)
+		}
+		if status == grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("health still reports %v during drain", status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	select {
+	case <-drained:
+		t.Fatal("Drain returned before the delay")
+	default:
+	}
+
+	<-drained
+	if elapsed := time.Since(start); elapsed < delay {
+		t.Fatalf("Drain took %s, want at least %s", elapsed, delay)
+	}
+	if _, err := check(); err == nil {
+		t.Fatal("expected calls to fail once drained")
+	}
+}
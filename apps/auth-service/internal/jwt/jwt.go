@@ -7,6 +7,10 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// ErrExpired is wrapped by the error Validate returns for a token that is
+// otherwise valid but past its expiry.
+var ErrExpired = jwt.ErrTokenExpired
+
 type Claims struct {
 	UserID   string `json:"user_id"`
 	Username string `json:"username"`
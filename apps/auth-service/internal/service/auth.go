@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/provsalt/DOP_P01_Team1/auth-service/internal/client"
 	"github.com/provsalt/DOP_P01_Team1/auth-service/internal/jwt"
@@ -16,38 +18,50 @@ type AuthServiceServer struct {
 	authv1.UnimplementedAuthServiceServer
 	userClient client.UserClient
 	jwtManager *jwt.Manager
+	metrics    *authMetrics
 }
 
 func NewAuthServiceServer(userClient client.UserClient, jwtManager *jwt.Manager) *AuthServiceServer {
 	return &AuthServiceServer{
 		userClient: userClient,
 		jwtManager: jwtManager,
+		metrics:    newAuthMetrics(),
 	}
 }
 
 func (s *AuthServiceServer) SignUp(ctx context.Context, req *authv1.SignUpRequest) (*authv1.SignUpResponse, error) {
 	if req.Username == "" {
+		s.metrics.recordSignUp(ctx, reasonMissingCredentials)
 		return nil, status.Error(codes.InvalidArgument, "username is required")
 	}
 	if req.Password == "" {
+		s.metrics.recordSignUp(ctx, reasonMissingCredentials)
 		return nil, status.Error(codes.InvalidArgument, "password is required")
 	}
 
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
+		s.metrics.recordSignUp(ctx, reasonInternal)
 		return nil, status.Error(codes.Internal, "failed to hash password")
 	}
 
 	user, err := s.userClient.CreateUser(ctx, req.Username, string(hashedPassword), userv1.Role_ROLE_USER)
 	if err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			s.metrics.recordSignUp(ctx, reasonAlreadyExists)
+		} else {
+			s.metrics.recordSignUp(ctx, reasonUpstreamError)
+		}
 		return nil, err
 	}
 
 	token, err := s.jwtManager.Generate(user.Id, user.Username, user.Role.String())
 	if err != nil {
+		s.metrics.recordSignUp(ctx, reasonTokenError)
 		return nil, status.Error(codes.Internal, "failed to generate token")
 	}
 
+	s.metrics.recordSignUp(ctx, "")
 	return &authv1.SignUpResponse{
 		User:  user,
 		Token: token,
@@ -55,27 +69,34 @@ func (s *AuthServiceServer) SignUp(ctx context.Context, req *authv1.SignUpReques
 }
 
 func (s *AuthServiceServer) Login(ctx context.Context, req *authv1.LoginRequest) (*authv1.LoginResponse, error) {
+	start := time.Now()
 	if req.Username == "" {
+		s.metrics.recordLogin(ctx, start, reasonMissingCredentials)
 		return nil, status.Error(codes.InvalidArgument, "username is required")
 	}
 	if req.Password == "" {
+		s.metrics.recordLogin(ctx, start, reasonMissingCredentials)
 		return nil, status.Error(codes.InvalidArgument, "password is required")
 	}
 
 	user, valid, err := s.userClient.VerifyPassword(ctx, req.Username, req.Password)
 	if err != nil {
+		s.metrics.recordLogin(ctx, start, reasonUpstreamError)
 		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
 	}
 
 	if !valid {
+		s.metrics.recordLogin(ctx, start, reasonInvalidCredentials)
 		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
 	}
 
 	token, err := s.jwtManager.Generate(user.Id, user.Username, user.Role.String())
 	if err != nil {
+		s.metrics.recordLogin(ctx, start, reasonTokenError)
 		return nil, status.Error(codes.Internal, "failed to generate token")
 	}
 
+	s.metrics.recordLogin(ctx, start, "")
 	return &authv1.LoginResponse{
 		User:  user,
 		Token: token,
@@ -89,10 +110,16 @@ func (s *AuthServiceServer) ValidateToken(ctx context.Context, req *authv1.Valid
 
 	claims, err := s.jwtManager.Validate(req.Token)
 	if err != nil {
+		if errors.Is(err, jwt.ErrExpired) {
+			s.metrics.recordValidation(ctx, "expired")
+		} else {
+			s.metrics.recordValidation(ctx, "invalid")
+		}
 		return &authv1.ValidateTokenResponse{
 			Valid: false,
 		}, nil
 	}
+	s.metrics.recordValidation(ctx, "valid")
 	return &authv1.ValidateTokenResponse{
 		Valid: true,
 		User: &userv1.User{
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/provsalt/DOP_P01_Team1/auth-service/internal/service"
+
+// Failure reasons recorded on login and sign-up metrics. They are a fixed set
+// so the attribute stays low-cardinality.
+const (
+	reasonMissingCredentials = "missing_credentials"
+	reasonInvalidCredentials = "invalid_credentials"
+	reasonAlreadyExists      = "already_exists"
+	reasonUpstreamError      = "upstream_error"
+	reasonTokenError         = "token_error"
+	reasonInternal           = "internal"
+)
+
+var (
+	resultKey = attribute.Key("auth.result")
+	reasonKey = attribute.Key("auth.failure_reason")
+)
+
+type authMetrics struct {
+	logins        metric.Int64Counter
+	loginDuration metric.Float64Histogram
+	signups       metric.Int64Counter
+	validations   metric.Int64Counter
+}
+
+// newAuthMetrics creates the instruments on the global meter provider, which
+// forwards to the SDK provider once telemetry.InitTelemetry has run.
+func newAuthMetrics() *authMetrics {
+	meter := otel.GetMeterProvider().Meter(meterName)
+	m := &authMetrics{}
+	var err, errs error
+
+	m.logins, err = meter.Int64Counter("auth.logins",
+		metric.WithDescription("Login attempts by result and failure reason."),
+		metric.WithUnit("{attempt}"))
+	errs = errors.Join(errs, err)
+	m.loginDuration, err = meter.Float64Histogram("auth.login.duration",
+		metric.WithDescription("Time taken to verify credentials and issue a token."),
+		metric.WithUnit("s"))
+	errs = errors.Join(errs, err)
+	m.signups, err = meter.Int64Counter("auth.signups",
+		metric.WithDescription("Sign-up attempts by result and failure reason."),
+		metric.WithUnit("{attempt}"))
+	errs = errors.Join(errs, err)
+	m.validations, err = meter.Int64Counter("auth.token.validations",
+		metric.WithDescription("Token validations by result."),
+		metric.WithUnit("{validation}"))
+	errs = errors.Join(errs, err)
+
+	if errs != nil {
+		otel.Handle(errs)
+	}
+	return m
+}
+
+func outcome(reason string) metric.MeasurementOption {
+	if reason == "" {
+		return metric.WithAttributes(resultKey.String("success"))
+	}
+	return metric.WithAttributes(resultKey.String("failure"), reasonKey.String(reason))
+}
+
+func (m *authMetrics) recordLogin(ctx context.Context, start time.Time, reason string) {
+	m.logins.Add(ctx, 1, outcome(reason))
+	m.loginDuration.Record(ctx, time.Since(start).Seconds(), outcome(reason))
+}
+
+func (m *authMetrics) recordSignUp(ctx context.Context, reason string) {
+	m.signups.Add(ctx, 1, outcome(reason))
+}
+
+func (m *authMetrics) recordValidation(ctx context.Context, result string) {
+	m.validations.Add(ctx, 1, metric.WithAttributes(resultKey.String(result)))
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	authv1 "github.com/provsalt/DOP_P01_Team1/common/auth/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func setupMeteredAuthService(t *testing.T) (*AuthServiceServer, *sdkmetric.ManualReader) {
+	t.Helper()
+	reader := sdkmetric.NewManualReader()
+	prev := otel.GetMeterProvider()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	t.Cleanup(func() { otel.SetMeterProvider(prev) })
+	return setupAuthService(), reader
+}
+
+// counterValues returns the data points of a counter keyed by attribute set.
+func counterValues(t *testing.T, reader *sdkmetric.ManualReader, name string) map[attribute.Distinct]int64 {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+	values := make(map[attribute.Distinct]int64)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != name {
+				continue
+			}
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				values[dp.Attributes.Equivalent()] = dp.Value
+			}
+		}
+	}
+	return values
+}
+
+func key(kvs ...attribute.KeyValue) attribute.Distinct {
+	set := attribute.NewSet(kvs...)
+	return set.Equivalent()
+}
+
+func TestMetrics_LoginByResultAndReason(t *testing.T) {
+	svc, reader := setupMeteredAuthService(t)
+	ctx := context.Background()
+
+	_, _ = svc.Login(ctx, &authv1.LoginRequest{Username: "testuser", Password: "password123"})
+	_, _ = svc.Login(ctx, &authv1.LoginRequest{Username: "testuser", Password: "wrong"})
+	_, _ = svc.Login(ctx, &authv1.LoginRequest{Username: "testuser"})
+
+	values := counterValues(t, reader, "auth.logins")
+	if got := values[key(resultKey.String("success"))]; got != 1 {
+		t.Fatalf("expected 1 successful login, got %d (%v)", got, values)
+	}
+	// The mock user client returns an error rather than valid=false.
+	if got := values[key(resultKey.String("failure"), reasonKey.String(reasonUpstreamError))]; got != 1 {
+		t.Fatalf("expected 1 upstream failure, got %d (%v)", got, values)
+	}
+	if got := values[key(resultKey.String("failure"), reasonKey.String(reasonMissingCredentials))]; got != 1 {
+		t.Fatalf("expected 1 missing-credentials failure, got %d (%v)", got, values)
+	}
+}
+
+func TestMetrics_SignUpsAndValidations(t *testing.T) {
+	svc, reader := setupMeteredAuthService(t)
+	ctx := context.Background()
+
+	resp, err := svc.SignUp(ctx, &authv1.SignUpRequest{Username: "alice", Password: "secret123"})
+	if err != nil {
+		t.Fatalf("SignUp: %v", err)
+	}
+	_, _ = svc.ValidateToken(ctx, &authv1.ValidateTokenRequest{Token: resp.Token})
+	_, _ = svc.ValidateToken(ctx, &authv1.ValidateTokenRequest{Token: "not-a-jwt"})
+
+	if got := counterValues(t, reader, "auth.signups")[key(resultKey.String("success"))]; got != 1 {
+		t.Fatalf("expected 1 successful sign-up, got %d", got)
+	}
+	validations := counterValues(t, reader, "auth.token.validations")
+	if validations[key(resultKey.String("valid"))] != 1 || validations[key(resultKey.String("invalid"))] != 1 {
+		t.Fatalf("unexpected validation counts: %v", validations)
+	}
+}
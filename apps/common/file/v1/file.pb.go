@@ -9,6 +9,7 @@ package filev1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +22,159 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type FileSortField int32
+
+const (
+	FileSortField_FILE_SORT_FIELD_UNSPECIFIED FileSortField = 0
+	FileSortField_FILE_SORT_FIELD_NAME        FileSortField = 1
+	FileSortField_FILE_SORT_FIELD_SIZE        FileSortField = 2
+	FileSortField_FILE_SORT_FIELD_CREATED_AT  FileSortField = 3
+)
+
+// Enum value maps for FileSortField.
+var (
+	FileSortField_name = map[int32]string{
+		0: "FILE_SORT_FIELD_UNSPECIFIED",
+		1: "FILE_SORT_FIELD_NAME",
+		2: "FILE_SORT_FIELD_SIZE",
+		3: "FILE_SORT_FIELD_CREATED_AT",
+	}
+	FileSortField_value = map[string]int32{
+		"FILE_SORT_FIELD_UNSPECIFIED": 0,
+		"FILE_SORT_FIELD_NAME":        1,
+		"FILE_SORT_FIELD_SIZE":        2,
+		"FILE_SORT_FIELD_CREATED_AT":  3,
+	}
+)
+
+func (x FileSortField) Enum() *FileSortField {
+	p := new(FileSortField)
+	*p = x
+	return p
+}
+
+func (x FileSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FileSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_file_v1_file_proto_enumTypes[0].Descriptor()
+}
+
+func (FileSortField) Type() protoreflect.EnumType {
+	return &file_file_v1_file_proto_enumTypes[0]
+}
+
+func (x FileSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FileSortField.Descriptor instead.
+func (FileSortField) EnumDescriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{0}
+}
+
+type ConflictPolicy int32
+
+const (
+	ConflictPolicy_CONFLICT_POLICY_UNSPECIFIED ConflictPolicy = 0
+	ConflictPolicy_CONFLICT_POLICY_FAIL        ConflictPolicy = 1
+	ConflictPolicy_CONFLICT_POLICY_RENAME      ConflictPolicy = 2
+	ConflictPolicy_CONFLICT_POLICY_REPLACE     ConflictPolicy = 3
+)
+
+// Enum value maps for ConflictPolicy.
+var (
+	ConflictPolicy_name = map[int32]string{
+		0: "CONFLICT_POLICY_UNSPECIFIED",
+		1: "CONFLICT_POLICY_FAIL",
+		2: "CONFLICT_POLICY_RENAME",
+		3: "CONFLICT_POLICY_REPLACE",
+	}
+	ConflictPolicy_value = map[string]int32{
+		"CONFLICT_POLICY_UNSPECIFIED": 0,
+		"CONFLICT_POLICY_FAIL":        1,
+		"CONFLICT_POLICY_RENAME":      2,
+		"CONFLICT_POLICY_REPLACE":     3,
+	}
+)
+
+func (x ConflictPolicy) Enum() *ConflictPolicy {
+	p := new(ConflictPolicy)
+	*p = x
+	return p
+}
+
+func (x ConflictPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConflictPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_file_v1_file_proto_enumTypes[1].Descriptor()
+}
+
+func (ConflictPolicy) Type() protoreflect.EnumType {
+	return &file_file_v1_file_proto_enumTypes[1]
+}
+
+func (x ConflictPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConflictPolicy.Descriptor instead.
+func (ConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{1}
+}
+
+type SharePermission int32
+
+const (
+	SharePermission_SHARE_PERMISSION_UNSPECIFIED SharePermission = 0
+	SharePermission_SHARE_PERMISSION_VIEW        SharePermission = 1
+	SharePermission_SHARE_PERMISSION_DOWNLOAD    SharePermission = 2
+)
+
+// Enum value maps for SharePermission.
+var (
+	SharePermission_name = map[int32]string{
+		0: "SHARE_PERMISSION_UNSPECIFIED",
+		1: "SHARE_PERMISSION_VIEW",
+		2: "SHARE_PERMISSION_DOWNLOAD",
+	}
+	SharePermission_value = map[string]int32{
+		"SHARE_PERMISSION_UNSPECIFIED": 0,
+		"SHARE_PERMISSION_VIEW":        1,
+		"SHARE_PERMISSION_DOWNLOAD":    2,
+	}
+)
+
+func (x SharePermission) Enum() *SharePermission {
+	p := new(SharePermission)
+	*p = x
+	return p
+}
+
+func (x SharePermission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SharePermission) Descriptor() protoreflect.EnumDescriptor {
+	return file_file_v1_file_proto_enumTypes[2].Descriptor()
+}
+
+func (SharePermission) Type() protoreflect.EnumType {
+	return &file_file_v1_file_proto_enumTypes[2]
+}
+
+func (x SharePermission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SharePermission.Descriptor instead.
+func (SharePermission) EnumDescriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{2}
+}
+
 type File struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -29,6 +183,11 @@ type File struct {
 	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
 	ContentType   string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ParentId      string                 `protobuf:"bytes,7,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Sha256        string                 `protobuf:"bytes,10,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	PartsSha256   string                 `protobuf:"bytes,11,opt,name=parts_sha256,json=partsSha256,proto3" json:"parts_sha256,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -105,6 +264,41 @@ func (x *File) GetCreatedAt() int64 {
 	return 0
 }
 
+func (x *File) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *File) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *File) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *File) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *File) GetPartsSha256() string {
+	if x != nil {
+		return x.PartsSha256
+	}
+	return ""
+}
+
 type CreateFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
@@ -168,6 +362,8 @@ func (x *CreateFileRequest) GetContentType() string {
 type GetFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LinkId        string                 `protobuf:"bytes,2,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Admin         bool                   `protobuf:"varint,3,opt,name=admin,proto3" json:"admin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -209,8 +405,36 @@ func (x *GetFileRequest) GetId() string {
 	return ""
 }
 
+func (x *GetFileRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *GetFileRequest) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
 type ListFilesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      *string                `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	MinSize       *int64                 `protobuf:"varint,5,opt,name=min_size,json=minSize,proto3,oneof" json:"min_size,omitempty"`
+	MaxSize       *int64                 `protobuf:"varint,6,opt,name=max_size,json=maxSize,proto3,oneof" json:"max_size,omitempty"`
+	CreatedAfter  *int64                 `protobuf:"varint,7,opt,name=created_after,json=createdAfter,proto3,oneof" json:"created_after,omitempty"`
+	CreatedBefore *int64                 `protobuf:"varint,8,opt,name=created_before,json=createdBefore,proto3,oneof" json:"created_before,omitempty"`
+	PageSize      int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	SortBy        FileSortField          `protobuf:"varint,11,opt,name=sort_by,json=sortBy,proto3,enum=file.v1.FileSortField" json:"sort_by,omitempty"`
+	Descending    bool                   `protobuf:"varint,12,opt,name=descending,proto3" json:"descending,omitempty"`
+	Admin         bool                   `protobuf:"varint,13,opt,name=admin,proto3" json:"admin,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,14,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -245,9 +469,112 @@ func (*ListFilesRequest) Descriptor() ([]byte, []int) {
 	return file_file_v1_file_proto_rawDescGZIP(), []int{3}
 }
 
+func (x *ListFilesRequest) GetFolderId() string {
+	if x != nil && x.FolderId != nil {
+		return *x.FolderId
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListFilesRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetMinSize() int64 {
+	if x != nil && x.MinSize != nil {
+		return *x.MinSize
+	}
+	return 0
+}
+
+func (x *ListFilesRequest) GetMaxSize() int64 {
+	if x != nil && x.MaxSize != nil {
+		return *x.MaxSize
+	}
+	return 0
+}
+
+func (x *ListFilesRequest) GetCreatedAfter() int64 {
+	if x != nil && x.CreatedAfter != nil {
+		return *x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *ListFilesRequest) GetCreatedBefore() int64 {
+	if x != nil && x.CreatedBefore != nil {
+		return *x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *ListFilesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFilesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetSortBy() FileSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return FileSortField_FILE_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListFilesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListFilesRequest) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+func (x *ListFilesRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
 type ListFilesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Files         []*File                `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Folders       []*Folder              `protobuf:"bytes,2,rep,name=folders,proto3" json:"folders,omitempty"`
+	Breadcrumbs   []*Folder              `protobuf:"bytes,3,rep,name=breadcrumbs,proto3" json:"breadcrumbs,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	PrevPageToken string                 `protobuf:"bytes,5,opt,name=prev_page_token,json=prevPageToken,proto3" json:"prev_page_token,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,6,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -289,6 +616,41 @@ func (x *ListFilesResponse) GetFiles() []*File {
 	return nil
 }
 
+func (x *ListFilesResponse) GetFolders() []*Folder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+func (x *ListFilesResponse) GetBreadcrumbs() []*Folder {
+	if x != nil {
+		return x.Breadcrumbs
+	}
+	return nil
+}
+
+func (x *ListFilesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListFilesResponse) GetPrevPageToken() string {
+	if x != nil {
+		return x.PrevPageToken
+	}
+	return ""
+}
+
+func (x *ListFilesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type FileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	File          *File                  `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
@@ -337,6 +699,7 @@ type DeleteFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Admin         bool                   `protobuf:"varint,3,opt,name=admin,proto3" json:"admin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -385,27 +748,41 @@ func (x *DeleteFileRequest) GetUserId() string {
 	return ""
 }
 
-type DeleteFileResponse struct {
+func (x *DeleteFileRequest) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+type UpdateFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	ParentId      string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	OnConflict    ConflictPolicy         `protobuf:"varint,6,opt,name=on_conflict,json=onConflict,proto3,enum=file.v1.ConflictPolicy" json:"on_conflict,omitempty"`
+	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteFileResponse) Reset() {
-	*x = DeleteFileResponse{}
+func (x *UpdateFileRequest) Reset() {
+	*x = UpdateFileRequest{}
 	mi := &file_file_v1_file_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteFileResponse) String() string {
+func (x *UpdateFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFileResponse) ProtoMessage() {}
+func (*UpdateFileRequest) ProtoMessage() {}
 
-func (x *DeleteFileResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateFileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_file_v1_file_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -417,122 +794,139 @@ func (x *DeleteFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFileResponse.ProtoReflect.Descriptor instead.
-func (*DeleteFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateFileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFileRequest) Descriptor() ([]byte, []int) {
 	return file_file_v1_file_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DeleteFileResponse) GetSuccess() bool {
+func (x *UpdateFileRequest) GetId() string {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
-}
-
-type UploadFileRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Data:
-	//
-	//	*UploadFileRequest_Metadata
-	//	*UploadFileRequest_Chunk
-	Data          isUploadFileRequest_Data `protobuf_oneof:"data"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UploadFileRequest) Reset() {
-	*x = UploadFileRequest{}
-	mi := &file_file_v1_file_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *UploadFileRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateFileRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
 }
 
-func (*UploadFileRequest) ProtoMessage() {}
-
-func (x *UploadFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_file_v1_file_proto_msgTypes[8]
+func (x *UpdateFileRequest) GetContentType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ContentType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UploadFileRequest.ProtoReflect.Descriptor instead.
-func (*UploadFileRequest) Descriptor() ([]byte, []int) {
-	return file_file_v1_file_proto_rawDescGZIP(), []int{8}
+func (x *UpdateFileRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
 }
 
-func (x *UploadFileRequest) GetData() isUploadFileRequest_Data {
+func (x *UpdateFileRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Data
+		return x.UpdateMask
 	}
 	return nil
 }
 
-func (x *UploadFileRequest) GetMetadata() *UploadFileMetadata {
+func (x *UpdateFileRequest) GetOnConflict() ConflictPolicy {
 	if x != nil {
-		if x, ok := x.Data.(*UploadFileRequest_Metadata); ok {
-			return x.Metadata
-		}
+		return x.OnConflict
+	}
+	return ConflictPolicy_CONFLICT_POLICY_UNSPECIFIED
+}
+
+func (x *UpdateFileRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
 	}
 	return nil
 }
 
-func (x *UploadFileRequest) GetChunk() []byte {
+func (x *UpdateFileRequest) GetMetadata() map[string]string {
 	if x != nil {
-		if x, ok := x.Data.(*UploadFileRequest_Chunk); ok {
-			return x.Chunk
-		}
+		return x.Metadata
 	}
 	return nil
 }
 
-type isUploadFileRequest_Data interface {
-	isUploadFileRequest_Data()
+type FileTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type UploadFileRequest_Metadata struct {
-	Metadata *UploadFileMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+func (x *FileTagsRequest) Reset() {
+	*x = FileTagsRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type UploadFileRequest_Chunk struct {
-	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+func (x *FileTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadFileRequest_Metadata) isUploadFileRequest_Data() {}
+func (*FileTagsRequest) ProtoMessage() {}
 
-func (*UploadFileRequest_Chunk) isUploadFileRequest_Data() {}
+func (x *FileTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-type UploadFileMetadata struct {
+// Deprecated: Use FileTagsRequest.ProtoReflect.Descriptor instead.
+func (*FileTagsRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FileTagsRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *FileTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListTagsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadFileMetadata) Reset() {
-	*x = UploadFileMetadata{}
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
 	mi := &file_file_v1_file_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadFileMetadata) String() string {
+func (x *ListTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadFileMetadata) ProtoMessage() {}
+func (*ListTagsRequest) ProtoMessage() {}
 
-func (x *UploadFileMetadata) ProtoReflect() protoreflect.Message {
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_file_v1_file_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -544,47 +938,85 @@ func (x *UploadFileMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadFileMetadata.ProtoReflect.Descriptor instead.
-func (*UploadFileMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
 	return file_file_v1_file_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UploadFileMetadata) GetFilename() string {
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_file_v1_file_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[10]
 	if x != nil {
-		return x.Filename
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UploadFileMetadata) GetContentType() string {
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Tag) GetName() string {
 	if x != nil {
-		return x.ContentType
+		return x.Name
 	}
 	return ""
 }
 
-type DownloadFileRequest struct {
+func (x *Tag) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadFileRequest) Reset() {
-	*x = DownloadFileRequest{}
-	mi := &file_file_v1_file_proto_msgTypes[10]
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_file_v1_file_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadFileRequest) String() string {
+func (x *ListTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadFileRequest) ProtoMessage() {}
+func (*ListTagsResponse) ProtoMessage() {}
 
-func (x *DownloadFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_file_v1_file_proto_msgTypes[10]
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,44 +1027,88 @@ func (x *DownloadFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadFileRequest.ProtoReflect.Descriptor instead.
-func (*DownloadFileRequest) Descriptor() ([]byte, []int) {
-	return file_file_v1_file_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DownloadFileRequest) GetId() string {
+func (x *ListTagsResponse) GetTags() []*Tag {
 	if x != nil {
-		return x.Id
+		return x.Tags
 	}
-	return ""
+	return nil
 }
 
-type DownloadFileResponse struct {
+type DeleteFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFileResponse) Reset() {
+	*x = DeleteFileResponse{}
+	mi := &file_file_v1_file_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFileResponse) ProtoMessage() {}
+
+func (x *DeleteFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFileResponse) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteFileResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UploadFileRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Data:
 	//
-	//	*DownloadFileResponse_Metadata
-	//	*DownloadFileResponse_Chunk
-	Data          isDownloadFileResponse_Data `protobuf_oneof:"data"`
+	//	*UploadFileRequest_Metadata
+	//	*UploadFileRequest_Chunk
+	Data          isUploadFileRequest_Data `protobuf_oneof:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadFileResponse) Reset() {
-	*x = DownloadFileResponse{}
-	mi := &file_file_v1_file_proto_msgTypes[11]
+func (x *UploadFileRequest) Reset() {
+	*x = UploadFileRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadFileResponse) String() string {
+func (x *UploadFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadFileResponse) ProtoMessage() {}
+func (*UploadFileRequest) ProtoMessage() {}
 
-func (x *DownloadFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_file_v1_file_proto_msgTypes[11]
+func (x *UploadFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -643,76 +1119,78 @@ func (x *DownloadFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadFileResponse.ProtoReflect.Descriptor instead.
-func (*DownloadFileResponse) Descriptor() ([]byte, []int) {
-	return file_file_v1_file_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use UploadFileRequest.ProtoReflect.Descriptor instead.
+func (*UploadFileRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DownloadFileResponse) GetData() isDownloadFileResponse_Data {
+func (x *UploadFileRequest) GetData() isUploadFileRequest_Data {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *DownloadFileResponse) GetMetadata() *DownloadFileMetadata {
+func (x *UploadFileRequest) GetMetadata() *UploadFileMetadata {
 	if x != nil {
-		if x, ok := x.Data.(*DownloadFileResponse_Metadata); ok {
+		if x, ok := x.Data.(*UploadFileRequest_Metadata); ok {
 			return x.Metadata
 		}
 	}
 	return nil
 }
 
-func (x *DownloadFileResponse) GetChunk() []byte {
+func (x *UploadFileRequest) GetChunk() []byte {
 	if x != nil {
-		if x, ok := x.Data.(*DownloadFileResponse_Chunk); ok {
+		if x, ok := x.Data.(*UploadFileRequest_Chunk); ok {
 			return x.Chunk
 		}
 	}
 	return nil
 }
 
-type isDownloadFileResponse_Data interface {
-	isDownloadFileResponse_Data()
+type isUploadFileRequest_Data interface {
+	isUploadFileRequest_Data()
 }
 
-type DownloadFileResponse_Metadata struct {
-	Metadata *DownloadFileMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+type UploadFileRequest_Metadata struct {
+	Metadata *UploadFileMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
 }
 
-type DownloadFileResponse_Chunk struct {
+type UploadFileRequest_Chunk struct {
 	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
 }
 
-func (*DownloadFileResponse_Metadata) isDownloadFileResponse_Data() {}
+func (*UploadFileRequest_Metadata) isUploadFileRequest_Data() {}
 
-func (*DownloadFileResponse_Chunk) isDownloadFileResponse_Data() {}
+func (*UploadFileRequest_Chunk) isUploadFileRequest_Data() {}
 
-type DownloadFileMetadata struct {
+type UploadFileMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
 	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
-	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	ParentId      string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Quota         *StorageQuota          `protobuf:"bytes,4,opt,name=quota,proto3" json:"quota,omitempty"`
+	Sha256        string                 `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadFileMetadata) Reset() {
-	*x = DownloadFileMetadata{}
-	mi := &file_file_v1_file_proto_msgTypes[12]
+func (x *UploadFileMetadata) Reset() {
+	*x = UploadFileMetadata{}
+	mi := &file_file_v1_file_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadFileMetadata) String() string {
+func (x *UploadFileMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadFileMetadata) ProtoMessage() {}
+func (*UploadFileMetadata) ProtoMessage() {}
 
-func (x *DownloadFileMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_file_v1_file_proto_msgTypes[12]
+func (x *UploadFileMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,56 +1201,2281 @@ func (x *DownloadFileMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadFileMetadata.ProtoReflect.Descriptor instead.
-func (*DownloadFileMetadata) Descriptor() ([]byte, []int) {
-	return file_file_v1_file_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use UploadFileMetadata.ProtoReflect.Descriptor instead.
+func (*UploadFileMetadata) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *DownloadFileMetadata) GetFilename() string {
+func (x *UploadFileMetadata) GetFilename() string {
 	if x != nil {
 		return x.Filename
 	}
 	return ""
 }
 
-func (x *DownloadFileMetadata) GetContentType() string {
+func (x *UploadFileMetadata) GetContentType() string {
 	if x != nil {
 		return x.ContentType
 	}
 	return ""
 }
 
-func (x *DownloadFileMetadata) GetSize() int64 {
+func (x *UploadFileMetadata) GetParentId() string {
 	if x != nil {
-		return x.Size
+		return x.ParentId
 	}
-	return 0
+	return ""
 }
 
-type InitiateMultipartUploadRequest struct {
+func (x *UploadFileMetadata) GetQuota() *StorageQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *UploadFileMetadata) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type StorageQuota struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
-	TotalSize     int64                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	MaxFiles      int64                  `protobuf:"varint,1,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`
+	MaxBytes      int64                  `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InitiateMultipartUploadRequest) Reset() {
-	*x = InitiateMultipartUploadRequest{}
-	mi := &file_file_v1_file_proto_msgTypes[13]
+func (x *StorageQuota) Reset() {
+	*x = StorageQuota{}
+	mi := &file_file_v1_file_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageQuota) ProtoMessage() {}
+
+func (x *StorageQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageQuota.ProtoReflect.Descriptor instead.
+func (*StorageQuota) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StorageQuota) GetMaxFiles() int64 {
+	if x != nil {
+		return x.MaxFiles
+	}
+	return 0
+}
+
+func (x *StorageQuota) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+type GetUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{16}
+}
+
+type GetUsageResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FileCount      int64                  `protobuf:"varint,1,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+	TotalBytes     int64                  `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	PendingUploads int64                  `protobuf:"varint,3,opt,name=pending_uploads,json=pendingUploads,proto3" json:"pending_uploads,omitempty"`
+	PendingBytes   int64                  `protobuf:"varint,4,opt,name=pending_bytes,json=pendingBytes,proto3" json:"pending_bytes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_file_v1_file_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetUsageResponse) GetFileCount() int64 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+func (x *GetUsageResponse) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *GetUsageResponse) GetPendingUploads() int64 {
+	if x != nil {
+		return x.PendingUploads
+	}
+	return 0
+}
+
+func (x *GetUsageResponse) GetPendingBytes() int64 {
+	if x != nil {
+		return x.PendingBytes
+	}
+	return 0
+}
+
+type DownloadFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length        int64                  `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	LinkId        string                 `protobuf:"bytes,4,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Admin         bool                   `protobuf:"varint,5,opt,name=admin,proto3" json:"admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadFileRequest) Reset() {
+	*x = DownloadFileRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFileRequest) ProtoMessage() {}
+
+func (x *DownloadFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFileRequest.ProtoReflect.Descriptor instead.
+func (*DownloadFileRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DownloadFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DownloadFileRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DownloadFileRequest) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *DownloadFileRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *DownloadFileRequest) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+type DownloadFileResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*DownloadFileResponse_Metadata
+	//	*DownloadFileResponse_Chunk
+	Data          isDownloadFileResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadFileResponse) Reset() {
+	*x = DownloadFileResponse{}
+	mi := &file_file_v1_file_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFileResponse) ProtoMessage() {}
+
+func (x *DownloadFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFileResponse.ProtoReflect.Descriptor instead.
+func (*DownloadFileResponse) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DownloadFileResponse) GetData() isDownloadFileResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DownloadFileResponse) GetMetadata() *DownloadFileMetadata {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadFileResponse_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *DownloadFileResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadFileResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadFileResponse_Data interface {
+	isDownloadFileResponse_Data()
+}
+
+type DownloadFileResponse_Metadata struct {
+	Metadata *DownloadFileMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type DownloadFileResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadFileResponse_Metadata) isDownloadFileResponse_Data() {}
+
+func (*DownloadFileResponse_Chunk) isDownloadFileResponse_Data() {}
+
+type DownloadFileMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadFileMetadata) Reset() {
+	*x = DownloadFileMetadata{}
+	mi := &file_file_v1_file_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadFileMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFileMetadata) ProtoMessage() {}
+
+func (x *DownloadFileMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFileMetadata.ProtoReflect.Descriptor instead.
+func (*DownloadFileMetadata) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DownloadFileMetadata) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *DownloadFileMetadata) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DownloadFileMetadata) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type InitiateMultipartUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	ParentId      string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Quota         *StorageQuota          `protobuf:"bytes,5,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitiateMultipartUploadRequest) Reset() {
+	*x = InitiateMultipartUploadRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateMultipartUploadRequest) ProtoMessage() {}
+
+func (x *InitiateMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*InitiateMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *InitiateMultipartUploadRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *InitiateMultipartUploadRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *InitiateMultipartUploadRequest) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *InitiateMultipartUploadRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *InitiateMultipartUploadRequest) GetQuota() *StorageQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type InitiateMultipartUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	ChunkSize     int32                  `protobuf:"varint,2,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	TotalParts    int32                  `protobuf:"varint,3,opt,name=total_parts,json=totalParts,proto3" json:"total_parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitiateMultipartUploadResponse) Reset() {
+	*x = InitiateMultipartUploadResponse{}
+	mi := &file_file_v1_file_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateMultipartUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateMultipartUploadResponse) ProtoMessage() {}
+
+func (x *InitiateMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*InitiateMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InitiateMultipartUploadResponse) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *InitiateMultipartUploadResponse) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *InitiateMultipartUploadResponse) GetTotalParts() int32 {
+	if x != nil {
+		return x.TotalParts
+	}
+	return 0
+}
+
+type UploadPartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	PartNumber    int32                  `protobuf:"varint,2,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	Chunk         []byte                 `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Sha256        string                 `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadPartRequest) Reset() {
+	*x = UploadPartRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadPartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPartRequest) ProtoMessage() {}
+
+func (x *UploadPartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPartRequest.ProtoReflect.Descriptor instead.
+func (*UploadPartRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UploadPartRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadPartRequest) GetPartNumber() int32 {
+	if x != nil {
+		return x.PartNumber
+	}
+	return 0
+}
+
+func (x *UploadPartRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *UploadPartRequest) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type UploadPartStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadPartStreamRequest_Metadata
+	//	*UploadPartStreamRequest_Chunk
+	Data          isUploadPartStreamRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadPartStreamRequest) Reset() {
+	*x = UploadPartStreamRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadPartStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPartStreamRequest) ProtoMessage() {}
+
+func (x *UploadPartStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPartStreamRequest.ProtoReflect.Descriptor instead.
+func (*UploadPartStreamRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UploadPartStreamRequest) GetData() isUploadPartStreamRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadPartStreamRequest) GetMetadata() *UploadPartMetadata {
+	if x != nil {
+		if x, ok := x.Data.(*UploadPartStreamRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadPartStreamRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadPartStreamRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadPartStreamRequest_Data interface {
+	isUploadPartStreamRequest_Data()
+}
+
+type UploadPartStreamRequest_Metadata struct {
+	Metadata *UploadPartMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadPartStreamRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadPartStreamRequest_Metadata) isUploadPartStreamRequest_Data() {}
+
+func (*UploadPartStreamRequest_Chunk) isUploadPartStreamRequest_Data() {}
+
+type UploadPartMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	PartNumber    int32                  `protobuf:"varint,2,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	Sha256        string                 `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadPartMetadata) Reset() {
+	*x = UploadPartMetadata{}
+	mi := &file_file_v1_file_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadPartMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPartMetadata) ProtoMessage() {}
+
+func (x *UploadPartMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPartMetadata.ProtoReflect.Descriptor instead.
+func (*UploadPartMetadata) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UploadPartMetadata) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadPartMetadata) GetPartNumber() int32 {
+	if x != nil {
+		return x.PartNumber
+	}
+	return 0
+}
+
+func (x *UploadPartMetadata) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type UploadPartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Etag          string                 `protobuf:"bytes,1,opt,name=etag,proto3" json:"etag,omitempty"`
+	PartNumber    int32                  `protobuf:"varint,2,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	Sha256        string                 `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadPartResponse) Reset() {
+	*x = UploadPartResponse{}
+	mi := &file_file_v1_file_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadPartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPartResponse) ProtoMessage() {}
+
+func (x *UploadPartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPartResponse.ProtoReflect.Descriptor instead.
+func (*UploadPartResponse) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UploadPartResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *UploadPartResponse) GetPartNumber() int32 {
+	if x != nil {
+		return x.PartNumber
+	}
+	return 0
+}
+
+func (x *UploadPartResponse) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type CompleteMultipartUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Parts         []*PartInfo            `protobuf:"bytes,2,rep,name=parts,proto3" json:"parts,omitempty"`
+	PartsSha256   string                 `protobuf:"bytes,3,opt,name=parts_sha256,json=partsSha256,proto3" json:"parts_sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteMultipartUploadRequest) Reset() {
+	*x = CompleteMultipartUploadRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteMultipartUploadRequest) ProtoMessage() {}
+
+func (x *CompleteMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*CompleteMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CompleteMultipartUploadRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *CompleteMultipartUploadRequest) GetParts() []*PartInfo {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *CompleteMultipartUploadRequest) GetPartsSha256() string {
+	if x != nil {
+		return x.PartsSha256
+	}
+	return ""
+}
+
+type PartInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartNumber    int32                  `protobuf:"varint,1,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartInfo) Reset() {
+	*x = PartInfo{}
+	mi := &file_file_v1_file_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartInfo) ProtoMessage() {}
+
+func (x *PartInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartInfo.ProtoReflect.Descriptor instead.
+func (*PartInfo) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PartInfo) GetPartNumber() int32 {
+	if x != nil {
+		return x.PartNumber
+	}
+	return 0
+}
+
+func (x *PartInfo) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type AbortMultipartUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortMultipartUploadRequest) Reset() {
+	*x = AbortMultipartUploadRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortMultipartUploadRequest) ProtoMessage() {}
+
+func (x *AbortMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*AbortMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AbortMultipartUploadRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+type AbortMultipartUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortMultipartUploadResponse) Reset() {
+	*x = AbortMultipartUploadResponse{}
+	mi := &file_file_v1_file_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortMultipartUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortMultipartUploadResponse) ProtoMessage() {}
+
+func (x *AbortMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*AbortMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AbortMultipartUploadResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type MultipartUpload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	ParentId      string                 `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	ChunkSize     int32                  `protobuf:"varint,6,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	TotalParts    int32                  `protobuf:"varint,7,opt,name=total_parts,json=totalParts,proto3" json:"total_parts,omitempty"`
+	PartsUploaded int32                  `protobuf:"varint,8,opt,name=parts_uploaded,json=partsUploaded,proto3" json:"parts_uploaded,omitempty"`
+	UploadedBytes int64                  `protobuf:"varint,9,opt,name=uploaded_bytes,json=uploadedBytes,proto3" json:"uploaded_bytes,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipartUpload) Reset() {
+	*x = MultipartUpload{}
+	mi := &file_file_v1_file_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipartUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipartUpload) ProtoMessage() {}
+
+func (x *MultipartUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipartUpload.ProtoReflect.Descriptor instead.
+func (*MultipartUpload) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MultipartUpload) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *MultipartUpload) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *MultipartUpload) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *MultipartUpload) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *MultipartUpload) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *MultipartUpload) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *MultipartUpload) GetTotalParts() int32 {
+	if x != nil {
+		return x.TotalParts
+	}
+	return 0
+}
+
+func (x *MultipartUpload) GetPartsUploaded() int32 {
+	if x != nil {
+		return x.PartsUploaded
+	}
+	return 0
+}
+
+func (x *MultipartUpload) GetUploadedBytes() int64 {
+	if x != nil {
+		return x.UploadedBytes
+	}
+	return 0
+}
+
+func (x *MultipartUpload) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *MultipartUpload) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type UploadedPart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartNumber    int32                  `protobuf:"varint,1,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256        string                 `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadedPart) Reset() {
+	*x = UploadedPart{}
+	mi := &file_file_v1_file_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadedPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadedPart) ProtoMessage() {}
+
+func (x *UploadedPart) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadedPart.ProtoReflect.Descriptor instead.
+func (*UploadedPart) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UploadedPart) GetPartNumber() int32 {
+	if x != nil {
+		return x.PartNumber
+	}
+	return 0
+}
+
+func (x *UploadedPart) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *UploadedPart) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadedPart) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type ListMultipartUploadsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMultipartUploadsRequest) Reset() {
+	*x = ListMultipartUploadsRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMultipartUploadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMultipartUploadsRequest) ProtoMessage() {}
+
+func (x *ListMultipartUploadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMultipartUploadsRequest.ProtoReflect.Descriptor instead.
+func (*ListMultipartUploadsRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{33}
+}
+
+type ListMultipartUploadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uploads       []*MultipartUpload     `protobuf:"bytes,1,rep,name=uploads,proto3" json:"uploads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMultipartUploadsResponse) Reset() {
+	*x = ListMultipartUploadsResponse{}
+	mi := &file_file_v1_file_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMultipartUploadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMultipartUploadsResponse) ProtoMessage() {}
+
+func (x *ListMultipartUploadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMultipartUploadsResponse.ProtoReflect.Descriptor instead.
+func (*ListMultipartUploadsResponse) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListMultipartUploadsResponse) GetUploads() []*MultipartUpload {
+	if x != nil {
+		return x.Uploads
+	}
+	return nil
+}
+
+type ListPartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartsRequest) Reset() {
+	*x = ListPartsRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsRequest) ProtoMessage() {}
+
+func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
+func (*ListPartsRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListPartsRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+type ListPartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Upload        *MultipartUpload       `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload,omitempty"`
+	Parts         []*UploadedPart        `protobuf:"bytes,2,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartsResponse) Reset() {
+	*x = ListPartsResponse{}
+	mi := &file_file_v1_file_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsResponse) ProtoMessage() {}
+
+func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
+func (*ListPartsResponse) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListPartsResponse) GetUpload() *MultipartUpload {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+func (x *ListPartsResponse) GetParts() []*UploadedPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+type DownloadLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxDownloads  int32                  `protobuf:"varint,4,opt,name=max_downloads,json=maxDownloads,proto3" json:"max_downloads,omitempty"`
+	DownloadCount int32                  `protobuf:"varint,5,opt,name=download_count,json=downloadCount,proto3" json:"download_count,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadLink) Reset() {
+	*x = DownloadLink{}
+	mi := &file_file_v1_file_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadLink) ProtoMessage() {}
+
+func (x *DownloadLink) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadLink.ProtoReflect.Descriptor instead.
+func (*DownloadLink) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DownloadLink) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DownloadLink) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *DownloadLink) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *DownloadLink) GetMaxDownloads() int32 {
+	if x != nil {
+		return x.MaxDownloads
+	}
+	return 0
+}
+
+func (x *DownloadLink) GetDownloadCount() int32 {
+	if x != nil {
+		return x.DownloadCount
+	}
+	return 0
+}
+
+func (x *DownloadLink) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateDownloadLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxDownloads  int32                  `protobuf:"varint,3,opt,name=max_downloads,json=maxDownloads,proto3" json:"max_downloads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDownloadLinkRequest) Reset() {
+	*x = CreateDownloadLinkRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDownloadLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDownloadLinkRequest) ProtoMessage() {}
+
+func (x *CreateDownloadLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDownloadLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateDownloadLinkRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateDownloadLinkRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *CreateDownloadLinkRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *CreateDownloadLinkRequest) GetMaxDownloads() int32 {
+	if x != nil {
+		return x.MaxDownloads
+	}
+	return 0
+}
+
+type ListDownloadLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDownloadLinksRequest) Reset() {
+	*x = ListDownloadLinksRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDownloadLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDownloadLinksRequest) ProtoMessage() {}
+
+func (x *ListDownloadLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDownloadLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListDownloadLinksRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListDownloadLinksRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+type ListDownloadLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*DownloadLink        `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDownloadLinksResponse) Reset() {
+	*x = ListDownloadLinksResponse{}
+	mi := &file_file_v1_file_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDownloadLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDownloadLinksResponse) ProtoMessage() {}
+
+func (x *ListDownloadLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDownloadLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListDownloadLinksResponse) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListDownloadLinksResponse) GetLinks() []*DownloadLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type RevokeDownloadLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeDownloadLinkRequest) Reset() {
+	*x = RevokeDownloadLinkRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeDownloadLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeDownloadLinkRequest) ProtoMessage() {}
+
+func (x *RevokeDownloadLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeDownloadLinkRequest.ProtoReflect.Descriptor instead.
+func (*RevokeDownloadLinkRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RevokeDownloadLinkRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *RevokeDownloadLinkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeDownloadLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeDownloadLinkResponse) Reset() {
+	*x = RevokeDownloadLinkResponse{}
+	mi := &file_file_v1_file_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeDownloadLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeDownloadLinkResponse) ProtoMessage() {}
+
+func (x *RevokeDownloadLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeDownloadLinkResponse.ProtoReflect.Descriptor instead.
+func (*RevokeDownloadLinkResponse) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RevokeDownloadLinkResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type Share struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Permission    SharePermission        `protobuf:"varint,5,opt,name=permission,proto3,enum=file.v1.SharePermission" json:"permission,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Share) Reset() {
+	*x = Share{}
+	mi := &file_file_v1_file_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Share) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Share) ProtoMessage() {}
+
+func (x *Share) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Share.ProtoReflect.Descriptor instead.
+func (*Share) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Share) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Share) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *Share) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Share) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Share) GetPermission() SharePermission {
+	if x != nil {
+		return x.Permission
+	}
+	return SharePermission_SHARE_PERMISSION_UNSPECIFIED
+}
+
+func (x *Share) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ShareFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Permission    SharePermission        `protobuf:"varint,4,opt,name=permission,proto3,enum=file.v1.SharePermission" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareFileRequest) Reset() {
+	*x = ShareFileRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareFileRequest) ProtoMessage() {}
+
+func (x *ShareFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareFileRequest.ProtoReflect.Descriptor instead.
+func (*ShareFileRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ShareFileRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *ShareFileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ShareFileRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ShareFileRequest) GetPermission() SharePermission {
+	if x != nil {
+		return x.Permission
+	}
+	return SharePermission_SHARE_PERMISSION_UNSPECIFIED
+}
+
+type ListSharesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharesRequest) Reset() {
+	*x = ListSharesRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharesRequest) ProtoMessage() {}
+
+func (x *ListSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListSharesRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListSharesRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+type ListSharesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shares        []*Share               `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharesResponse) Reset() {
+	*x = ListSharesResponse{}
+	mi := &file_file_v1_file_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharesResponse) ProtoMessage() {}
+
+func (x *ListSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListSharesResponse) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListSharesResponse) GetShares() []*Share {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type RevokeShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeShareRequest) Reset() {
+	*x = RevokeShareRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareRequest) ProtoMessage() {}
+
+func (x *RevokeShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareRequest.ProtoReflect.Descriptor instead.
+func (*RevokeShareRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RevokeShareRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *RevokeShareRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeShareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeShareResponse) Reset() {
+	*x = RevokeShareResponse{}
+	mi := &file_file_v1_file_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareResponse) ProtoMessage() {}
+
+func (x *RevokeShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareResponse.ProtoReflect.Descriptor instead.
+func (*RevokeShareResponse) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RevokeShareResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListSharedWithMeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedWithMeRequest) Reset() {
+	*x = ListSharedWithMeRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedWithMeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedWithMeRequest) ProtoMessage() {}
+
+func (x *ListSharedWithMeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedWithMeRequest.ProtoReflect.Descriptor instead.
+func (*ListSharedWithMeRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{49}
+}
+
+type SharedFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          *File                  `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Permission    SharePermission        `protobuf:"varint,2,opt,name=permission,proto3,enum=file.v1.SharePermission" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedFile) Reset() {
+	*x = SharedFile{}
+	mi := &file_file_v1_file_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedFile) ProtoMessage() {}
+
+func (x *SharedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedFile.ProtoReflect.Descriptor instead.
+func (*SharedFile) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SharedFile) GetFile() *File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *SharedFile) GetPermission() SharePermission {
+	if x != nil {
+		return x.Permission
+	}
+	return SharePermission_SHARE_PERMISSION_UNSPECIFIED
+}
+
+type ListSharedWithMeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*SharedFile          `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedWithMeResponse) Reset() {
+	*x = ListSharedWithMeResponse{}
+	mi := &file_file_v1_file_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedWithMeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedWithMeResponse) ProtoMessage() {}
+
+func (x *ListSharedWithMeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedWithMeResponse.ProtoReflect.Descriptor instead.
+func (*ListSharedWithMeResponse) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListSharedWithMeResponse) GetFiles() []*SharedFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type Folder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Folder) Reset() {
+	*x = Folder{}
+	mi := &file_file_v1_file_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Folder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Folder) ProtoMessage() {}
+
+func (x *Folder) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Folder.ProtoReflect.Descriptor instead.
+func (*Folder) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Folder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Folder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Folder) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Folder) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFolderRequest) Reset() {
+	*x = CreateFolderRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InitiateMultipartUploadRequest) String() string {
+func (x *CreateFolderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitiateMultipartUploadRequest) ProtoMessage() {}
+func (*CreateFolderRequest) ProtoMessage() {}
 
-func (x *InitiateMultipartUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_file_v1_file_proto_msgTypes[13]
+func (x *CreateFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,56 +3486,48 @@ func (x *InitiateMultipartUploadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitiateMultipartUploadRequest.ProtoReflect.Descriptor instead.
-func (*InitiateMultipartUploadRequest) Descriptor() ([]byte, []int) {
-	return file_file_v1_file_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CreateFolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateFolderRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *InitiateMultipartUploadRequest) GetFilename() string {
+func (x *CreateFolderRequest) GetName() string {
 	if x != nil {
-		return x.Filename
+		return x.Name
 	}
 	return ""
 }
 
-func (x *InitiateMultipartUploadRequest) GetContentType() string {
+func (x *CreateFolderRequest) GetParentId() string {
 	if x != nil {
-		return x.ContentType
+		return x.ParentId
 	}
 	return ""
 }
 
-func (x *InitiateMultipartUploadRequest) GetTotalSize() int64 {
-	if x != nil {
-		return x.TotalSize
-	}
-	return 0
-}
-
-type InitiateMultipartUploadResponse struct {
+type RenameFolderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
-	ChunkSize     int32                  `protobuf:"varint,2,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
-	TotalParts    int32                  `protobuf:"varint,3,opt,name=total_parts,json=totalParts,proto3" json:"total_parts,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InitiateMultipartUploadResponse) Reset() {
-	*x = InitiateMultipartUploadResponse{}
-	mi := &file_file_v1_file_proto_msgTypes[14]
+func (x *RenameFolderRequest) Reset() {
+	*x = RenameFolderRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InitiateMultipartUploadResponse) String() string {
+func (x *RenameFolderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitiateMultipartUploadResponse) ProtoMessage() {}
+func (*RenameFolderRequest) ProtoMessage() {}
 
-func (x *InitiateMultipartUploadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_file_v1_file_proto_msgTypes[14]
+func (x *RenameFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,56 +3538,48 @@ func (x *InitiateMultipartUploadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitiateMultipartUploadResponse.ProtoReflect.Descriptor instead.
-func (*InitiateMultipartUploadResponse) Descriptor() ([]byte, []int) {
-	return file_file_v1_file_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RenameFolderRequest.ProtoReflect.Descriptor instead.
+func (*RenameFolderRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *InitiateMultipartUploadResponse) GetUploadId() string {
+func (x *RenameFolderRequest) GetId() string {
 	if x != nil {
-		return x.UploadId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *InitiateMultipartUploadResponse) GetChunkSize() int32 {
-	if x != nil {
-		return x.ChunkSize
-	}
-	return 0
-}
-
-func (x *InitiateMultipartUploadResponse) GetTotalParts() int32 {
+func (x *RenameFolderRequest) GetName() string {
 	if x != nil {
-		return x.TotalParts
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type UploadPartRequest struct {
+type MoveFolderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
-	PartNumber    int32                  `protobuf:"varint,2,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
-	Chunk         []byte                 `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadPartRequest) Reset() {
-	*x = UploadPartRequest{}
-	mi := &file_file_v1_file_proto_msgTypes[15]
+func (x *MoveFolderRequest) Reset() {
+	*x = MoveFolderRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadPartRequest) String() string {
+func (x *MoveFolderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadPartRequest) ProtoMessage() {}
+func (*MoveFolderRequest) ProtoMessage() {}
 
-func (x *UploadPartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_file_v1_file_proto_msgTypes[15]
+func (x *MoveFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,55 +3590,47 @@ func (x *UploadPartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadPartRequest.ProtoReflect.Descriptor instead.
-func (*UploadPartRequest) Descriptor() ([]byte, []int) {
-	return file_file_v1_file_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use MoveFolderRequest.ProtoReflect.Descriptor instead.
+func (*MoveFolderRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *UploadPartRequest) GetUploadId() string {
+func (x *MoveFolderRequest) GetId() string {
 	if x != nil {
-		return x.UploadId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UploadPartRequest) GetPartNumber() int32 {
-	if x != nil {
-		return x.PartNumber
-	}
-	return 0
-}
-
-func (x *UploadPartRequest) GetChunk() []byte {
+func (x *MoveFolderRequest) GetParentId() string {
 	if x != nil {
-		return x.Chunk
+		return x.ParentId
 	}
-	return nil
+	return ""
 }
 
-type UploadPartResponse struct {
+type DeleteFolderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Etag          string                 `protobuf:"bytes,1,opt,name=etag,proto3" json:"etag,omitempty"`
-	PartNumber    int32                  `protobuf:"varint,2,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadPartResponse) Reset() {
-	*x = UploadPartResponse{}
-	mi := &file_file_v1_file_proto_msgTypes[16]
+func (x *DeleteFolderRequest) Reset() {
+	*x = DeleteFolderRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadPartResponse) String() string {
+func (x *DeleteFolderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadPartResponse) ProtoMessage() {}
+func (*DeleteFolderRequest) ProtoMessage() {}
 
-func (x *UploadPartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_file_v1_file_proto_msgTypes[16]
+func (x *DeleteFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -962,48 +3641,42 @@ func (x *UploadPartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadPartResponse.ProtoReflect.Descriptor instead.
-func (*UploadPartResponse) Descriptor() ([]byte, []int) {
-	return file_file_v1_file_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use DeleteFolderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFolderRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *UploadPartResponse) GetEtag() string {
+func (x *DeleteFolderRequest) GetId() string {
 	if x != nil {
-		return x.Etag
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UploadPartResponse) GetPartNumber() int32 {
-	if x != nil {
-		return x.PartNumber
-	}
-	return 0
-}
-
-type CompleteMultipartUploadRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
-	Parts         []*PartInfo            `protobuf:"bytes,2,rep,name=parts,proto3" json:"parts,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DeleteFolderResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	FoldersDeleted int32                  `protobuf:"varint,2,opt,name=folders_deleted,json=foldersDeleted,proto3" json:"folders_deleted,omitempty"`
+	FilesDeleted   int32                  `protobuf:"varint,3,opt,name=files_deleted,json=filesDeleted,proto3" json:"files_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CompleteMultipartUploadRequest) Reset() {
-	*x = CompleteMultipartUploadRequest{}
-	mi := &file_file_v1_file_proto_msgTypes[17]
+func (x *DeleteFolderResponse) Reset() {
+	*x = DeleteFolderResponse{}
+	mi := &file_file_v1_file_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteMultipartUploadRequest) String() string {
+func (x *DeleteFolderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteMultipartUploadRequest) ProtoMessage() {}
+func (*DeleteFolderResponse) ProtoMessage() {}
 
-func (x *CompleteMultipartUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_file_v1_file_proto_msgTypes[17]
+func (x *DeleteFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1014,48 +3687,61 @@ func (x *CompleteMultipartUploadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteMultipartUploadRequest.ProtoReflect.Descriptor instead.
-func (*CompleteMultipartUploadRequest) Descriptor() ([]byte, []int) {
-	return file_file_v1_file_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DeleteFolderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFolderResponse) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CompleteMultipartUploadRequest) GetUploadId() string {
+func (x *DeleteFolderResponse) GetSuccess() bool {
 	if x != nil {
-		return x.UploadId
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *CompleteMultipartUploadRequest) GetParts() []*PartInfo {
+func (x *DeleteFolderResponse) GetFoldersDeleted() int32 {
 	if x != nil {
-		return x.Parts
+		return x.FoldersDeleted
 	}
-	return nil
+	return 0
 }
 
-type PartInfo struct {
+func (x *DeleteFolderResponse) GetFilesDeleted() int32 {
+	if x != nil {
+		return x.FilesDeleted
+	}
+	return 0
+}
+
+type AdminAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PartNumber    int32                  `protobuf:"varint,1,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
-	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	AdminUsername string                 `protobuf:"bytes,3,opt,name=admin_username,json=adminUsername,proto3" json:"admin_username,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	FileId        string                 `protobuf:"bytes,5,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,6,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,7,opt,name=filename,proto3" json:"filename,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PartInfo) Reset() {
-	*x = PartInfo{}
-	mi := &file_file_v1_file_proto_msgTypes[18]
+func (x *AdminAction) Reset() {
+	*x = AdminAction{}
+	mi := &file_file_v1_file_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PartInfo) String() string {
+func (x *AdminAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PartInfo) ProtoMessage() {}
+func (*AdminAction) ProtoMessage() {}
 
-func (x *PartInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_file_v1_file_proto_msgTypes[18]
+func (x *AdminAction) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1066,47 +3752,91 @@ func (x *PartInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PartInfo.ProtoReflect.Descriptor instead.
-func (*PartInfo) Descriptor() ([]byte, []int) {
-	return file_file_v1_file_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use AdminAction.ProtoReflect.Descriptor instead.
+func (*AdminAction) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *PartInfo) GetPartNumber() int32 {
+func (x *AdminAction) GetId() string {
 	if x != nil {
-		return x.PartNumber
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *PartInfo) GetEtag() string {
+func (x *AdminAction) GetAdminId() string {
 	if x != nil {
-		return x.Etag
+		return x.AdminId
 	}
 	return ""
 }
 
-type AbortMultipartUploadRequest struct {
+func (x *AdminAction) GetAdminUsername() string {
+	if x != nil {
+		return x.AdminUsername
+	}
+	return ""
+}
+
+func (x *AdminAction) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AdminAction) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *AdminAction) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *AdminAction) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *AdminAction) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListAdminActionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbortMultipartUploadRequest) Reset() {
-	*x = AbortMultipartUploadRequest{}
-	mi := &file_file_v1_file_proto_msgTypes[19]
+func (x *ListAdminActionsRequest) Reset() {
+	*x = ListAdminActionsRequest{}
+	mi := &file_file_v1_file_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbortMultipartUploadRequest) String() string {
+func (x *ListAdminActionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbortMultipartUploadRequest) ProtoMessage() {}
+func (*ListAdminActionsRequest) ProtoMessage() {}
 
-func (x *AbortMultipartUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_file_v1_file_proto_msgTypes[19]
+func (x *ListAdminActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1117,40 +3847,54 @@ func (x *AbortMultipartUploadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbortMultipartUploadRequest.ProtoReflect.Descriptor instead.
-func (*AbortMultipartUploadRequest) Descriptor() ([]byte, []int) {
-	return file_file_v1_file_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListAdminActionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAdminActionsRequest) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *AbortMultipartUploadRequest) GetUploadId() string {
+func (x *ListAdminActionsRequest) GetFileId() string {
 	if x != nil {
-		return x.UploadId
+		return x.FileId
 	}
 	return ""
 }
 
-type AbortMultipartUploadResponse struct {
+func (x *ListAdminActionsRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *ListAdminActionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAdminActionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Actions       []*AdminAction         `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbortMultipartUploadResponse) Reset() {
-	*x = AbortMultipartUploadResponse{}
-	mi := &file_file_v1_file_proto_msgTypes[20]
+func (x *ListAdminActionsResponse) Reset() {
+	*x = ListAdminActionsResponse{}
+	mi := &file_file_v1_file_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbortMultipartUploadResponse) String() string {
+func (x *ListAdminActionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbortMultipartUploadResponse) ProtoMessage() {}
+func (*ListAdminActionsResponse) ProtoMessage() {}
 
-func (x *AbortMultipartUploadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_file_v1_file_proto_msgTypes[20]
+func (x *ListAdminActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_v1_file_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1161,23 +3905,23 @@ func (x *AbortMultipartUploadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbortMultipartUploadResponse.ProtoReflect.Descriptor instead.
-func (*AbortMultipartUploadResponse) Descriptor() ([]byte, []int) {
-	return file_file_v1_file_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListAdminActionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAdminActionsResponse) Descriptor() ([]byte, []int) {
+	return file_file_v1_file_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *AbortMultipartUploadResponse) GetSuccess() bool {
+func (x *ListAdminActionsResponse) GetActions() []*AdminAction {
 	if x != nil {
-		return x.Success
+		return x.Actions
 	}
-	return false
+	return nil
 }
 
 var File_file_v1_file_proto protoreflect.FileDescriptor
 
 const file_file_v1_file_proto_rawDesc = "" +
 	"\n" +
-	"\x12file/v1/file.proto\x12\afile.v1\"\xa1\x01\n" +
+	"\x12file/v1/file.proto\x12\afile.v1\x1a google/protobuf/field_mask.proto\"\x83\x03\n" +
 	"\x04File\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
@@ -1185,32 +3929,115 @@ const file_file_v1_file_proto_rawDesc = "" +
 	"\x04size\x18\x04 \x01(\x03R\x04size\x12!\n" +
 	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"f\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1b\n" +
+	"\tparent_id\x18\a \x01(\tR\bparentId\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x127\n" +
+	"\bmetadata\x18\t \x03(\v2\x1b.file.v1.File.MetadataEntryR\bmetadata\x12\x16\n" +
+	"\x06sha256\x18\n" +
+	" \x01(\tR\x06sha256\x12!\n" +
+	"\fparts_sha256\x18\v \x01(\tR\vpartsSha256\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"f\n" +
 	"\x11CreateFileRequest\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x03R\x04size\x12!\n" +
-	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\" \n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"O\n" +
 	"\x0eGetFileRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x12\n" +
-	"\x10ListFilesRequest\"8\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\alink_id\x18\x02 \x01(\tR\x06linkId\x12\x14\n" +
+	"\x05admin\x18\x03 \x01(\bR\x05admin\"\xa2\x04\n" +
+	"\x10ListFilesRequest\x12 \n" +
+	"\tfolder_id\x18\x01 \x01(\tH\x00R\bfolderId\x88\x01\x01\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1e\n" +
+	"\bmin_size\x18\x05 \x01(\x03H\x01R\aminSize\x88\x01\x01\x12\x1e\n" +
+	"\bmax_size\x18\x06 \x01(\x03H\x02R\amaxSize\x88\x01\x01\x12(\n" +
+	"\rcreated_after\x18\a \x01(\x03H\x03R\fcreatedAfter\x88\x01\x01\x12*\n" +
+	"\x0ecreated_before\x18\b \x01(\x03H\x04R\rcreatedBefore\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\t \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\n" +
+	" \x01(\tR\tpageToken\x12/\n" +
+	"\asort_by\x18\v \x01(\x0e2\x16.file.v1.FileSortFieldR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\f \x01(\bR\n" +
+	"descending\x12\x14\n" +
+	"\x05admin\x18\r \x01(\bR\x05admin\x12\x19\n" +
+	"\bowner_id\x18\x0e \x01(\tR\aownerIdB\f\n" +
+	"\n" +
+	"_folder_idB\v\n" +
+	"\t_min_sizeB\v\n" +
+	"\t_max_sizeB\x10\n" +
+	"\x0e_created_afterB\x11\n" +
+	"\x0f_created_before\"\x87\x02\n" +
 	"\x11ListFilesResponse\x12#\n" +
-	"\x05files\x18\x01 \x03(\v2\r.file.v1.FileR\x05files\"1\n" +
+	"\x05files\x18\x01 \x03(\v2\r.file.v1.FileR\x05files\x12)\n" +
+	"\afolders\x18\x02 \x03(\v2\x0f.file.v1.FolderR\afolders\x121\n" +
+	"\vbreadcrumbs\x18\x03 \x03(\v2\x0f.file.v1.FolderR\vbreadcrumbs\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\x12&\n" +
+	"\x0fprev_page_token\x18\x05 \x01(\tR\rprevPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x06 \x01(\x03R\n" +
+	"totalCount\"1\n" +
 	"\fFileResponse\x12!\n" +
-	"\x04file\x18\x01 \x01(\v2\r.file.v1.FileR\x04file\"<\n" +
+	"\x04file\x18\x01 \x01(\v2\r.file.v1.FileR\x04file\"R\n" +
 	"\x11DeleteFileRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\".\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05admin\x18\x03 \x01(\bR\x05admin\"\x8d\x03\n" +
+	"\x11UpdateFileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\tR\bparentId\x12;\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x128\n" +
+	"\von_conflict\x18\x06 \x01(\x0e2\x17.file.v1.ConflictPolicyR\n" +
+	"onConflict\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12D\n" +
+	"\bmetadata\x18\b \x03(\v2(.file.v1.UpdateFileRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\">\n" +
+	"\x0fFileTagsRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"\x11\n" +
+	"\x0fListTagsRequest\"/\n" +
+	"\x03Tag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"4\n" +
+	"\x10ListTagsResponse\x12 \n" +
+	"\x04tags\x18\x01 \x03(\v2\f.file.v1.TagR\x04tags\".\n" +
 	"\x12DeleteFileResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"n\n" +
 	"\x11UploadFileRequest\x129\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x1b.file.v1.UploadFileMetadataH\x00R\bmetadata\x12\x16\n" +
 	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
-	"\x04data\"S\n" +
+	"\x04data\"\xb5\x01\n" +
 	"\x12UploadFileMetadata\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
-	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"%\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12+\n" +
+	"\x05quota\x18\x04 \x01(\v2\x15.file.v1.StorageQuotaR\x05quota\x12\x16\n" +
+	"\x06sha256\x18\x05 \x01(\tR\x06sha256\"H\n" +
+	"\fStorageQuota\x12\x1b\n" +
+	"\tmax_files\x18\x01 \x01(\x03R\bmaxFiles\x12\x1b\n" +
+	"\tmax_bytes\x18\x02 \x01(\x03R\bmaxBytes\"\x11\n" +
+	"\x0fGetUsageRequest\"\xa0\x01\n" +
+	"\x10GetUsageResponse\x12\x1d\n" +
+	"\n" +
+	"file_count\x18\x01 \x01(\x03R\tfileCount\x12\x1f\n" +
+	"\vtotal_bytes\x18\x02 \x01(\x03R\n" +
+	"totalBytes\x12'\n" +
+	"\x0fpending_uploads\x18\x03 \x01(\x03R\x0ependingUploads\x12#\n" +
+	"\rpending_bytes\x18\x04 \x01(\x03R\fpendingBytes\"\x84\x01\n" +
 	"\x13DownloadFileRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"s\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\x03R\x06length\x12\x17\n" +
+	"\alink_id\x18\x04 \x01(\tR\x06linkId\x12\x14\n" +
+	"\x05admin\x18\x05 \x01(\bR\x05admin\"s\n" +
 	"\x14DownloadFileResponse\x12;\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x1d.file.v1.DownloadFileMetadataH\x00R\bmetadata\x12\x16\n" +
 	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
@@ -1218,30 +4045,44 @@ const file_file_v1_file_proto_rawDesc = "" +
 	"\x14DownloadFileMetadata\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
 	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
-	"\x04size\x18\x03 \x01(\x03R\x04size\"~\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\"\xc8\x01\n" +
 	"\x1eInitiateMultipartUploadRequest\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
 	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x03 \x01(\x03R\ttotalSize\"~\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\tR\bparentId\x12+\n" +
+	"\x05quota\x18\x05 \x01(\v2\x15.file.v1.StorageQuotaR\x05quota\"~\n" +
 	"\x1fInitiateMultipartUploadResponse\x12\x1b\n" +
 	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x1d\n" +
 	"\n" +
 	"chunk_size\x18\x02 \x01(\x05R\tchunkSize\x12\x1f\n" +
 	"\vtotal_parts\x18\x03 \x01(\x05R\n" +
-	"totalParts\"g\n" +
+	"totalParts\"\x7f\n" +
 	"\x11UploadPartRequest\x12\x1b\n" +
 	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x1f\n" +
 	"\vpart_number\x18\x02 \x01(\x05R\n" +
 	"partNumber\x12\x14\n" +
-	"\x05chunk\x18\x03 \x01(\fR\x05chunk\"I\n" +
+	"\x05chunk\x18\x03 \x01(\fR\x05chunk\x12\x16\n" +
+	"\x06sha256\x18\x04 \x01(\tR\x06sha256\"t\n" +
+	"\x17UploadPartStreamRequest\x129\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x1b.file.v1.UploadPartMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"j\n" +
+	"\x12UploadPartMetadata\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x1f\n" +
+	"\vpart_number\x18\x02 \x01(\x05R\n" +
+	"partNumber\x12\x16\n" +
+	"\x06sha256\x18\x03 \x01(\tR\x06sha256\"a\n" +
 	"\x12UploadPartResponse\x12\x12\n" +
 	"\x04etag\x18\x01 \x01(\tR\x04etag\x12\x1f\n" +
 	"\vpart_number\x18\x02 \x01(\x05R\n" +
-	"partNumber\"f\n" +
+	"partNumber\x12\x16\n" +
+	"\x06sha256\x18\x03 \x01(\tR\x06sha256\"\x89\x01\n" +
 	"\x1eCompleteMultipartUploadRequest\x12\x1b\n" +
 	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12'\n" +
-	"\x05parts\x18\x02 \x03(\v2\x11.file.v1.PartInfoR\x05parts\"?\n" +
+	"\x05parts\x18\x02 \x03(\v2\x11.file.v1.PartInfoR\x05parts\x12!\n" +
+	"\fparts_sha256\x18\x03 \x01(\tR\vpartsSha256\"?\n" +
 	"\bPartInfo\x12\x1f\n" +
 	"\vpart_number\x18\x01 \x01(\x05R\n" +
 	"partNumber\x12\x12\n" +
@@ -1249,7 +4090,148 @@ const file_file_v1_file_proto_rawDesc = "" +
 	"\x1bAbortMultipartUploadRequest\x12\x1b\n" +
 	"\tupload_id\x18\x01 \x01(\tR\buploadId\"8\n" +
 	"\x1cAbortMultipartUploadResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\x9b\x06\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf5\x02\n" +
+	"\x0fMultipartUpload\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x04 \x01(\x03R\ttotalSize\x12\x1b\n" +
+	"\tparent_id\x18\x05 \x01(\tR\bparentId\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x06 \x01(\x05R\tchunkSize\x12\x1f\n" +
+	"\vtotal_parts\x18\a \x01(\x05R\n" +
+	"totalParts\x12%\n" +
+	"\x0eparts_uploaded\x18\b \x01(\x05R\rpartsUploaded\x12%\n" +
+	"\x0euploaded_bytes\x18\t \x01(\x03R\ruploadedBytes\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\v \x01(\x03R\texpiresAt\"o\n" +
+	"\fUploadedPart\x12\x1f\n" +
+	"\vpart_number\x18\x01 \x01(\x05R\n" +
+	"partNumber\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\x04 \x01(\tR\x06sha256\"\x1d\n" +
+	"\x1bListMultipartUploadsRequest\"R\n" +
+	"\x1cListMultipartUploadsResponse\x122\n" +
+	"\auploads\x18\x01 \x03(\v2\x18.file.v1.MultipartUploadR\auploads\"/\n" +
+	"\x10ListPartsRequest\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\"r\n" +
+	"\x11ListPartsResponse\x120\n" +
+	"\x06upload\x18\x01 \x01(\v2\x18.file.v1.MultipartUploadR\x06upload\x12+\n" +
+	"\x05parts\x18\x02 \x03(\v2\x15.file.v1.UploadedPartR\x05parts\"\xc1\x01\n" +
+	"\fDownloadLink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\x12#\n" +
+	"\rmax_downloads\x18\x04 \x01(\x05R\fmaxDownloads\x12%\n" +
+	"\x0edownload_count\x18\x05 \x01(\x05R\rdownloadCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"x\n" +
+	"\x19CreateDownloadLinkRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\x12#\n" +
+	"\rmax_downloads\x18\x03 \x01(\x05R\fmaxDownloads\"3\n" +
+	"\x18ListDownloadLinksRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\"H\n" +
+	"\x19ListDownloadLinksResponse\x12+\n" +
+	"\x05links\x18\x01 \x03(\v2\x15.file.v1.DownloadLinkR\x05links\"D\n" +
+	"\x19RevokeDownloadLinkRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"6\n" +
+	"\x1aRevokeDownloadLinkResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xbe\x01\n" +
+	"\x05Share\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x128\n" +
+	"\n" +
+	"permission\x18\x05 \x01(\x0e2\x18.file.v1.SharePermissionR\n" +
+	"permission\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"\x9a\x01\n" +
+	"\x10ShareFileRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x128\n" +
+	"\n" +
+	"permission\x18\x04 \x01(\x0e2\x18.file.v1.SharePermissionR\n" +
+	"permission\",\n" +
+	"\x11ListSharesRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\"<\n" +
+	"\x12ListSharesResponse\x12&\n" +
+	"\x06shares\x18\x01 \x03(\v2\x0e.file.v1.ShareR\x06shares\"=\n" +
+	"\x12RevokeShareRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"/\n" +
+	"\x13RevokeShareResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x19\n" +
+	"\x17ListSharedWithMeRequest\"i\n" +
+	"\n" +
+	"SharedFile\x12!\n" +
+	"\x04file\x18\x01 \x01(\v2\r.file.v1.FileR\x04file\x128\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\x0e2\x18.file.v1.SharePermissionR\n" +
+	"permission\"E\n" +
+	"\x18ListSharedWithMeResponse\x12)\n" +
+	"\x05files\x18\x01 \x03(\v2\x13.file.v1.SharedFileR\x05files\"h\n" +
+	"\x06Folder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\"F\n" +
+	"\x13CreateFolderRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\"9\n" +
+	"\x13RenameFolderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"@\n" +
+	"\x11MoveFolderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\"%\n" +
+	"\x13DeleteFolderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"~\n" +
+	"\x14DeleteFolderResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12'\n" +
+	"\x0ffolders_deleted\x18\x02 \x01(\x05R\x0efoldersDeleted\x12#\n" +
+	"\rfiles_deleted\x18\x03 \x01(\x05R\ffilesDeleted\"\xe6\x01\n" +
+	"\vAdminAction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\x12%\n" +
+	"\x0eadmin_username\x18\x03 \x01(\tR\radminUsername\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x17\n" +
+	"\afile_id\x18\x05 \x01(\tR\x06fileId\x12\x19\n" +
+	"\bowner_id\x18\x06 \x01(\tR\aownerId\x12\x1a\n" +
+	"\bfilename\x18\a \x01(\tR\bfilename\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\"c\n" +
+	"\x17ListAdminActionsRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"J\n" +
+	"\x18ListAdminActionsResponse\x12.\n" +
+	"\aactions\x18\x01 \x03(\v2\x14.file.v1.AdminActionR\aactions*\x84\x01\n" +
+	"\rFileSortField\x12\x1f\n" +
+	"\x1bFILE_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14FILE_SORT_FIELD_NAME\x10\x01\x12\x18\n" +
+	"\x14FILE_SORT_FIELD_SIZE\x10\x02\x12\x1e\n" +
+	"\x1aFILE_SORT_FIELD_CREATED_AT\x10\x03*\x84\x01\n" +
+	"\x0eConflictPolicy\x12\x1f\n" +
+	"\x1bCONFLICT_POLICY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14CONFLICT_POLICY_FAIL\x10\x01\x12\x1a\n" +
+	"\x16CONFLICT_POLICY_RENAME\x10\x02\x12\x1b\n" +
+	"\x17CONFLICT_POLICY_REPLACE\x10\x03*m\n" +
+	"\x0fSharePermission\x12 \n" +
+	"\x1cSHARE_PERMISSION_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SHARE_PERMISSION_VIEW\x10\x01\x12\x1d\n" +
+	"\x19SHARE_PERMISSION_DOWNLOAD\x10\x022\xec\x11\n" +
 	"\vFileService\x12A\n" +
 	"\n" +
 	"UploadFile\x12\x1a.file.v1.UploadFileRequest\x1a\x15.file.v1.FileResponse(\x01\x12?\n" +
@@ -1259,12 +4241,35 @@ const file_file_v1_file_proto_rawDesc = "" +
 	"\aGetFile\x12\x17.file.v1.GetFileRequest\x1a\x15.file.v1.FileResponse\x12M\n" +
 	"\fDownloadFile\x12\x1c.file.v1.DownloadFileRequest\x1a\x1d.file.v1.DownloadFileResponse0\x01\x12E\n" +
 	"\n" +
-	"DeleteFile\x12\x1a.file.v1.DeleteFileRequest\x1a\x1b.file.v1.DeleteFileResponse\x12l\n" +
+	"DeleteFile\x12\x1a.file.v1.DeleteFileRequest\x1a\x1b.file.v1.DeleteFileResponse\x12?\n" +
+	"\n" +
+	"UpdateFile\x12\x1a.file.v1.UpdateFileRequest\x1a\x15.file.v1.FileResponse\x12>\n" +
+	"\vAddFileTags\x12\x18.file.v1.FileTagsRequest\x1a\x15.file.v1.FileResponse\x12A\n" +
+	"\x0eRemoveFileTags\x12\x18.file.v1.FileTagsRequest\x1a\x15.file.v1.FileResponse\x12?\n" +
+	"\bListTags\x12\x18.file.v1.ListTagsRequest\x1a\x19.file.v1.ListTagsResponse\x12W\n" +
+	"\x10ListAdminActions\x12 .file.v1.ListAdminActionsRequest\x1a!.file.v1.ListAdminActionsResponse\x12?\n" +
+	"\bGetUsage\x12\x18.file.v1.GetUsageRequest\x1a\x19.file.v1.GetUsageResponse\x12l\n" +
 	"\x17InitiateMultipartUpload\x12'.file.v1.InitiateMultipartUploadRequest\x1a(.file.v1.InitiateMultipartUploadResponse\x12E\n" +
 	"\n" +
-	"UploadPart\x12\x1a.file.v1.UploadPartRequest\x1a\x1b.file.v1.UploadPartResponse\x12Y\n" +
+	"UploadPart\x12\x1a.file.v1.UploadPartRequest\x1a\x1b.file.v1.UploadPartResponse\x12S\n" +
+	"\x10UploadPartStream\x12 .file.v1.UploadPartStreamRequest\x1a\x1b.file.v1.UploadPartResponse(\x01\x12Y\n" +
 	"\x17CompleteMultipartUpload\x12'.file.v1.CompleteMultipartUploadRequest\x1a\x15.file.v1.FileResponse\x12c\n" +
-	"\x14AbortMultipartUpload\x12$.file.v1.AbortMultipartUploadRequest\x1a%.file.v1.AbortMultipartUploadResponseB\x8e\x01\n" +
+	"\x14AbortMultipartUpload\x12$.file.v1.AbortMultipartUploadRequest\x1a%.file.v1.AbortMultipartUploadResponse\x12c\n" +
+	"\x14ListMultipartUploads\x12$.file.v1.ListMultipartUploadsRequest\x1a%.file.v1.ListMultipartUploadsResponse\x12B\n" +
+	"\tListParts\x12\x19.file.v1.ListPartsRequest\x1a\x1a.file.v1.ListPartsResponse\x12O\n" +
+	"\x12CreateDownloadLink\x12\".file.v1.CreateDownloadLinkRequest\x1a\x15.file.v1.DownloadLink\x12Z\n" +
+	"\x11ListDownloadLinks\x12!.file.v1.ListDownloadLinksRequest\x1a\".file.v1.ListDownloadLinksResponse\x12]\n" +
+	"\x12RevokeDownloadLink\x12\".file.v1.RevokeDownloadLinkRequest\x1a#.file.v1.RevokeDownloadLinkResponse\x126\n" +
+	"\tShareFile\x12\x19.file.v1.ShareFileRequest\x1a\x0e.file.v1.Share\x12E\n" +
+	"\n" +
+	"ListShares\x12\x1a.file.v1.ListSharesRequest\x1a\x1b.file.v1.ListSharesResponse\x12H\n" +
+	"\vRevokeShare\x12\x1b.file.v1.RevokeShareRequest\x1a\x1c.file.v1.RevokeShareResponse\x12W\n" +
+	"\x10ListSharedWithMe\x12 .file.v1.ListSharedWithMeRequest\x1a!.file.v1.ListSharedWithMeResponse\x12=\n" +
+	"\fCreateFolder\x12\x1c.file.v1.CreateFolderRequest\x1a\x0f.file.v1.Folder\x12=\n" +
+	"\fRenameFolder\x12\x1c.file.v1.RenameFolderRequest\x1a\x0f.file.v1.Folder\x129\n" +
+	"\n" +
+	"MoveFolder\x12\x1a.file.v1.MoveFolderRequest\x1a\x0f.file.v1.Folder\x12K\n" +
+	"\fDeleteFolder\x12\x1c.file.v1.DeleteFolderRequest\x1a\x1d.file.v1.DeleteFolderResponseB\x8e\x01\n" +
 	"\vcom.file.v1B\tFileProtoP\x01Z7github.com/provsalt/DOP_P01_Team1/common/file/v1;filev1\xa2\x02\x03FXX\xaa\x02\aFile.V1\xca\x02\aFile\\V1\xe2\x02\x13File\\V1\\GPBMetadata\xea\x02\bFile::V1b\x06proto3"
 
 var (
@@ -1279,61 +4284,170 @@ func file_file_v1_file_proto_rawDescGZIP() []byte {
 	return file_file_v1_file_proto_rawDescData
 }
 
-var file_file_v1_file_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_file_v1_file_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_file_v1_file_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
 var file_file_v1_file_proto_goTypes = []any{
-	(*File)(nil),                            // 0: file.v1.File
-	(*CreateFileRequest)(nil),               // 1: file.v1.CreateFileRequest
-	(*GetFileRequest)(nil),                  // 2: file.v1.GetFileRequest
-	(*ListFilesRequest)(nil),                // 3: file.v1.ListFilesRequest
-	(*ListFilesResponse)(nil),               // 4: file.v1.ListFilesResponse
-	(*FileResponse)(nil),                    // 5: file.v1.FileResponse
-	(*DeleteFileRequest)(nil),               // 6: file.v1.DeleteFileRequest
-	(*DeleteFileResponse)(nil),              // 7: file.v1.DeleteFileResponse
-	(*UploadFileRequest)(nil),               // 8: file.v1.UploadFileRequest
-	(*UploadFileMetadata)(nil),              // 9: file.v1.UploadFileMetadata
-	(*DownloadFileRequest)(nil),             // 10: file.v1.DownloadFileRequest
-	(*DownloadFileResponse)(nil),            // 11: file.v1.DownloadFileResponse
-	(*DownloadFileMetadata)(nil),            // 12: file.v1.DownloadFileMetadata
-	(*InitiateMultipartUploadRequest)(nil),  // 13: file.v1.InitiateMultipartUploadRequest
-	(*InitiateMultipartUploadResponse)(nil), // 14: file.v1.InitiateMultipartUploadResponse
-	(*UploadPartRequest)(nil),               // 15: file.v1.UploadPartRequest
-	(*UploadPartResponse)(nil),              // 16: file.v1.UploadPartResponse
-	(*CompleteMultipartUploadRequest)(nil),  // 17: file.v1.CompleteMultipartUploadRequest
-	(*PartInfo)(nil),                        // 18: file.v1.PartInfo
-	(*AbortMultipartUploadRequest)(nil),     // 19: file.v1.AbortMultipartUploadRequest
-	(*AbortMultipartUploadResponse)(nil),    // 20: file.v1.AbortMultipartUploadResponse
+	(FileSortField)(0),                      // 0: file.v1.FileSortField
+	(ConflictPolicy)(0),                     // 1: file.v1.ConflictPolicy
+	(SharePermission)(0),                    // 2: file.v1.SharePermission
+	(*File)(nil),                            // 3: file.v1.File
+	(*CreateFileRequest)(nil),               // 4: file.v1.CreateFileRequest
+	(*GetFileRequest)(nil),                  // 5: file.v1.GetFileRequest
+	(*ListFilesRequest)(nil),                // 6: file.v1.ListFilesRequest
+	(*ListFilesResponse)(nil),               // 7: file.v1.ListFilesResponse
+	(*FileResponse)(nil),                    // 8: file.v1.FileResponse
+	(*DeleteFileRequest)(nil),               // 9: file.v1.DeleteFileRequest
+	(*UpdateFileRequest)(nil),               // 10: file.v1.UpdateFileRequest
+	(*FileTagsRequest)(nil),                 // 11: file.v1.FileTagsRequest
+	(*ListTagsRequest)(nil),                 // 12: file.v1.ListTagsRequest
+	(*Tag)(nil),                             // 13: file.v1.Tag
+	(*ListTagsResponse)(nil),                // 14: file.v1.ListTagsResponse
+	(*DeleteFileResponse)(nil),              // 15: file.v1.DeleteFileResponse
+	(*UploadFileRequest)(nil),               // 16: file.v1.UploadFileRequest
+	(*UploadFileMetadata)(nil),              // 17: file.v1.UploadFileMetadata
+	(*StorageQuota)(nil),                    // 18: file.v1.StorageQuota
+	(*GetUsageRequest)(nil),                 // 19: file.v1.GetUsageRequest
+	(*GetUsageResponse)(nil),                // 20: file.v1.GetUsageResponse
+	(*DownloadFileRequest)(nil),             // 21: file.v1.DownloadFileRequest
+	(*DownloadFileResponse)(nil),            // 22: file.v1.DownloadFileResponse
+	(*DownloadFileMetadata)(nil),            // 23: file.v1.DownloadFileMetadata
+	(*InitiateMultipartUploadRequest)(nil),  // 24: file.v1.InitiateMultipartUploadRequest
+	(*InitiateMultipartUploadResponse)(nil), // 25: file.v1.InitiateMultipartUploadResponse
+	(*UploadPartRequest)(nil),               // 26: file.v1.UploadPartRequest
+	(*UploadPartStreamRequest)(nil),         // 27: file.v1.UploadPartStreamRequest
+	(*UploadPartMetadata)(nil),              // 28: file.v1.UploadPartMetadata
+	(*UploadPartResponse)(nil),              // 29: file.v1.UploadPartResponse
+	(*CompleteMultipartUploadRequest)(nil),  // 30: file.v1.CompleteMultipartUploadRequest
+	(*PartInfo)(nil),                        // 31: file.v1.PartInfo
+	(*AbortMultipartUploadRequest)(nil),     // 32: file.v1.AbortMultipartUploadRequest
+	(*AbortMultipartUploadResponse)(nil),    // 33: file.v1.AbortMultipartUploadResponse
+	(*MultipartUpload)(nil),                 // 34: file.v1.MultipartUpload
+	(*UploadedPart)(nil),                    // 35: file.v1.UploadedPart
+	(*ListMultipartUploadsRequest)(nil),     // 36: file.v1.ListMultipartUploadsRequest
+	(*ListMultipartUploadsResponse)(nil),    // 37: file.v1.ListMultipartUploadsResponse
+	(*ListPartsRequest)(nil),                // 38: file.v1.ListPartsRequest
+	(*ListPartsResponse)(nil),               // 39: file.v1.ListPartsResponse
+	(*DownloadLink)(nil),                    // 40: file.v1.DownloadLink
+	(*CreateDownloadLinkRequest)(nil),       // 41: file.v1.CreateDownloadLinkRequest
+	(*ListDownloadLinksRequest)(nil),        // 42: file.v1.ListDownloadLinksRequest
+	(*ListDownloadLinksResponse)(nil),       // 43: file.v1.ListDownloadLinksResponse
+	(*RevokeDownloadLinkRequest)(nil),       // 44: file.v1.RevokeDownloadLinkRequest
+	(*RevokeDownloadLinkResponse)(nil),      // 45: file.v1.RevokeDownloadLinkResponse
+	(*Share)(nil),                           // 46: file.v1.Share
+	(*ShareFileRequest)(nil),                // 47: file.v1.ShareFileRequest
+	(*ListSharesRequest)(nil),               // 48: file.v1.ListSharesRequest
+	(*ListSharesResponse)(nil),              // 49: file.v1.ListSharesResponse
+	(*RevokeShareRequest)(nil),              // 50: file.v1.RevokeShareRequest
+	(*RevokeShareResponse)(nil),             // 51: file.v1.RevokeShareResponse
+	(*ListSharedWithMeRequest)(nil),         // 52: file.v1.ListSharedWithMeRequest
+	(*SharedFile)(nil),                      // 53: file.v1.SharedFile
+	(*ListSharedWithMeResponse)(nil),        // 54: file.v1.ListSharedWithMeResponse
+	(*Folder)(nil),                          // 55: file.v1.Folder
+	(*CreateFolderRequest)(nil),             // 56: file.v1.CreateFolderRequest
+	(*RenameFolderRequest)(nil),             // 57: file.v1.RenameFolderRequest
+	(*MoveFolderRequest)(nil),               // 58: file.v1.MoveFolderRequest
+	(*DeleteFolderRequest)(nil),             // 59: file.v1.DeleteFolderRequest
+	(*DeleteFolderResponse)(nil),            // 60: file.v1.DeleteFolderResponse
+	(*AdminAction)(nil),                     // 61: file.v1.AdminAction
+	(*ListAdminActionsRequest)(nil),         // 62: file.v1.ListAdminActionsRequest
+	(*ListAdminActionsResponse)(nil),        // 63: file.v1.ListAdminActionsResponse
+	nil,                                     // 64: file.v1.File.MetadataEntry
+	nil,                                     // 65: file.v1.UpdateFileRequest.MetadataEntry
+	(*fieldmaskpb.FieldMask)(nil),           // 66: google.protobuf.FieldMask
 }
 var file_file_v1_file_proto_depIdxs = []int32{
-	0,  // 0: file.v1.ListFilesResponse.files:type_name -> file.v1.File
-	0,  // 1: file.v1.FileResponse.file:type_name -> file.v1.File
-	9,  // 2: file.v1.UploadFileRequest.metadata:type_name -> file.v1.UploadFileMetadata
-	12, // 3: file.v1.DownloadFileResponse.metadata:type_name -> file.v1.DownloadFileMetadata
-	18, // 4: file.v1.CompleteMultipartUploadRequest.parts:type_name -> file.v1.PartInfo
-	8,  // 5: file.v1.FileService.UploadFile:input_type -> file.v1.UploadFileRequest
-	1,  // 6: file.v1.FileService.CreateFile:input_type -> file.v1.CreateFileRequest
-	3,  // 7: file.v1.FileService.ListFiles:input_type -> file.v1.ListFilesRequest
-	2,  // 8: file.v1.FileService.GetFile:input_type -> file.v1.GetFileRequest
-	10, // 9: file.v1.FileService.DownloadFile:input_type -> file.v1.DownloadFileRequest
-	6,  // 10: file.v1.FileService.DeleteFile:input_type -> file.v1.DeleteFileRequest
-	13, // 11: file.v1.FileService.InitiateMultipartUpload:input_type -> file.v1.InitiateMultipartUploadRequest
-	15, // 12: file.v1.FileService.UploadPart:input_type -> file.v1.UploadPartRequest
-	17, // 13: file.v1.FileService.CompleteMultipartUpload:input_type -> file.v1.CompleteMultipartUploadRequest
-	19, // 14: file.v1.FileService.AbortMultipartUpload:input_type -> file.v1.AbortMultipartUploadRequest
-	5,  // 15: file.v1.FileService.UploadFile:output_type -> file.v1.FileResponse
-	5,  // 16: file.v1.FileService.CreateFile:output_type -> file.v1.FileResponse
-	4,  // 17: file.v1.FileService.ListFiles:output_type -> file.v1.ListFilesResponse
-	5,  // 18: file.v1.FileService.GetFile:output_type -> file.v1.FileResponse
-	11, // 19: file.v1.FileService.DownloadFile:output_type -> file.v1.DownloadFileResponse
-	7,  // 20: file.v1.FileService.DeleteFile:output_type -> file.v1.DeleteFileResponse
-	14, // 21: file.v1.FileService.InitiateMultipartUpload:output_type -> file.v1.InitiateMultipartUploadResponse
-	16, // 22: file.v1.FileService.UploadPart:output_type -> file.v1.UploadPartResponse
-	5,  // 23: file.v1.FileService.CompleteMultipartUpload:output_type -> file.v1.FileResponse
-	20, // 24: file.v1.FileService.AbortMultipartUpload:output_type -> file.v1.AbortMultipartUploadResponse
-	15, // [15:25] is the sub-list for method output_type
-	5,  // [5:15] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	64, // 0: file.v1.File.metadata:type_name -> file.v1.File.MetadataEntry
+	0,  // 1: file.v1.ListFilesRequest.sort_by:type_name -> file.v1.FileSortField
+	3,  // 2: file.v1.ListFilesResponse.files:type_name -> file.v1.File
+	55, // 3: file.v1.ListFilesResponse.folders:type_name -> file.v1.Folder
+	55, // 4: file.v1.ListFilesResponse.breadcrumbs:type_name -> file.v1.Folder
+	3,  // 5: file.v1.FileResponse.file:type_name -> file.v1.File
+	66, // 6: file.v1.UpdateFileRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 7: file.v1.UpdateFileRequest.on_conflict:type_name -> file.v1.ConflictPolicy
+	65, // 8: file.v1.UpdateFileRequest.metadata:type_name -> file.v1.UpdateFileRequest.MetadataEntry
+	13, // 9: file.v1.ListTagsResponse.tags:type_name -> file.v1.Tag
+	17, // 10: file.v1.UploadFileRequest.metadata:type_name -> file.v1.UploadFileMetadata
+	18, // 11: file.v1.UploadFileMetadata.quota:type_name -> file.v1.StorageQuota
+	23, // 12: file.v1.DownloadFileResponse.metadata:type_name -> file.v1.DownloadFileMetadata
+	18, // 13: file.v1.InitiateMultipartUploadRequest.quota:type_name -> file.v1.StorageQuota
+	28, // 14: file.v1.UploadPartStreamRequest.metadata:type_name -> file.v1.UploadPartMetadata
+	31, // 15: file.v1.CompleteMultipartUploadRequest.parts:type_name -> file.v1.PartInfo
+	34, // 16: file.v1.ListMultipartUploadsResponse.uploads:type_name -> file.v1.MultipartUpload
+	34, // 17: file.v1.ListPartsResponse.upload:type_name -> file.v1.MultipartUpload
+	35, // 18: file.v1.ListPartsResponse.parts:type_name -> file.v1.UploadedPart
+	40, // 19: file.v1.ListDownloadLinksResponse.links:type_name -> file.v1.DownloadLink
+	2,  // 20: file.v1.Share.permission:type_name -> file.v1.SharePermission
+	2,  // 21: file.v1.ShareFileRequest.permission:type_name -> file.v1.SharePermission
+	46, // 22: file.v1.ListSharesResponse.shares:type_name -> file.v1.Share
+	3,  // 23: file.v1.SharedFile.file:type_name -> file.v1.File
+	2,  // 24: file.v1.SharedFile.permission:type_name -> file.v1.SharePermission
+	53, // 25: file.v1.ListSharedWithMeResponse.files:type_name -> file.v1.SharedFile
+	61, // 26: file.v1.ListAdminActionsResponse.actions:type_name -> file.v1.AdminAction
+	16, // 27: file.v1.FileService.UploadFile:input_type -> file.v1.UploadFileRequest
+	4,  // 28: file.v1.FileService.CreateFile:input_type -> file.v1.CreateFileRequest
+	6,  // 29: file.v1.FileService.ListFiles:input_type -> file.v1.ListFilesRequest
+	5,  // 30: file.v1.FileService.GetFile:input_type -> file.v1.GetFileRequest
+	21, // 31: file.v1.FileService.DownloadFile:input_type -> file.v1.DownloadFileRequest
+	9,  // 32: file.v1.FileService.DeleteFile:input_type -> file.v1.DeleteFileRequest
+	10, // 33: file.v1.FileService.UpdateFile:input_type -> file.v1.UpdateFileRequest
+	11, // 34: file.v1.FileService.AddFileTags:input_type -> file.v1.FileTagsRequest
+	11, // 35: file.v1.FileService.RemoveFileTags:input_type -> file.v1.FileTagsRequest
+	12, // 36: file.v1.FileService.ListTags:input_type -> file.v1.ListTagsRequest
+	62, // 37: file.v1.FileService.ListAdminActions:input_type -> file.v1.ListAdminActionsRequest
+	19, // 38: file.v1.FileService.GetUsage:input_type -> file.v1.GetUsageRequest
+	24, // 39: file.v1.FileService.InitiateMultipartUpload:input_type -> file.v1.InitiateMultipartUploadRequest
+	26, // 40: file.v1.FileService.UploadPart:input_type -> file.v1.UploadPartRequest
+	27, // 41: file.v1.FileService.UploadPartStream:input_type -> file.v1.UploadPartStreamRequest
+	30, // 42: file.v1.FileService.CompleteMultipartUpload:input_type -> file.v1.CompleteMultipartUploadRequest
+	32, // 43: file.v1.FileService.AbortMultipartUpload:input_type -> file.v1.AbortMultipartUploadRequest
+	36, // 44: file.v1.FileService.ListMultipartUploads:input_type -> file.v1.ListMultipartUploadsRequest
+	38, // 45: file.v1.FileService.ListParts:input_type -> file.v1.ListPartsRequest
+	41, // 46: file.v1.FileService.CreateDownloadLink:input_type -> file.v1.CreateDownloadLinkRequest
+	42, // 47: file.v1.FileService.ListDownloadLinks:input_type -> file.v1.ListDownloadLinksRequest
+	44, // 48: file.v1.FileService.RevokeDownloadLink:input_type -> file.v1.RevokeDownloadLinkRequest
+	47, // 49: file.v1.FileService.ShareFile:input_type -> file.v1.ShareFileRequest
+	48, // 50: file.v1.FileService.ListShares:input_type -> file.v1.ListSharesRequest
+	50, // 51: file.v1.FileService.RevokeShare:input_type -> file.v1.RevokeShareRequest
+	52, // 52: file.v1.FileService.ListSharedWithMe:input_type -> file.v1.ListSharedWithMeRequest
+	56, // 53: file.v1.FileService.CreateFolder:input_type -> file.v1.CreateFolderRequest
+	57, // 54: file.v1.FileService.RenameFolder:input_type -> file.v1.RenameFolderRequest
+	58, // 55: file.v1.FileService.MoveFolder:input_type -> file.v1.MoveFolderRequest
+	59, // 56: file.v1.FileService.DeleteFolder:input_type -> file.v1.DeleteFolderRequest
+	8,  // 57: file.v1.FileService.UploadFile:output_type -> file.v1.FileResponse
+	8,  // 58: file.v1.FileService.CreateFile:output_type -> file.v1.FileResponse
+	7,  // 59: file.v1.FileService.ListFiles:output_type -> file.v1.ListFilesResponse
+	8,  // 60: file.v1.FileService.GetFile:output_type -> file.v1.FileResponse
+	22, // 61: file.v1.FileService.DownloadFile:output_type -> file.v1.DownloadFileResponse
+	15, // 62: file.v1.FileService.DeleteFile:output_type -> file.v1.DeleteFileResponse
+	8,  // 63: file.v1.FileService.UpdateFile:output_type -> file.v1.FileResponse
+	8,  // 64: file.v1.FileService.AddFileTags:output_type -> file.v1.FileResponse
+	8,  // 65: file.v1.FileService.RemoveFileTags:output_type -> file.v1.FileResponse
+	14, // 66: file.v1.FileService.ListTags:output_type -> file.v1.ListTagsResponse
+	63, // 67: file.v1.FileService.ListAdminActions:output_type -> file.v1.ListAdminActionsResponse
+	20, // 68: file.v1.FileService.GetUsage:output_type -> file.v1.GetUsageResponse
+	25, // 69: file.v1.FileService.InitiateMultipartUpload:output_type -> file.v1.InitiateMultipartUploadResponse
+	29, // 70: file.v1.FileService.UploadPart:output_type -> file.v1.UploadPartResponse
+	29, // 71: file.v1.FileService.UploadPartStream:output_type -> file.v1.UploadPartResponse
+	8,  // 72: file.v1.FileService.CompleteMultipartUpload:output_type -> file.v1.FileResponse
+	33, // 73: file.v1.FileService.AbortMultipartUpload:output_type -> file.v1.AbortMultipartUploadResponse
+	37, // 74: file.v1.FileService.ListMultipartUploads:output_type -> file.v1.ListMultipartUploadsResponse
+	39, // 75: file.v1.FileService.ListParts:output_type -> file.v1.ListPartsResponse
+	40, // 76: file.v1.FileService.CreateDownloadLink:output_type -> file.v1.DownloadLink
+	43, // 77: file.v1.FileService.ListDownloadLinks:output_type -> file.v1.ListDownloadLinksResponse
+	45, // 78: file.v1.FileService.RevokeDownloadLink:output_type -> file.v1.RevokeDownloadLinkResponse
+	46, // 79: file.v1.FileService.ShareFile:output_type -> file.v1.Share
+	49, // 80: file.v1.FileService.ListShares:output_type -> file.v1.ListSharesResponse
+	51, // 81: file.v1.FileService.RevokeShare:output_type -> file.v1.RevokeShareResponse
+	54, // 82: file.v1.FileService.ListSharedWithMe:output_type -> file.v1.ListSharedWithMeResponse
+	55, // 83: file.v1.FileService.CreateFolder:output_type -> file.v1.Folder
+	55, // 84: file.v1.FileService.RenameFolder:output_type -> file.v1.Folder
+	55, // 85: file.v1.FileService.MoveFolder:output_type -> file.v1.Folder
+	60, // 86: file.v1.FileService.DeleteFolder:output_type -> file.v1.DeleteFolderResponse
+	57, // [57:87] is the sub-list for method output_type
+	27, // [27:57] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_file_v1_file_proto_init() }
@@ -1341,26 +4455,32 @@ func file_file_v1_file_proto_init() {
 	if File_file_v1_file_proto != nil {
 		return
 	}
-	file_file_v1_file_proto_msgTypes[8].OneofWrappers = []any{
+	file_file_v1_file_proto_msgTypes[3].OneofWrappers = []any{}
+	file_file_v1_file_proto_msgTypes[13].OneofWrappers = []any{
 		(*UploadFileRequest_Metadata)(nil),
 		(*UploadFileRequest_Chunk)(nil),
 	}
-	file_file_v1_file_proto_msgTypes[11].OneofWrappers = []any{
+	file_file_v1_file_proto_msgTypes[19].OneofWrappers = []any{
 		(*DownloadFileResponse_Metadata)(nil),
 		(*DownloadFileResponse_Chunk)(nil),
 	}
+	file_file_v1_file_proto_msgTypes[24].OneofWrappers = []any{
+		(*UploadPartStreamRequest_Metadata)(nil),
+		(*UploadPartStreamRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_file_v1_file_proto_rawDesc), len(file_file_v1_file_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   21,
+			NumEnums:      3,
+			NumMessages:   63,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_file_v1_file_proto_goTypes,
 		DependencyIndexes: file_file_v1_file_proto_depIdxs,
+		EnumInfos:         file_file_v1_file_proto_enumTypes,
 		MessageInfos:      file_file_v1_file_proto_msgTypes,
 	}.Build()
 	File_file_v1_file_proto = out.File
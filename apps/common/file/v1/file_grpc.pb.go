@@ -25,10 +25,30 @@ const (
 	FileService_GetFile_FullMethodName                 = "/file.v1.FileService/GetFile"
 	FileService_DownloadFile_FullMethodName            = "/file.v1.FileService/DownloadFile"
 	FileService_DeleteFile_FullMethodName              = "/file.v1.FileService/DeleteFile"
+	FileService_UpdateFile_FullMethodName              = "/file.v1.FileService/UpdateFile"
+	FileService_AddFileTags_FullMethodName             = "/file.v1.FileService/AddFileTags"
+	FileService_RemoveFileTags_FullMethodName          = "/file.v1.FileService/RemoveFileTags"
+	FileService_ListTags_FullMethodName                = "/file.v1.FileService/ListTags"
+	FileService_ListAdminActions_FullMethodName        = "/file.v1.FileService/ListAdminActions"
+	FileService_GetUsage_FullMethodName                = "/file.v1.FileService/GetUsage"
 	FileService_InitiateMultipartUpload_FullMethodName = "/file.v1.FileService/InitiateMultipartUpload"
 	FileService_UploadPart_FullMethodName              = "/file.v1.FileService/UploadPart"
+	FileService_UploadPartStream_FullMethodName        = "/file.v1.FileService/UploadPartStream"
 	FileService_CompleteMultipartUpload_FullMethodName = "/file.v1.FileService/CompleteMultipartUpload"
 	FileService_AbortMultipartUpload_FullMethodName    = "/file.v1.FileService/AbortMultipartUpload"
+	FileService_ListMultipartUploads_FullMethodName    = "/file.v1.FileService/ListMultipartUploads"
+	FileService_ListParts_FullMethodName               = "/file.v1.FileService/ListParts"
+	FileService_CreateDownloadLink_FullMethodName      = "/file.v1.FileService/CreateDownloadLink"
+	FileService_ListDownloadLinks_FullMethodName       = "/file.v1.FileService/ListDownloadLinks"
+	FileService_RevokeDownloadLink_FullMethodName      = "/file.v1.FileService/RevokeDownloadLink"
+	FileService_ShareFile_FullMethodName               = "/file.v1.FileService/ShareFile"
+	FileService_ListShares_FullMethodName              = "/file.v1.FileService/ListShares"
+	FileService_RevokeShare_FullMethodName             = "/file.v1.FileService/RevokeShare"
+	FileService_ListSharedWithMe_FullMethodName        = "/file.v1.FileService/ListSharedWithMe"
+	FileService_CreateFolder_FullMethodName            = "/file.v1.FileService/CreateFolder"
+	FileService_RenameFolder_FullMethodName            = "/file.v1.FileService/RenameFolder"
+	FileService_MoveFolder_FullMethodName              = "/file.v1.FileService/MoveFolder"
+	FileService_DeleteFolder_FullMethodName            = "/file.v1.FileService/DeleteFolder"
 )
 
 // FileServiceClient is the client API for FileService service.
@@ -41,10 +61,30 @@ type FileServiceClient interface {
 	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*FileResponse, error)
 	DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadFileResponse], error)
 	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error)
+	UpdateFile(ctx context.Context, in *UpdateFileRequest, opts ...grpc.CallOption) (*FileResponse, error)
+	AddFileTags(ctx context.Context, in *FileTagsRequest, opts ...grpc.CallOption) (*FileResponse, error)
+	RemoveFileTags(ctx context.Context, in *FileTagsRequest, opts ...grpc.CallOption) (*FileResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	ListAdminActions(ctx context.Context, in *ListAdminActionsRequest, opts ...grpc.CallOption) (*ListAdminActionsResponse, error)
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
 	InitiateMultipartUpload(ctx context.Context, in *InitiateMultipartUploadRequest, opts ...grpc.CallOption) (*InitiateMultipartUploadResponse, error)
 	UploadPart(ctx context.Context, in *UploadPartRequest, opts ...grpc.CallOption) (*UploadPartResponse, error)
+	UploadPartStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadPartStreamRequest, UploadPartResponse], error)
 	CompleteMultipartUpload(ctx context.Context, in *CompleteMultipartUploadRequest, opts ...grpc.CallOption) (*FileResponse, error)
 	AbortMultipartUpload(ctx context.Context, in *AbortMultipartUploadRequest, opts ...grpc.CallOption) (*AbortMultipartUploadResponse, error)
+	ListMultipartUploads(ctx context.Context, in *ListMultipartUploadsRequest, opts ...grpc.CallOption) (*ListMultipartUploadsResponse, error)
+	ListParts(ctx context.Context, in *ListPartsRequest, opts ...grpc.CallOption) (*ListPartsResponse, error)
+	CreateDownloadLink(ctx context.Context, in *CreateDownloadLinkRequest, opts ...grpc.CallOption) (*DownloadLink, error)
+	ListDownloadLinks(ctx context.Context, in *ListDownloadLinksRequest, opts ...grpc.CallOption) (*ListDownloadLinksResponse, error)
+	RevokeDownloadLink(ctx context.Context, in *RevokeDownloadLinkRequest, opts ...grpc.CallOption) (*RevokeDownloadLinkResponse, error)
+	ShareFile(ctx context.Context, in *ShareFileRequest, opts ...grpc.CallOption) (*Share, error)
+	ListShares(ctx context.Context, in *ListSharesRequest, opts ...grpc.CallOption) (*ListSharesResponse, error)
+	RevokeShare(ctx context.Context, in *RevokeShareRequest, opts ...grpc.CallOption) (*RevokeShareResponse, error)
+	ListSharedWithMe(ctx context.Context, in *ListSharedWithMeRequest, opts ...grpc.CallOption) (*ListSharedWithMeResponse, error)
+	CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*Folder, error)
+	RenameFolder(ctx context.Context, in *RenameFolderRequest, opts ...grpc.CallOption) (*Folder, error)
+	MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*Folder, error)
+	DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error)
 }
 
 type fileServiceClient struct {
@@ -127,6 +167,66 @@ func (c *fileServiceClient) DeleteFile(ctx context.Context, in *DeleteFileReques
 	return out, nil
 }
 
+func (c *fileServiceClient) UpdateFile(ctx context.Context, in *UpdateFileRequest, opts ...grpc.CallOption) (*FileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileResponse)
+	err := c.cc.Invoke(ctx, FileService_UpdateFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) AddFileTags(ctx context.Context, in *FileTagsRequest, opts ...grpc.CallOption) (*FileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileResponse)
+	err := c.cc.Invoke(ctx, FileService_AddFileTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) RemoveFileTags(ctx context.Context, in *FileTagsRequest, opts ...grpc.CallOption) (*FileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileResponse)
+	err := c.cc.Invoke(ctx, FileService_RemoveFileTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, FileService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) ListAdminActions(ctx context.Context, in *ListAdminActionsRequest, opts ...grpc.CallOption) (*ListAdminActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAdminActionsResponse)
+	err := c.cc.Invoke(ctx, FileService_ListAdminActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, FileService_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fileServiceClient) InitiateMultipartUpload(ctx context.Context, in *InitiateMultipartUploadRequest, opts ...grpc.CallOption) (*InitiateMultipartUploadResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InitiateMultipartUploadResponse)
@@ -147,6 +247,19 @@ func (c *fileServiceClient) UploadPart(ctx context.Context, in *UploadPartReques
 	return out, nil
 }
 
+func (c *fileServiceClient) UploadPartStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadPartStreamRequest, UploadPartResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FileService_ServiceDesc.Streams[2], FileService_UploadPartStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadPartStreamRequest, UploadPartResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FileService_UploadPartStreamClient = grpc.ClientStreamingClient[UploadPartStreamRequest, UploadPartResponse]
+
 func (c *fileServiceClient) CompleteMultipartUpload(ctx context.Context, in *CompleteMultipartUploadRequest, opts ...grpc.CallOption) (*FileResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FileResponse)
@@ -167,6 +280,136 @@ func (c *fileServiceClient) AbortMultipartUpload(ctx context.Context, in *AbortM
 	return out, nil
 }
 
+func (c *fileServiceClient) ListMultipartUploads(ctx context.Context, in *ListMultipartUploadsRequest, opts ...grpc.CallOption) (*ListMultipartUploadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMultipartUploadsResponse)
+	err := c.cc.Invoke(ctx, FileService_ListMultipartUploads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) ListParts(ctx context.Context, in *ListPartsRequest, opts ...grpc.CallOption) (*ListPartsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPartsResponse)
+	err := c.cc.Invoke(ctx, FileService_ListParts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) CreateDownloadLink(ctx context.Context, in *CreateDownloadLinkRequest, opts ...grpc.CallOption) (*DownloadLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DownloadLink)
+	err := c.cc.Invoke(ctx, FileService_CreateDownloadLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) ListDownloadLinks(ctx context.Context, in *ListDownloadLinksRequest, opts ...grpc.CallOption) (*ListDownloadLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDownloadLinksResponse)
+	err := c.cc.Invoke(ctx, FileService_ListDownloadLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) RevokeDownloadLink(ctx context.Context, in *RevokeDownloadLinkRequest, opts ...grpc.CallOption) (*RevokeDownloadLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeDownloadLinkResponse)
+	err := c.cc.Invoke(ctx, FileService_RevokeDownloadLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) ShareFile(ctx context.Context, in *ShareFileRequest, opts ...grpc.CallOption) (*Share, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Share)
+	err := c.cc.Invoke(ctx, FileService_ShareFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) ListShares(ctx context.Context, in *ListSharesRequest, opts ...grpc.CallOption) (*ListSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSharesResponse)
+	err := c.cc.Invoke(ctx, FileService_ListShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) RevokeShare(ctx context.Context, in *RevokeShareRequest, opts ...grpc.CallOption) (*RevokeShareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeShareResponse)
+	err := c.cc.Invoke(ctx, FileService_RevokeShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) ListSharedWithMe(ctx context.Context, in *ListSharedWithMeRequest, opts ...grpc.CallOption) (*ListSharedWithMeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSharedWithMeResponse)
+	err := c.cc.Invoke(ctx, FileService_ListSharedWithMe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*Folder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Folder)
+	err := c.cc.Invoke(ctx, FileService_CreateFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) RenameFolder(ctx context.Context, in *RenameFolderRequest, opts ...grpc.CallOption) (*Folder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Folder)
+	err := c.cc.Invoke(ctx, FileService_RenameFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*Folder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Folder)
+	err := c.cc.Invoke(ctx, FileService_MoveFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFolderResponse)
+	err := c.cc.Invoke(ctx, FileService_DeleteFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FileServiceServer is the server API for FileService service.
 // All implementations must embed UnimplementedFileServiceServer
 // for forward compatibility.
@@ -177,10 +420,30 @@ type FileServiceServer interface {
 	GetFile(context.Context, *GetFileRequest) (*FileResponse, error)
 	DownloadFile(*DownloadFileRequest, grpc.ServerStreamingServer[DownloadFileResponse]) error
 	DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error)
+	UpdateFile(context.Context, *UpdateFileRequest) (*FileResponse, error)
+	AddFileTags(context.Context, *FileTagsRequest) (*FileResponse, error)
+	RemoveFileTags(context.Context, *FileTagsRequest) (*FileResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	ListAdminActions(context.Context, *ListAdminActionsRequest) (*ListAdminActionsResponse, error)
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
 	InitiateMultipartUpload(context.Context, *InitiateMultipartUploadRequest) (*InitiateMultipartUploadResponse, error)
 	UploadPart(context.Context, *UploadPartRequest) (*UploadPartResponse, error)
+	UploadPartStream(grpc.ClientStreamingServer[UploadPartStreamRequest, UploadPartResponse]) error
 	CompleteMultipartUpload(context.Context, *CompleteMultipartUploadRequest) (*FileResponse, error)
 	AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*AbortMultipartUploadResponse, error)
+	ListMultipartUploads(context.Context, *ListMultipartUploadsRequest) (*ListMultipartUploadsResponse, error)
+	ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error)
+	CreateDownloadLink(context.Context, *CreateDownloadLinkRequest) (*DownloadLink, error)
+	ListDownloadLinks(context.Context, *ListDownloadLinksRequest) (*ListDownloadLinksResponse, error)
+	RevokeDownloadLink(context.Context, *RevokeDownloadLinkRequest) (*RevokeDownloadLinkResponse, error)
+	ShareFile(context.Context, *ShareFileRequest) (*Share, error)
+	ListShares(context.Context, *ListSharesRequest) (*ListSharesResponse, error)
+	RevokeShare(context.Context, *RevokeShareRequest) (*RevokeShareResponse, error)
+	ListSharedWithMe(context.Context, *ListSharedWithMeRequest) (*ListSharedWithMeResponse, error)
+	CreateFolder(context.Context, *CreateFolderRequest) (*Folder, error)
+	RenameFolder(context.Context, *RenameFolderRequest) (*Folder, error)
+	MoveFolder(context.Context, *MoveFolderRequest) (*Folder, error)
+	DeleteFolder(context.Context, *DeleteFolderRequest) (*DeleteFolderResponse, error)
 	mustEmbedUnimplementedFileServiceServer()
 }
 
@@ -209,18 +472,78 @@ func (UnimplementedFileServiceServer) DownloadFile(*DownloadFileRequest, grpc.Se
 func (UnimplementedFileServiceServer) DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteFile not implemented")
 }
+func (UnimplementedFileServiceServer) UpdateFile(context.Context, *UpdateFileRequest) (*FileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateFile not implemented")
+}
+func (UnimplementedFileServiceServer) AddFileTags(context.Context, *FileTagsRequest) (*FileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddFileTags not implemented")
+}
+func (UnimplementedFileServiceServer) RemoveFileTags(context.Context, *FileTagsRequest) (*FileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveFileTags not implemented")
+}
+func (UnimplementedFileServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedFileServiceServer) ListAdminActions(context.Context, *ListAdminActionsRequest) (*ListAdminActionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAdminActions not implemented")
+}
+func (UnimplementedFileServiceServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUsage not implemented")
+}
 func (UnimplementedFileServiceServer) InitiateMultipartUpload(context.Context, *InitiateMultipartUploadRequest) (*InitiateMultipartUploadResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InitiateMultipartUpload not implemented")
 }
 func (UnimplementedFileServiceServer) UploadPart(context.Context, *UploadPartRequest) (*UploadPartResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UploadPart not implemented")
 }
+func (UnimplementedFileServiceServer) UploadPartStream(grpc.ClientStreamingServer[UploadPartStreamRequest, UploadPartResponse]) error {
+	return status.Error(codes.Unimplemented, "method UploadPartStream not implemented")
+}
 func (UnimplementedFileServiceServer) CompleteMultipartUpload(context.Context, *CompleteMultipartUploadRequest) (*FileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CompleteMultipartUpload not implemented")
 }
 func (UnimplementedFileServiceServer) AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*AbortMultipartUploadResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AbortMultipartUpload not implemented")
 }
+func (UnimplementedFileServiceServer) ListMultipartUploads(context.Context, *ListMultipartUploadsRequest) (*ListMultipartUploadsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMultipartUploads not implemented")
+}
+func (UnimplementedFileServiceServer) ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListParts not implemented")
+}
+func (UnimplementedFileServiceServer) CreateDownloadLink(context.Context, *CreateDownloadLinkRequest) (*DownloadLink, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateDownloadLink not implemented")
+}
+func (UnimplementedFileServiceServer) ListDownloadLinks(context.Context, *ListDownloadLinksRequest) (*ListDownloadLinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDownloadLinks not implemented")
+}
+func (UnimplementedFileServiceServer) RevokeDownloadLink(context.Context, *RevokeDownloadLinkRequest) (*RevokeDownloadLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeDownloadLink not implemented")
+}
+func (UnimplementedFileServiceServer) ShareFile(context.Context, *ShareFileRequest) (*Share, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShareFile not implemented")
+}
+func (UnimplementedFileServiceServer) ListShares(context.Context, *ListSharesRequest) (*ListSharesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListShares not implemented")
+}
+func (UnimplementedFileServiceServer) RevokeShare(context.Context, *RevokeShareRequest) (*RevokeShareResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeShare not implemented")
+}
+func (UnimplementedFileServiceServer) ListSharedWithMe(context.Context, *ListSharedWithMeRequest) (*ListSharedWithMeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSharedWithMe not implemented")
+}
+func (UnimplementedFileServiceServer) CreateFolder(context.Context, *CreateFolderRequest) (*Folder, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateFolder not implemented")
+}
+func (UnimplementedFileServiceServer) RenameFolder(context.Context, *RenameFolderRequest) (*Folder, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenameFolder not implemented")
+}
+func (UnimplementedFileServiceServer) MoveFolder(context.Context, *MoveFolderRequest) (*Folder, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveFolder not implemented")
+}
+func (UnimplementedFileServiceServer) DeleteFolder(context.Context, *DeleteFolderRequest) (*DeleteFolderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteFolder not implemented")
+}
 func (UnimplementedFileServiceServer) mustEmbedUnimplementedFileServiceServer() {}
 func (UnimplementedFileServiceServer) testEmbeddedByValue()                     {}
 
@@ -332,6 +655,114 @@ func _FileService_DeleteFile_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileService_UpdateFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).UpdateFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_UpdateFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).UpdateFile(ctx, req.(*UpdateFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_AddFileTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).AddFileTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_AddFileTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).AddFileTags(ctx, req.(*FileTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_RemoveFileTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).RemoveFileTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_RemoveFileTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).RemoveFileTags(ctx, req.(*FileTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_ListAdminActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAdminActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ListAdminActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ListAdminActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ListAdminActions(ctx, req.(*ListAdminActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FileService_InitiateMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InitiateMultipartUploadRequest)
 	if err := dec(in); err != nil {
@@ -368,6 +799,13 @@ func _FileService_UploadPart_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileService_UploadPartStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FileServiceServer).UploadPartStream(&grpc.GenericServerStream[UploadPartStreamRequest, UploadPartResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FileService_UploadPartStreamServer = grpc.ClientStreamingServer[UploadPartStreamRequest, UploadPartResponse]
+
 func _FileService_CompleteMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CompleteMultipartUploadRequest)
 	if err := dec(in); err != nil {
@@ -404,6 +842,240 @@ func _FileService_AbortMultipartUpload_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileService_ListMultipartUploads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMultipartUploadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ListMultipartUploads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ListMultipartUploads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ListMultipartUploads(ctx, req.(*ListMultipartUploadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_ListParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ListParts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ListParts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ListParts(ctx, req.(*ListPartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_CreateDownloadLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDownloadLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).CreateDownloadLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_CreateDownloadLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).CreateDownloadLink(ctx, req.(*CreateDownloadLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_ListDownloadLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDownloadLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ListDownloadLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ListDownloadLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ListDownloadLinks(ctx, req.(*ListDownloadLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_RevokeDownloadLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeDownloadLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).RevokeDownloadLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_RevokeDownloadLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).RevokeDownloadLink(ctx, req.(*RevokeDownloadLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_ShareFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ShareFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ShareFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ShareFile(ctx, req.(*ShareFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_ListShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ListShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ListShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ListShares(ctx, req.(*ListSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_RevokeShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).RevokeShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_RevokeShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).RevokeShare(ctx, req.(*RevokeShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_ListSharedWithMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharedWithMeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ListSharedWithMe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ListSharedWithMe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ListSharedWithMe(ctx, req.(*ListSharedWithMeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).CreateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_CreateFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).CreateFolder(ctx, req.(*CreateFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_RenameFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).RenameFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_RenameFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).RenameFolder(ctx, req.(*RenameFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_MoveFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).MoveFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_MoveFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).MoveFolder(ctx, req.(*MoveFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_DeleteFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).DeleteFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_DeleteFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).DeleteFolder(ctx, req.(*DeleteFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FileService_ServiceDesc is the grpc.ServiceDesc for FileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -427,6 +1099,30 @@ var FileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFile",
 			Handler:    _FileService_DeleteFile_Handler,
 		},
+		{
+			MethodName: "UpdateFile",
+			Handler:    _FileService_UpdateFile_Handler,
+		},
+		{
+			MethodName: "AddFileTags",
+			Handler:    _FileService_AddFileTags_Handler,
+		},
+		{
+			MethodName: "RemoveFileTags",
+			Handler:    _FileService_RemoveFileTags_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _FileService_ListTags_Handler,
+		},
+		{
+			MethodName: "ListAdminActions",
+			Handler:    _FileService_ListAdminActions_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _FileService_GetUsage_Handler,
+		},
 		{
 			MethodName: "InitiateMultipartUpload",
 			Handler:    _FileService_InitiateMultipartUpload_Handler,
@@ -443,6 +1139,58 @@ var FileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AbortMultipartUpload",
 			Handler:    _FileService_AbortMultipartUpload_Handler,
 		},
+		{
+			MethodName: "ListMultipartUploads",
+			Handler:    _FileService_ListMultipartUploads_Handler,
+		},
+		{
+			MethodName: "ListParts",
+			Handler:    _FileService_ListParts_Handler,
+		},
+		{
+			MethodName: "CreateDownloadLink",
+			Handler:    _FileService_CreateDownloadLink_Handler,
+		},
+		{
+			MethodName: "ListDownloadLinks",
+			Handler:    _FileService_ListDownloadLinks_Handler,
+		},
+		{
+			MethodName: "RevokeDownloadLink",
+			Handler:    _FileService_RevokeDownloadLink_Handler,
+		},
+		{
+			MethodName: "ShareFile",
+			Handler:    _FileService_ShareFile_Handler,
+		},
+		{
+			MethodName: "ListShares",
+			Handler:    _FileService_ListShares_Handler,
+		},
+		{
+			MethodName: "RevokeShare",
+			Handler:    _FileService_RevokeShare_Handler,
+		},
+		{
+			MethodName: "ListSharedWithMe",
+			Handler:    _FileService_ListSharedWithMe_Handler,
+		},
+		{
+			MethodName: "CreateFolder",
+			Handler:    _FileService_CreateFolder_Handler,
+		},
+		{
+			MethodName: "RenameFolder",
+			Handler:    _FileService_RenameFolder_Handler,
+		},
+		{
+			MethodName: "MoveFolder",
+			Handler:    _FileService_MoveFolder_Handler,
+		},
+		{
+			MethodName: "DeleteFolder",
+			Handler:    _FileService_DeleteFolder_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -455,6 +1203,11 @@ var FileService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _FileService_DownloadFile_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "UploadPartStream",
+			Handler:       _FileService_UploadPartStream_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "file/v1/file.proto",
 }
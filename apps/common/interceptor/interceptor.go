@@ -0,0 +1,33 @@
+package interceptor
+
+import "google.golang.org/grpc"
+
+// ServerOptions returns the standard interceptor chain for a gRPC server:
+// request IDs first so every log line carries one, then logging, then panic
+// recovery closest to the handler so recovered panics are logged as Internal.
+// extra unary interceptors, such as authentication, run after recovery.
+func ServerOptions(extra ...grpc.UnaryServerInterceptor) []grpc.ServerOption {
+	unary := append([]grpc.UnaryServerInterceptor{
+		UnaryServerRequestID(),
+		UnaryServerLogging(),
+		UnaryServerRecovery(),
+	}, extra...)
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(
+			StreamServerRequestID(),
+			StreamServerLogging(),
+			StreamServerRecovery(),
+		),
+	}
+}
+
+// ClientOptions returns dial options that propagate the request ID to the
+// called service.
+func ClientOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(UnaryClientRequestID()),
+		grpc.WithChainStreamInterceptor(StreamClientRequestID()),
+	}
+}
@@ -0,0 +1,136 @@
+package interceptor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var unaryInfo = &grpc.UnaryServerInfo{FullMethod: "/test.v1.TestService/Do"}
+
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&buf, nil)))
+	t.Cleanup(func() { slog.SetDefault(prev) })
+	return &buf
+}
+
+func TestUnaryServerRecovery_ConvertsPanicToInternal(t *testing.T) {
+	captureLogs(t)
+
+	_, err := UnaryServerRecovery()(context.Background(), nil, unaryInfo, func(context.Context, interface{}) (interface{}, error) {
+		panic("boom")
+	})
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("expected Internal, got %v", err)
+	}
+}
+
+func TestStreamServerRecovery_ConvertsPanicToInternal(t *testing.T) {
+	captureLogs(t)
+
+	err := StreamServerRecovery()(nil, &fakeStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/test.v1.TestService/Stream"},
+		func(interface{}, grpc.ServerStream) error {
+			panic("boom")
+		})
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("expected Internal, got %v", err)
+	}
+}
+
+func TestUnaryServerRequestID_ReusesIncomingID(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, "req-123"))
+
+	var got string
+	_, _ = UnaryServerRequestID()(ctx, nil, unaryInfo, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		got = RequestIDFromContext(ctx)
+		return nil, nil
+	})
+	if got != "req-123" {
+		t.Fatalf("expected request ID req-123, got %q", got)
+	}
+}
+
+func TestUnaryServerRequestID_GeneratesWhenMissingOrInvalid(t *testing.T) {
+	for _, incoming := range []string{"", "has spaces", string(make([]byte, maxRequestIDLength+1))} {
+		ctx := context.Background()
+		if incoming != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(RequestIDHeader, incoming))
+		}
+
+		var got string
+		_, _ = UnaryServerRequestID()(ctx, nil, unaryInfo, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			got = RequestIDFromContext(ctx)
+			return nil, nil
+		})
+		if got == "" || got == incoming {
+			t.Fatalf("expected a generated request ID for %q, got %q", incoming, got)
+		}
+	}
+}
+
+func TestUnaryClientRequestID_PropagatesID(t *testing.T) {
+	ctx := WithRequestID(context.Background(), "req-456")
+
+	var got []string
+	err := UnaryClientRequestID()(ctx, "/test.v1.TestService/Do", nil, nil, nil,
+		func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			got = md.Get(RequestIDHeader)
+			return nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0] != "req-456" {
+		t.Fatalf("expected outgoing request ID req-456, got %v", got)
+	}
+}
+
+func TestUnaryServerLogging_LogsMethodCodeAndRequestID(t *testing.T) {
+	buf := captureLogs(t)
+	ctx := WithRequestID(context.Background(), "req-789")
+
+	_, _ = UnaryServerLogging()(ctx, nil, unaryInfo, func(context.Context, interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "missing")
+	})
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("expected one JSON log line, got %q: %v", buf.String(), err)
+	}
+	if entry["grpc.method"] != unaryInfo.FullMethod || entry["grpc.code"] != "NotFound" || entry["request_id"] != "req-789" {
+		t.Fatalf("unexpected log entry: %v", entry)
+	}
+	if _, ok := entry["duration_ms"]; !ok {
+		t.Fatalf("expected duration_ms in log entry: %v", entry)
+	}
+}
+
+func TestUnaryServerLogging_SkipsHealthChecks(t *testing.T) {
+	buf := captureLogs(t)
+
+	_, _ = UnaryServerLogging()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"},
+		func(context.Context, interface{}) (interface{}, error) { return nil, nil })
+	if buf.Len() != 0 {
+		t.Fatalf("expected no log output for health checks, got %q", buf.String())
+	}
+}
+
+type fakeStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (f *fakeStream) Context() context.Context {
+	return f.ctx
+}
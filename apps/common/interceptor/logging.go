@@ -0,0 +1,66 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// skipLogging lists services whose calls are too frequent to be worth logging.
+var skipLogging = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.",
+}
+
+func logCall(ctx context.Context, method string, start time.Time, err error) {
+	for _, prefix := range skipLogging {
+		if strings.HasPrefix(method, prefix) {
+			return
+		}
+	}
+
+	code := status.Code(err)
+	level := slog.LevelInfo
+	switch code {
+	case codes.OK, codes.NotFound, codes.InvalidArgument, codes.AlreadyExists, codes.Unauthenticated,
+		codes.PermissionDenied, codes.FailedPrecondition, codes.ResourceExhausted, codes.Canceled:
+	default:
+		level = slog.LevelError
+	}
+
+	attrs := []any{
+		"grpc.method", method,
+		"grpc.code", code.String(),
+		"duration_ms", float64(time.Since(start).Microseconds()) / 1000,
+		"request_id", RequestIDFromContext(ctx),
+	}
+	if err != nil {
+		attrs = append(attrs, "error", status.Convert(err).Message())
+	}
+	slog.Log(ctx, level, "grpc call", attrs...)
+}
+
+// UnaryServerLogging logs one line per call with its status code and duration.
+func UnaryServerLogging() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logCall(ctx, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerLogging logs one line per stream once it finishes.
+func StreamServerLogging() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logCall(ss.Context(), info.FullMethod, start, err)
+		return err
+	}
+}
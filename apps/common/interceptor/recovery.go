@@ -0,0 +1,41 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func recoverPanic(ctx context.Context, method string, err *error) {
+	if r := recover(); r != nil {
+		slog.ErrorContext(ctx, "grpc handler panicked",
+			"grpc.method", method,
+			"request_id", RequestIDFromContext(ctx),
+			"panic", r,
+			"stack", string(debug.Stack()),
+		)
+		*err = status.Error(codes.Internal, "internal server error")
+	}
+}
+
+// UnaryServerRecovery turns a panicking handler into an Internal error instead
+// of crashing the process.
+func UnaryServerRecovery() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer recoverPanic(ctx, info.FullMethod, &err)
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerRecovery turns a panicking stream handler into an Internal error
+// instead of crashing the process.
+func StreamServerRecovery() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer recoverPanic(ss.Context(), info.FullMethod, &err)
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,109 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDHeader is the HTTP header and gRPC metadata key carrying the
+// correlation ID of the request that started a call chain.
+const RequestIDHeader = "x-request-id"
+
+// maxRequestIDLength bounds caller-supplied IDs so they cannot bloat logs.
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying id.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID stored in ctx, if any.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// NewRequestID generates a fresh request ID.
+func NewRequestID() string {
+	return uuid.NewString()
+}
+
+// ValidRequestID reports whether a caller-supplied ID is safe to propagate.
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r < 0x21 || r > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// requestIDFromIncoming reuses the caller's request ID or mints a new one, and
+// echoes it back in the response header.
+func requestIDFromIncoming(ctx context.Context) context.Context {
+	id := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDHeader); len(values) > 0 && ValidRequestID(values[0]) {
+			id = values[0]
+		}
+	}
+	if id == "" {
+		id = NewRequestID()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id))
+	return WithRequestID(ctx, id)
+}
+
+// UnaryServerRequestID attaches the incoming request ID to the handler context.
+func UnaryServerRequestID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(requestIDFromIncoming(ctx), req)
+	}
+}
+
+// StreamServerRequestID attaches the incoming request ID to the stream context.
+func StreamServerRequestID() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: requestIDFromIncoming(ss.Context())})
+	}
+}
+
+func outgoingWithRequestID(ctx context.Context) context.Context {
+	id := RequestIDFromContext(ctx)
+	if id == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, RequestIDHeader, id)
+}
+
+// UnaryClientRequestID forwards the request ID in ctx to the called service.
+func UnaryClientRequestID() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingWithRequestID(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientRequestID forwards the request ID in ctx to the called service.
+func StreamClientRequestID() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingWithRequestID(ctx), desc, cc, method, opts...)
+	}
+}
+
+// wrappedStream overrides the context of a server stream.
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
@@ -0,0 +1,168 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Config controls how a service's logger is built.
+type Config struct {
+	ServiceName string
+	Environment string
+	// Level is one of debug, info, warn or error. Empty means info.
+	Level string
+	// Format is json or text. Empty means json.
+	Format string
+	// Output defaults to stdout.
+	Output io.Writer
+}
+
+// Redacted replaces the value of any attribute whose key looks sensitive.
+const Redacted = "[REDACTED]"
+
+// sensitiveKeys are matched case-insensitively as substrings of attribute keys,
+// so "hashed_password" and "authorization" are covered as well.
+var sensitiveKeys = []string{
+	"password",
+	"token",
+	"secret",
+	"authorization",
+	"cookie",
+	"api_key",
+	"apikey",
+}
+
+// ParseLevel maps a level name to a slog.Level.
+func ParseLevel(level string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "debug":
+		return slog.LevelDebug, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("logging: unknown level %q", level)
+	}
+}
+
+// New builds a logger that writes structured records tagged with the service
+// name, redacts sensitive attributes and adds trace_id/span_id from the context.
+// Records are also sent to the global OpenTelemetry logger provider, which
+// exports them once telemetry.InitTelemetry has configured a log exporter.
+func New(cfg Config) (*slog.Logger, error) {
+	level, err := ParseLevel(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	out := cfg.Output
+	if out == nil {
+		out = os.Stdout
+	}
+
+	opts := &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: redact,
+	}
+
+	var handler slog.Handler
+	switch strings.ToLower(cfg.Format) {
+	case "", "json":
+		handler = slog.NewJSONHandler(out, opts)
+	case "text":
+		handler = slog.NewTextHandler(out, opts)
+	default:
+		return nil, fmt.Errorf("logging: unknown format %q", cfg.Format)
+	}
+
+	logger := slog.New(fanoutHandler{
+		&traceHandler{Handler: handler},
+		&levelHandler{Handler: newGlobalOTelHandler(), level: level},
+	})
+	if cfg.ServiceName != "" {
+		logger = logger.With("service", cfg.ServiceName)
+	}
+	if cfg.Environment != "" {
+		logger = logger.With("environment", cfg.Environment)
+	}
+	return logger, nil
+}
+
+// Setup builds a logger with New and installs it as the slog default, which
+// also routes the standard log package through it.
+func Setup(cfg Config) (*slog.Logger, error) {
+	logger, err := New(cfg)
+	if err != nil {
+		return nil, err
+	}
+	slog.SetDefault(logger)
+	return logger, nil
+}
+
+func redact(groups []string, a slog.Attr) slog.Attr {
+	if isSensitive(a.Key) {
+		return slog.String(a.Key, Redacted)
+	}
+	return a
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// traceHandler adds the active span's IDs to every record logged with a context.
+type traceHandler struct {
+	slog.Handler
+}
+
+func (h *traceHandler) Handle(ctx context.Context, r slog.Record) error {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(
+			slog.String("trace_id", sc.TraceID().String()),
+			slog.String("span_id", sc.SpanID().String()),
+		)
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *traceHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &traceHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *traceHandler) WithGroup(name string) slog.Handler {
+	return &traceHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+// levelHandler applies the configured minimum level to a handler that has no
+// level option of its own.
+type levelHandler struct {
+	slog.Handler
+	level slog.Level
+}
+
+func (h *levelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.level && h.Handler.Enabled(ctx, level)
+}
+
+func (h *levelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &levelHandler{Handler: h.Handler.WithAttrs(attrs), level: h.level}
+}
+
+func (h *levelHandler) WithGroup(name string) slog.Handler {
+	return &levelHandler{Handler: h.Handler.WithGroup(name), level: h.level}
+}
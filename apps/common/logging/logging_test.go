@@ -0,0 +1,108 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func decode(t *testing.T, buf *bytes.Buffer) map[string]interface{} {
+	t.Helper()
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("expected a JSON log line, got %q: %v", buf.String(), err)
+	}
+	return entry
+}
+
+func TestNew_WritesJSONWithServiceFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(Config{ServiceName: "user-service", Environment: "test", Output: &buf})
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	logger.Info("hello", "user_id", "42")
+
+	entry := decode(t, &buf)
+	if entry["msg"] != "hello" || entry["service"] != "user-service" || entry["environment"] != "test" || entry["user_id"] != "42" {
+		t.Fatalf("unexpected log entry: %v", entry)
+	}
+}
+
+func TestNew_RedactsSensitiveFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(Config{Output: &buf})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger.Info("login", "username", "alice", "password", "hunter2", "hashed_password", "$2a$", "Authorization", "Bearer x",
+		slog.Group("req", "token", "abc"))
+
+	entry := decode(t, &buf)
+	for _, key := range []string{"password", "hashed_password", "Authorization"} {
+		if entry[key] != Redacted {
+			t.Fatalf("expected %s to be redacted, got %v", key, entry[key])
+		}
+	}
+	if group, _ := entry["req"].(map[string]interface{}); group["token"] != Redacted {
+		t.Fatalf("expected nested token to be redacted, got %v", entry["req"])
+	}
+	if entry["username"] != "alice" {
+		t.Fatalf("expected username to be kept, got %v", entry["username"])
+	}
+}
+
+func TestNew_RespectsLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(Config{Level: "warn", Output: &buf})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger.Info("dropped")
+	if buf.Len() != 0 {
+		t.Fatalf("expected info to be filtered at warn level, got %q", buf.String())
+	}
+	logger.Warn("kept")
+	if decode(t, &buf)["level"] != "WARN" {
+		t.Fatalf("expected WARN entry, got %q", buf.String())
+	}
+}
+
+func TestNew_AddsTraceAndSpanIDs(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(Config{Output: &buf})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	traceID, _ := trace.TraceIDFromHex("0102030405060708090a0b0c0d0e0f10")
+	spanID, _ := trace.SpanIDFromHex("0102030405060708")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	logger.InfoContext(ctx, "traced")
+
+	entry := decode(t, &buf)
+	if entry["trace_id"] != traceID.String() || entry["span_id"] != spanID.String() {
+		t.Fatalf("expected trace correlation fields, got %v", entry)
+	}
+}
+
+func TestNew_RejectsUnknownLevelAndFormat(t *testing.T) {
+	if _, err := New(Config{Level: "verbose"}); err == nil {
+		t.Fatal("expected error for unknown level")
+	}
+	if _, err := New(Config{Format: "xml"}); err == nil {
+		t.Fatal("expected error for unknown format")
+	}
+}
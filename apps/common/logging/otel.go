@@ -0,0 +1,187 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+)
+
+const instrumentationScope = "github.com/provsalt/DOP_P01_Team1/common/logging"
+
+// otelHandler forwards slog records to an OpenTelemetry logger so they are
+// exported alongside traces and metrics. Records are emitted with the caller's
+// context, which lets the SDK attach the active trace and span IDs.
+type otelHandler struct {
+	logger otellog.Logger
+	attrs  []otellog.KeyValue
+	groups []string
+}
+
+func newOTelHandler(provider otellog.LoggerProvider) *otelHandler {
+	return &otelHandler{logger: provider.Logger(instrumentationScope)}
+}
+
+// newGlobalOTelHandler uses the global provider, which delegates to whatever
+// telemetry.InitTelemetry installs later and drops records until then.
+func newGlobalOTelHandler() *otelHandler {
+	return newOTelHandler(global.GetLoggerProvider())
+}
+
+func (h *otelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.logger.Enabled(ctx, otellog.EnabledParameters{Severity: severity(level)})
+}
+
+func (h *otelHandler) Handle(ctx context.Context, r slog.Record) error {
+	var record otellog.Record
+	record.SetTimestamp(r.Time)
+	record.SetObservedTimestamp(time.Now())
+	record.SetSeverity(severity(r.Level))
+	record.SetSeverityText(r.Level.String())
+	record.SetBody(otellog.StringValue(r.Message))
+
+	attrs := make([]otellog.KeyValue, 0, len(h.attrs)+r.NumAttrs())
+	attrs = append(attrs, h.attrs...)
+	r.Attrs(func(a slog.Attr) bool {
+		if kv, ok := convertAttr(h.groups, a); ok {
+			attrs = append(attrs, kv)
+		}
+		return true
+	})
+	record.AddAttributes(attrs...)
+
+	h.logger.Emit(ctx, record)
+	return nil
+}
+
+func (h *otelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	next := *h
+	next.attrs = append(append([]otellog.KeyValue(nil), h.attrs...), convertAttrs(h.groups, attrs)...)
+	return &next
+}
+
+func (h *otelHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	next := *h
+	next.groups = append(append([]string(nil), h.groups...), name)
+	return &next
+}
+
+func severity(level slog.Level) otellog.Severity {
+	switch {
+	case level >= slog.LevelError:
+		return otellog.SeverityError
+	case level >= slog.LevelWarn:
+		return otellog.SeverityWarn
+	case level >= slog.LevelInfo:
+		return otellog.SeverityInfo
+	default:
+		return otellog.SeverityDebug
+	}
+}
+
+func convertAttrs(groups []string, attrs []slog.Attr) []otellog.KeyValue {
+	out := make([]otellog.KeyValue, 0, len(attrs))
+	for _, a := range attrs {
+		if kv, ok := convertAttr(groups, a); ok {
+			out = append(out, kv)
+		}
+	}
+	return out
+}
+
+// convertAttr applies the same redaction as the local handler and prefixes the
+// key with any open groups, matching how the JSON handler nests them.
+func convertAttr(groups []string, a slog.Attr) (otellog.KeyValue, bool) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return otellog.KeyValue{}, false
+	}
+
+	key := a.Key
+	for i := len(groups) - 1; i >= 0; i-- {
+		key = groups[i] + "." + key
+	}
+	if isSensitive(a.Key) {
+		return otellog.String(key, Redacted), true
+	}
+	return otellog.KeyValue{Key: key, Value: convertValue(a.Value)}, true
+}
+
+func convertValue(v slog.Value) otellog.Value {
+	switch v.Kind() {
+	case slog.KindString:
+		return otellog.StringValue(v.String())
+	case slog.KindInt64:
+		return otellog.Int64Value(v.Int64())
+	case slog.KindUint64:
+		return otellog.Int64Value(int64(v.Uint64()))
+	case slog.KindFloat64:
+		return otellog.Float64Value(v.Float64())
+	case slog.KindBool:
+		return otellog.BoolValue(v.Bool())
+	case slog.KindDuration:
+		return otellog.StringValue(v.Duration().String())
+	case slog.KindTime:
+		return otellog.StringValue(v.Time().Format(time.RFC3339Nano))
+	case slog.KindGroup:
+		kvs := make([]otellog.KeyValue, 0, len(v.Group()))
+		for _, a := range v.Group() {
+			if kv, ok := convertAttr(nil, a); ok {
+				kvs = append(kvs, kv)
+			}
+		}
+		return otellog.MapValue(kvs...)
+	default:
+		if err, ok := v.Any().(error); ok {
+			return otellog.StringValue(err.Error())
+		}
+		return otellog.StringValue(fmt.Sprint(v.Any()))
+	}
+}
+
+// fanoutHandler sends each record to every handler that accepts its level.
+type fanoutHandler []slog.Handler
+
+func (f fanoutHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, h := range f {
+		if h.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f fanoutHandler) Handle(ctx context.Context, r slog.Record) error {
+	var firstErr error
+	for _, h := range f {
+		if !h.Enabled(ctx, r.Level) {
+			continue
+		}
+		if err := h.Handle(ctx, r.Clone()); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (f fanoutHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	out := make(fanoutHandler, len(f))
+	for i, h := range f {
+		out[i] = h.WithAttrs(attrs)
+	}
+	return out
+}
+
+func (f fanoutHandler) WithGroup(name string) slog.Handler {
+	out := make(fanoutHandler, len(f))
+	for i, h := range f {
+		out[i] = h.WithGroup(name)
+	}
+	return out
+}
@@ -0,0 +1,101 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+
+	otellog "go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type recordingExporter struct {
+	mu      sync.Mutex
+	records []sdklog.Record
+}
+
+func (e *recordingExporter) Export(_ context.Context, records []sdklog.Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, r := range records {
+		e.records = append(e.records, r.Clone())
+	}
+	return nil
+}
+
+func (e *recordingExporter) Shutdown(context.Context) error   { return nil }
+func (e *recordingExporter) ForceFlush(context.Context) error { return nil }
+
+func newRecordingLogger(t *testing.T) (*slog.Logger, *recordingExporter) {
+	t.Helper()
+	exporter := &recordingExporter{}
+	provider := sdklog.NewLoggerProvider(sdklog.WithProcessor(sdklog.NewSimpleProcessor(exporter)))
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+	return slog.New(newOTelHandler(provider)), exporter
+}
+
+func attrs(r sdklog.Record) map[string]otellog.Value {
+	out := make(map[string]otellog.Value)
+	r.WalkAttributes(func(kv otellog.KeyValue) bool {
+		out[kv.Key] = kv.Value
+		return true
+	})
+	return out
+}
+
+func TestOTelHandler_EmitsRecord(t *testing.T) {
+	logger, exporter := newRecordingLogger(t)
+
+	traceID, _ := trace.TraceIDFromHex("0102030405060708090a0b0c0d0e0f10")
+	spanID, _ := trace.SpanIDFromHex("0102030405060708")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	logger.With("service", "user-service").WithGroup("req").
+		ErrorContext(ctx, "lookup failed", "user_id", 42, "password", "hunter2", "err", errors.New("boom"))
+
+	if len(exporter.records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(exporter.records))
+	}
+	r := exporter.records[0]
+	if r.Body().AsString() != "lookup failed" || r.Severity() != otellog.SeverityError || r.SeverityText() != "ERROR" {
+		t.Fatalf("unexpected record: body=%q severity=%v text=%q", r.Body().AsString(), r.Severity(), r.SeverityText())
+	}
+	if r.TraceID() != traceID || r.SpanID() != spanID {
+		t.Fatalf("expected trace correlation, got trace=%s span=%s", r.TraceID(), r.SpanID())
+	}
+
+	got := attrs(r)
+	if got["service"].AsString() != "user-service" {
+		t.Fatalf("expected service attribute, got %v", got)
+	}
+	if got["req.user_id"].AsInt64() != 42 || got["req.err"].AsString() != "boom" {
+		t.Fatalf("expected grouped attributes, got %v", got)
+	}
+	if got["req.password"].AsString() != Redacted {
+		t.Fatalf("expected password to be redacted, got %v", got["req.password"])
+	}
+}
+
+func TestNew_ForwardsToOTelAtConfiguredLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(Config{Level: "warn", Output: &buf})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler, ok := logger.Handler().(fanoutHandler)
+	if !ok || len(handler) != 2 {
+		t.Fatalf("expected stdout and OpenTelemetry handlers, got %T", logger.Handler())
+	}
+	if handler[1].Enabled(context.Background(), slog.LevelInfo) {
+		t.Fatal("expected info records to be filtered before reaching OpenTelemetry")
+	}
+}
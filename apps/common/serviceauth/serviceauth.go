@@ -0,0 +1,114 @@
+package serviceauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// MetadataKey is the gRPC metadata key that carries the service token.
+const MetadataKey = "x-service-token"
+
+const (
+	tokenTTL     = 5 * time.Minute
+	refreshAfter = tokenTTL - time.Minute
+)
+
+// Signer mints short-lived HS256 tokens naming the calling service and the
+// service it is calling. It implements credentials.PerRPCCredentials so it can
+// be passed to grpc.WithPerRPCCredentials.
+type Signer struct {
+	service  string
+	audience string
+	secret   []byte
+
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+// NewSigner returns a Signer that identifies as service when calling audience.
+func NewSigner(service, audience, secret string) *Signer {
+	return &Signer{service: service, audience: audience, secret: []byte(secret)}
+}
+
+// Token returns a cached token, minting a new one shortly before it expires.
+func (s *Signer) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.token != "" && now.Sub(s.issuedAt) < refreshAfter {
+		return s.token, nil
+	}
+
+	claims := jwt.RegisteredClaims{
+		Issuer:    s.service,
+		Subject:   s.service,
+		Audience:  jwt.ClaimStrings{s.audience},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(tokenTTL)),
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+	if err != nil {
+		return "", fmt.Errorf("serviceauth: sign token: %w", err)
+	}
+
+	s.token, s.issuedAt = token, now
+	return token, nil
+}
+
+func (s *Signer) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	token, err := s.Token()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{MetadataKey: token}, nil
+}
+
+// RequireTransportSecurity is false so tokens still flow when TLS is disabled
+// in local setups; production deployments should enable TLS alongside them.
+func (s *Signer) RequireTransportSecurity() bool {
+	return false
+}
+
+// VerifyToken checks a service token's signature, expiry and audience and
+// returns the name of the service that minted it.
+func VerifyToken(tokenString, audience string, secret []byte) (string, error) {
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(*jwt.Token) (interface{}, error) {
+		return secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithAudience(audience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return "", fmt.Errorf("serviceauth: invalid token: %w", err)
+	}
+	if claims.Subject == "" {
+		return "", errors.New("serviceauth: token has no subject")
+	}
+	return claims.Subject, nil
+}
+
+// PeerIdentity returns the common name of the caller's client certificate when
+// the connection is mTLS and the certificate chain was verified.
+func PeerIdentity(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	name := info.State.VerifiedChains[0][0].Subject.CommonName
+	return name, name != ""
+}
@@ -0,0 +1,62 @@
+package serviceauth
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSigner_TokenRoundTrip(t *testing.T) {
+	signer := NewSigner("auth-service", "user-service", "secret")
+
+	token, err := signer.Token()
+	if err != nil {
+		t.Fatalf("Token returned error: %v", err)
+	}
+
+	service, err := VerifyToken(token, "user-service", []byte("secret"))
+	if err != nil {
+		t.Fatalf("VerifyToken returned error: %v", err)
+	}
+	if service != "auth-service" {
+		t.Fatalf("expected auth-service, got %q", service)
+	}
+}
+
+func TestSigner_CachesToken(t *testing.T) {
+	signer := NewSigner("auth-service", "user-service", "secret")
+
+	first, _ := signer.Token()
+	second, _ := signer.Token()
+	if first != second {
+		t.Fatal("expected the cached token to be reused")
+	}
+}
+
+func TestSigner_GetRequestMetadata(t *testing.T) {
+	signer := NewSigner("api-gateway", "user-service", "secret")
+
+	md, err := signer.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatalf("GetRequestMetadata returned error: %v", err)
+	}
+	if md[MetadataKey] == "" {
+		t.Fatalf("expected %s in metadata, got %v", MetadataKey, md)
+	}
+}
+
+func TestVerifyToken_RejectsWrongSecretAndAudience(t *testing.T) {
+	token, _ := NewSigner("auth-service", "user-service", "secret").Token()
+
+	if _, err := VerifyToken(token, "user-service", []byte("other")); err == nil {
+		t.Fatal("expected error for wrong secret")
+	}
+	if _, err := VerifyToken(token, "file-service", []byte("secret")); err == nil {
+		t.Fatal("expected error for wrong audience")
+	}
+}
+
+func TestPeerIdentity_NoPeer(t *testing.T) {
+	if _, ok := PeerIdentity(context.Background()); ok {
+		t.Fatal("expected no identity without a peer")
+	}
+}
@@ -0,0 +1,271 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Exporter names accepted in Config.Exporter and OTEL_{TRACES,METRICS,LOGS}_EXPORTER.
+const (
+	ExporterOTLP    = "otlp"
+	ExporterConsole = "console"
+	ExporterNone    = "none"
+)
+
+// OTLP transport protocols accepted in Config.Protocol and OTEL_EXPORTER_OTLP_PROTOCOL.
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http/protobuf"
+)
+
+func normalizeExporter(name string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case ExporterOTLP:
+		return ExporterOTLP, nil
+	case ExporterConsole, "stdout":
+		return ExporterConsole, nil
+	case ExporterNone:
+		return ExporterNone, nil
+	default:
+		return "", fmt.Errorf("telemetry: unsupported exporter %q", name)
+	}
+}
+
+// defaultExporter applies to every signal when nothing names an exporter
+// explicitly: OTLP if there is somewhere to send it, otherwise nothing.
+func (c Config) defaultExporter() string {
+	if c.Token != "" || c.Endpoint != "" ||
+		os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" ||
+		os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != "" ||
+		os.Getenv("OTEL_EXPORTER_OTLP_METRICS_ENDPOINT") != "" {
+		return ExporterOTLP
+	}
+	return ExporterNone
+}
+
+func (c Config) signalExporter(envVar string) (string, error) {
+	if c.Exporter != "" {
+		return normalizeExporter(c.Exporter)
+	}
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return ExporterNone, nil
+	}
+	if v := os.Getenv(envVar); v != "" {
+		return normalizeExporter(v)
+	}
+	return c.defaultExporter(), nil
+}
+
+func (c Config) tracesExporter() (string, error) {
+	return c.signalExporter("OTEL_TRACES_EXPORTER")
+}
+
+func (c Config) metricsExporter() (string, error) {
+	return c.signalExporter("OTEL_METRICS_EXPORTER")
+}
+
+func (c Config) logsExporter() (string, error) {
+	return c.signalExporter("OTEL_LOGS_EXPORTER")
+}
+
+func (c Config) protocol(signalEnvVar string) (string, error) {
+	protocol := c.Protocol
+	if protocol == "" {
+		protocol = os.Getenv(signalEnvVar)
+	}
+	if protocol == "" {
+		protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+	}
+	switch strings.ToLower(protocol) {
+	case "", ProtocolHTTP, "http":
+		return ProtocolHTTP, nil
+	case ProtocolGRPC:
+		return ProtocolGRPC, nil
+	default:
+		return "", fmt.Errorf("telemetry: unsupported OTLP protocol %q", protocol)
+	}
+}
+
+// endpoint returns the configured endpoint and whether it is a full URL.
+func (c Config) endpoint() (string, bool) {
+	endpoint := c.Endpoint
+	if endpoint == "" && c.Token != "" {
+		endpoint = defaultAxiomEndpoint
+	}
+	return endpoint, strings.Contains(endpoint, "://")
+}
+
+// headers merges Config.Headers with the Axiom auth headers for dataset. A nil
+// result leaves OTEL_EXPORTER_OTLP_HEADERS in effect.
+func (c Config) headers(dataset string) map[string]string {
+	if len(c.Headers) == 0 && c.Token == "" {
+		return nil
+	}
+	headers := make(map[string]string, len(c.Headers)+2)
+	for k, v := range c.Headers {
+		headers[k] = v
+	}
+	if c.Token != "" {
+		headers["Authorization"] = "Bearer " + c.Token
+		headers["X-AXIOM-DATASET"] = dataset
+	}
+	return headers
+}
+
+func (c Config) newTraceExporter(ctx context.Context, kind string) (sdktrace.SpanExporter, error) {
+	if kind == ExporterConsole {
+		return stdouttrace.New()
+	}
+
+	protocol, err := c.protocol("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+	if err != nil {
+		return nil, err
+	}
+	endpoint, isURL := c.endpoint()
+	headers := c.headers(c.Dataset)
+
+	if protocol == ProtocolGRPC {
+		var opts []otlptracegrpc.Option
+		switch {
+		case isURL:
+			opts = append(opts, otlptracegrpc.WithEndpointURL(endpoint))
+		case endpoint != "":
+			opts = append(opts, otlptracegrpc.WithEndpoint(endpoint))
+		}
+		if c.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		if headers != nil {
+			opts = append(opts, otlptracegrpc.WithHeaders(headers))
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	}
+
+	var opts []otlptracehttp.Option
+	switch {
+	case isURL:
+		opts = append(opts, otlptracehttp.WithEndpointURL(endpoint))
+	case endpoint != "":
+		opts = append(opts, otlptracehttp.WithEndpoint(endpoint))
+	}
+	if c.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	if headers != nil {
+		opts = append(opts, otlptracehttp.WithHeaders(headers))
+	}
+	return otlptracehttp.New(ctx, opts...)
+}
+
+func (c Config) newMetricExporter(ctx context.Context, kind string) (sdkmetric.Exporter, error) {
+	if kind == ExporterConsole {
+		return stdoutmetric.New()
+	}
+
+	protocol, err := c.protocol("OTEL_EXPORTER_OTLP_METRICS_PROTOCOL")
+	if err != nil {
+		return nil, err
+	}
+	endpoint, isURL := c.endpoint()
+	metricsDataset := c.MetricsDataset
+	if metricsDataset == "" {
+		metricsDataset = "metrics"
+	}
+	headers := c.headers(metricsDataset)
+
+	if protocol == ProtocolGRPC {
+		var opts []otlpmetricgrpc.Option
+		switch {
+		case isURL:
+			opts = append(opts, otlpmetricgrpc.WithEndpointURL(endpoint))
+		case endpoint != "":
+			opts = append(opts, otlpmetricgrpc.WithEndpoint(endpoint))
+		}
+		if c.Insecure {
+			opts = append(opts, otlpmetricgrpc.WithInsecure())
+		}
+		if headers != nil {
+			opts = append(opts, otlpmetricgrpc.WithHeaders(headers))
+		}
+		return otlpmetricgrpc.New(ctx, opts...)
+	}
+
+	var opts []otlpmetrichttp.Option
+	switch {
+	case isURL:
+		opts = append(opts, otlpmetrichttp.WithEndpointURL(endpoint))
+	case endpoint != "":
+		opts = append(opts, otlpmetrichttp.WithEndpoint(endpoint))
+	}
+	if c.Insecure {
+		opts = append(opts, otlpmetrichttp.WithInsecure())
+	}
+	if headers != nil {
+		opts = append(opts, otlpmetrichttp.WithHeaders(headers))
+	}
+	return otlpmetrichttp.New(ctx, opts...)
+}
+
+func (c Config) newLogExporter(ctx context.Context, kind string) (sdklog.Exporter, error) {
+	if kind == ExporterConsole {
+		return stdoutlog.New()
+	}
+
+	protocol, err := c.protocol("OTEL_EXPORTER_OTLP_LOGS_PROTOCOL")
+	if err != nil {
+		return nil, err
+	}
+	endpoint, isURL := c.endpoint()
+	logsDataset := c.LogsDataset
+	if logsDataset == "" {
+		logsDataset = c.Dataset
+	}
+	headers := c.headers(logsDataset)
+
+	if protocol == ProtocolGRPC {
+		var opts []otlploggrpc.Option
+		switch {
+		case isURL:
+			opts = append(opts, otlploggrpc.WithEndpointURL(endpoint))
+		case endpoint != "":
+			opts = append(opts, otlploggrpc.WithEndpoint(endpoint))
+		}
+		if c.Insecure {
+			opts = append(opts, otlploggrpc.WithInsecure())
+		}
+		if headers != nil {
+			opts = append(opts, otlploggrpc.WithHeaders(headers))
+		}
+		return otlploggrpc.New(ctx, opts...)
+	}
+
+	var opts []otlploghttp.Option
+	switch {
+	case isURL:
+		opts = append(opts, otlploghttp.WithEndpointURL(endpoint))
+	case endpoint != "":
+		opts = append(opts, otlploghttp.WithEndpoint(endpoint))
+	}
+	if c.Insecure {
+		opts = append(opts, otlploghttp.WithInsecure())
+	}
+	if headers != nil {
+		opts = append(opts, otlploghttp.WithHeaders(headers))
+	}
+	return otlploghttp.New(ctx, opts...)
+}
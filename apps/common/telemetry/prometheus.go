@@ -0,0 +1,39 @@
+package telemetry
+
+import (
+	"net/http"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	otelprom "go.opentelemetry.io/otel/exporters/prometheus"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// promRegistry holds the registry of the most recent InitTelemetry call with
+// Prometheus enabled. A fresh registry per call avoids duplicate registration
+// errors when telemetry is initialised more than once, e.g. in tests.
+var promRegistry atomic.Pointer[prometheus.Registry]
+
+func newPrometheusReader() (sdkmetric.Reader, error) {
+	registry := prometheus.NewRegistry()
+	reader, err := otelprom.New(otelprom.WithRegisterer(registry))
+	if err != nil {
+		return nil, err
+	}
+	promRegistry.Store(registry)
+	return reader, nil
+}
+
+// MetricsHandler serves the meter provider's metrics in the Prometheus text
+// format. It responds 404 until InitTelemetry has run with Prometheus enabled.
+func MetricsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		registry := promRegistry.Load()
+		if registry == nil {
+			http.NotFound(w, r)
+			return
+		}
+		promhttp.HandlerFor(registry, promhttp.HandlerOpts{}).ServeHTTP(w, r)
+	})
+}
@@ -2,118 +2,240 @@ package telemetry
 
 import (
 	"context"
-	"crypto/tls"
 	"errors"
+	"log/slog"
 	"net/http"
+	"time"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/contrib/instrumentation/runtime"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/log/global"
 	"go.opentelemetry.io/otel/propagation"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
 )
 
-// Config holds the configuration for initializing the tracer.
+// Config holds the configuration for initializing tracing, metrics and logs.
+//
+// Every field is optional apart from ServiceName. Unset fields fall back to the
+// standard OTEL_* environment variables and then to the SDK defaults, so a
+// service can be pointed at any OTLP backend without code changes.
 type Config struct {
-	ServiceName    string
-	Environment    string
-	Endpoint       string
+	ServiceName string
+	Environment string
+
+	// Exporter is otlp, console or none. Empty uses OTEL_TRACES_EXPORTER, then
+	// otlp when an endpoint or Axiom token is configured, then none.
+	Exporter string
+	// Protocol is grpc or http/protobuf. Empty uses OTEL_EXPORTER_OTLP_PROTOCOL,
+	// then http/protobuf.
+	Protocol string
+	// Endpoint is a host:port or URL. Empty uses OTEL_EXPORTER_OTLP_ENDPOINT.
+	Endpoint string
+	// Insecure disables TLS to a host:port Endpoint, e.g. a local collector.
+	Insecure bool
+	// Headers are sent with every export request.
+	Headers map[string]string
+
+	// Token and Dataset send telemetry to Axiom. MetricsDataset defaults to
+	// "metrics" and LogsDataset to Dataset.
 	Token          string
 	Dataset        string
 	MetricsDataset string
+	LogsDataset    string
+
+	// SampleRatio is the fraction of new traces recorded. Zero leaves sampling
+	// to OTEL_TRACES_SAMPLER, which defaults to recording everything.
+	SampleRatio float64
+	// Batch settings for spans and log records. Zero values use OTEL_BSP_*,
+	// OTEL_BLRP_* or SDK defaults.
+	BatchTimeout       time.Duration
+	MaxExportBatchSize int
+	MaxQueueSize       int
+	// Prometheus adds a pull exporter to the meter provider, served by
+	// MetricsHandler. It works alongside any push exporter.
+	Prometheus bool
+	// MetricInterval is how often metrics are exported. Zero uses
+	// OTEL_METRIC_EXPORT_INTERVAL or the SDK default.
+	MetricInterval time.Duration
+	// ResourceAttributes are added to every span, metric and log record. OTEL_RESOURCE_ATTRIBUTES
+	// is also honoured and takes precedence.
+	ResourceAttributes map[string]string
 }
 
-// InitTelemetry initializes OpenTelemetry tracing and metrics with Axiom as the backend.
+const defaultAxiomEndpoint = "us-east-1.aws.edge.axiom.co"
+
+// InitTelemetry initializes OpenTelemetry tracing, metrics and logs. Log records
+// come from the logging package, which bridges slog into the global logger
+// provider installed here. When no exporter is configured it only installs the
+// propagators and leaves the global no-op providers in place.
 // Returns a shutdown function that should be deferred in main().
 func InitTelemetry(ctx context.Context, cfg Config) (func(context.Context) error, error) {
 	if cfg.ServiceName == "" {
 		return nil, errors.New("telemetry: ServiceName is required")
 	}
-	if cfg.Token == "" {
-		return nil, errors.New("telemetry: Token is required")
-	}
-	if cfg.Dataset == "" {
-		return nil, errors.New("telemetry: Dataset is required")
-	}
-
-	endpoint := cfg.Endpoint
-	if endpoint == "" {
-		endpoint = "us-east-1.aws.edge.axiom.co"
+	if cfg.Token != "" || cfg.Dataset != "" {
+		if cfg.Token == "" {
+			return nil, errors.New("telemetry: Token is required")
+		}
+		if cfg.Dataset == "" {
+			return nil, errors.New("telemetry: Dataset is required")
+		}
 	}
 
-	environment := cfg.Environment
-	if environment == "" {
-		environment = "development"
-	}
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
 
-	metricsDataset := cfg.MetricsDataset
-	if metricsDataset == "" {
-		metricsDataset = "metrics"
+	traceKind, err := cfg.tracesExporter()
+	if err != nil {
+		return nil, err
 	}
-
-	traceExporter, err := otlptracehttp.New(ctx,
-		otlptracehttp.WithEndpoint(endpoint),
-		otlptracehttp.WithHeaders(map[string]string{
-			"Authorization":   "Bearer " + cfg.Token,
-			"X-AXIOM-DATASET": cfg.Dataset,
-		}),
-		otlptracehttp.WithTLSClientConfig(&tls.Config{}),
-	)
+	metricKind, err := cfg.metricsExporter()
 	if err != nil {
 		return nil, err
 	}
-
-	metricExporter, err := otlpmetrichttp.New(ctx,
-		otlpmetrichttp.WithEndpoint(endpoint),
-		otlpmetrichttp.WithHeaders(map[string]string{
-			"Authorization":   "Bearer " + cfg.Token,
-			"X-AXIOM-DATASET": metricsDataset,
-		}),
-		otlpmetrichttp.WithTLSClientConfig(&tls.Config{}),
-	)
+	logKind, err := cfg.logsExporter()
 	if err != nil {
 		return nil, err
 	}
+	slog.Info("Telemetry configured", "traces_exporter", traceKind, "metrics_exporter", metricKind, "logs_exporter", logKind, "prometheus", cfg.Prometheus)
 
-	res, err := resource.New(ctx,
-		resource.WithAttributes(
-			semconv.ServiceNameKey.String(cfg.ServiceName),
-			attribute.String("environment", environment),
-		),
-	)
+	if traceKind == ExporterNone && metricKind == ExporterNone && logKind == ExporterNone && !cfg.Prometheus {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	res, err := cfg.resource(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	tp := sdktrace.NewTracerProvider(
-		sdktrace.WithBatcher(traceExporter),
-		sdktrace.WithResource(res),
-	)
+	var shutdowns []func(context.Context) error
+	shutdown := func(ctx context.Context) error {
+		var errs []error
+		for _, fn := range shutdowns {
+			errs = append(errs, fn(ctx))
+		}
+		return errors.Join(errs...)
+	}
 
-	mp := sdkmetric.NewMeterProvider(
-		sdkmetric.WithResource(res),
-		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)),
-	)
+	if traceKind != ExporterNone {
+		exporter, err := cfg.newTraceExporter(ctx, traceKind)
+		if err != nil {
+			return nil, err
+		}
+		tp := sdktrace.NewTracerProvider(cfg.tracerOptions(exporter, res)...)
+		otel.SetTracerProvider(tp)
+		shutdowns = append(shutdowns, tp.Shutdown)
+		http.DefaultTransport = otelhttp.NewTransport(http.DefaultTransport)
+	}
 
-	otel.SetTracerProvider(tp)
-	otel.SetMeterProvider(mp)
-	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
-		propagation.TraceContext{},
-		propagation.Baggage{},
-	))
+	if metricKind != ExporterNone || cfg.Prometheus {
+		meterOpts := []sdkmetric.Option{sdkmetric.WithResource(res)}
+		if metricKind != ExporterNone {
+			exporter, err := cfg.newMetricExporter(ctx, metricKind)
+			if err != nil {
+				return nil, errors.Join(err, shutdown(ctx))
+			}
+			var readerOpts []sdkmetric.PeriodicReaderOption
+			if cfg.MetricInterval > 0 {
+				readerOpts = append(readerOpts, sdkmetric.WithInterval(cfg.MetricInterval))
+			}
+			meterOpts = append(meterOpts, sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter, readerOpts...)))
+		}
+		if cfg.Prometheus {
+			reader, err := newPrometheusReader()
+			if err != nil {
+				return nil, errors.Join(err, shutdown(ctx))
+			}
+			meterOpts = append(meterOpts, sdkmetric.WithReader(reader))
+		}
+		mp := sdkmetric.NewMeterProvider(meterOpts...)
+		otel.SetMeterProvider(mp)
+		shutdowns = append(shutdowns, mp.Shutdown)
+
+		if err := runtime.Start(runtime.WithMeterProvider(mp)); err != nil {
+			return nil, errors.Join(err, shutdown(ctx))
+		}
+	}
 
-	http.DefaultTransport = otelhttp.NewTransport(http.DefaultTransport)
-	if err := runtime.Start(runtime.WithMeterProvider(mp)); err != nil {
-		return nil, errors.Join(err, tp.Shutdown(ctx), mp.Shutdown(ctx))
+	// The logger provider is shut down last so records logged while the other
+	// providers flush are still exported.
+	if logKind != ExporterNone {
+		exporter, err := cfg.newLogExporter(ctx, logKind)
+		if err != nil {
+			return nil, errors.Join(err, shutdown(ctx))
+		}
+		lp := sdklog.NewLoggerProvider(
+			sdklog.WithResource(res),
+			sdklog.WithProcessor(sdklog.NewBatchProcessor(exporter, cfg.logBatchOptions()...)),
+		)
+		global.SetLoggerProvider(lp)
+		shutdowns = append(shutdowns, lp.Shutdown)
 	}
 
-	return func(ctx context.Context) error {
-		return errors.Join(tp.Shutdown(ctx), mp.Shutdown(ctx))
-	}, nil
+	return shutdown, nil
+}
+
+func (c Config) logBatchOptions() []sdklog.BatchProcessorOption {
+	var opts []sdklog.BatchProcessorOption
+	if c.BatchTimeout > 0 {
+		opts = append(opts, sdklog.WithExportInterval(c.BatchTimeout))
+	}
+	if c.MaxExportBatchSize > 0 {
+		opts = append(opts, sdklog.WithExportMaxBatchSize(c.MaxExportBatchSize))
+	}
+	if c.MaxQueueSize > 0 {
+		opts = append(opts, sdklog.WithMaxQueueSize(c.MaxQueueSize))
+	}
+	return opts
+}
+
+func (c Config) tracerOptions(exporter sdktrace.SpanExporter, res *resource.Resource) []sdktrace.TracerProviderOption {
+	var batchOpts []sdktrace.BatchSpanProcessorOption
+	if c.BatchTimeout > 0 {
+		batchOpts = append(batchOpts, sdktrace.WithBatchTimeout(c.BatchTimeout))
+	}
+	if c.MaxExportBatchSize > 0 {
+		batchOpts = append(batchOpts, sdktrace.WithMaxExportBatchSize(c.MaxExportBatchSize))
+	}
+	if c.MaxQueueSize > 0 {
+		batchOpts = append(batchOpts, sdktrace.WithMaxQueueSize(c.MaxQueueSize))
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithBatcher(exporter, batchOpts...),
+		sdktrace.WithResource(res),
+	}
+	if c.SampleRatio > 0 {
+		opts = append(opts, sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(c.SampleRatio))))
+	}
+	return opts
+}
+
+func (c Config) resource(ctx context.Context) (*resource.Resource, error) {
+	environment := c.Environment
+	if environment == "" {
+		environment = "development"
+	}
+
+	attrs := []attribute.KeyValue{
+		semconv.ServiceNameKey.String(c.ServiceName),
+		attribute.String("environment", environment),
+	}
+	for k, v := range c.ResourceAttributes {
+		attrs = append(attrs, attribute.String(k, v))
+	}
+
+	return resource.New(ctx,
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(attrs...),
+		resource.WithFromEnv(),
+	)
 }
@@ -2,10 +2,17 @@ package telemetry
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/log/global"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
 	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 func TestInitTelemetrySetsProviderAndPropagator(t *testing.T) {
@@ -62,6 +69,144 @@ func TestInitTelemetry_EmptyDataset(t *testing.T) {
 	}
 }
 
+func TestInitTelemetry_NoExporterFallsBackToNoop(t *testing.T) {
+	for _, key := range []string{"OTEL_TRACES_EXPORTER", "OTEL_METRICS_EXPORTER", "OTEL_LOGS_EXPORTER", "OTEL_EXPORTER_OTLP_ENDPOINT", "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "OTEL_EXPORTER_OTLP_METRICS_ENDPOINT", "OTEL_EXPORTER_OTLP_LOGS_ENDPOINT"} {
+		t.Setenv(key, "")
+	}
+	otel.SetTracerProvider(noop.NewTracerProvider())
+
+	shutdown, err := InitTelemetry(context.Background(), Config{ServiceName: "svc"})
+	if err != nil {
+		t.Fatalf("InitTelemetry returned error: %v", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("shutdown returned error: %v", err)
+	}
+	if _, ok := otel.GetTracerProvider().(*trace.TracerProvider); ok {
+		t.Fatal("expected the no-op tracer provider to stay in place")
+	}
+}
+
+func TestInitTelemetry_ConsoleExporterFromEnv(t *testing.T) {
+	t.Setenv("OTEL_TRACES_EXPORTER", "console")
+	t.Setenv("OTEL_METRICS_EXPORTER", "none")
+
+	shutdown, err := InitTelemetry(context.Background(), Config{ServiceName: "svc"})
+	if err != nil {
+		t.Fatalf("InitTelemetry returned error: %v", err)
+	}
+	defer shutdown(context.Background())
+
+	if _, ok := otel.GetTracerProvider().(*trace.TracerProvider); !ok {
+		t.Fatalf("unexpected tracer provider type: %T", otel.GetTracerProvider())
+	}
+}
+
+func TestInitTelemetry_LogsExporterFromEnv(t *testing.T) {
+	t.Setenv("OTEL_TRACES_EXPORTER", "none")
+	t.Setenv("OTEL_METRICS_EXPORTER", "none")
+	t.Setenv("OTEL_LOGS_EXPORTER", "console")
+
+	shutdown, err := InitTelemetry(context.Background(), Config{ServiceName: "svc", BatchTimeout: time.Second})
+	if err != nil {
+		t.Fatalf("InitTelemetry returned error: %v", err)
+	}
+	defer shutdown(context.Background())
+
+	if _, ok := global.GetLoggerProvider().(*sdklog.LoggerProvider); !ok {
+		t.Fatalf("unexpected logger provider type: %T", global.GetLoggerProvider())
+	}
+}
+
+func TestInitTelemetry_PrometheusServesMetrics(t *testing.T) {
+	t.Setenv("OTEL_TRACES_EXPORTER", "none")
+	t.Setenv("OTEL_METRICS_EXPORTER", "none")
+	t.Setenv("OTEL_LOGS_EXPORTER", "none")
+
+	shutdown, err := InitTelemetry(context.Background(), Config{ServiceName: "svc", Prometheus: true})
+	if err != nil {
+		t.Fatalf("InitTelemetry returned error: %v", err)
+	}
+	defer shutdown(context.Background())
+
+	counter, err := otel.Meter("test").Int64Counter("widgets_total")
+	if err != nil {
+		t.Fatal(err)
+	}
+	counter.Add(context.Background(), 3)
+
+	rec := httptest.NewRecorder()
+	MetricsHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	body := rec.Body.String()
+	for _, want := range []string{"widgets_total", "go_goroutine_count", `service_name="svc"`} {
+		if !strings.Contains(body, want) {
+			t.Fatalf("expected %q in metrics output:\n%s", want, body)
+		}
+	}
+}
+
+func TestInitTelemetry_SDKDisabled(t *testing.T) {
+	t.Setenv("OTEL_SDK_DISABLED", "true")
+	t.Setenv("OTEL_TRACES_EXPORTER", "otlp")
+
+	cfg := Config{ServiceName: "svc", Endpoint: "localhost:4317"}
+	kind, err := cfg.tracesExporter()
+	if err != nil || kind != ExporterNone {
+		t.Fatalf("expected none when OTEL_SDK_DISABLED is set, got %q (err %v)", kind, err)
+	}
+}
+
+func TestInitTelemetry_GRPCCollector(t *testing.T) {
+	shutdown, err := InitTelemetry(context.Background(), Config{
+		ServiceName:        "svc",
+		Protocol:           ProtocolGRPC,
+		Endpoint:           "localhost:4317",
+		Insecure:           true,
+		Headers:            map[string]string{"x-tenant": "dev"},
+		SampleRatio:        0.5,
+		ResourceAttributes: map[string]string{"team": "platform"},
+	})
+	if err != nil {
+		t.Fatalf("InitTelemetry returned error: %v", err)
+	}
+	// Nothing listens on the collector port, so don't wait for the final flush.
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_ = shutdown(ctx)
+	}()
+
+	if _, ok := otel.GetTracerProvider().(*trace.TracerProvider); !ok {
+		t.Fatalf("unexpected tracer provider type: %T", otel.GetTracerProvider())
+	}
+}
+
+func TestInitTelemetry_RejectsUnknownExporterAndProtocol(t *testing.T) {
+	if _, err := InitTelemetry(context.Background(), Config{ServiceName: "svc", Exporter: "zipkin"}); err == nil {
+		t.Fatal("expected error for unknown exporter")
+	}
+	if _, err := InitTelemetry(context.Background(), Config{ServiceName: "svc", Exporter: ExporterOTLP, Protocol: "http/json"}); err == nil {
+		t.Fatal("expected error for unknown protocol")
+	}
+}
+
+func TestConfig_HeadersIncludeAxiomAuth(t *testing.T) {
+	cfg := Config{Token: "tok", Headers: map[string]string{"x-extra": "1"}}
+	headers := cfg.headers("traces")
+	if headers["Authorization"] != "Bearer tok" || headers["X-AXIOM-DATASET"] != "traces" || headers["x-extra"] != "1" {
+		t.Fatalf("unexpected headers: %v", headers)
+	}
+	if endpoint, _ := cfg.endpoint(); endpoint != defaultAxiomEndpoint {
+		t.Fatalf("expected Axiom endpoint by default, got %q", endpoint)
+	}
+	if (Config{}).headers("traces") != nil {
+		t.Fatal("expected nil headers so OTEL_EXPORTER_OTLP_HEADERS applies")
+	}
+}
+
 func containsAll(fields []string, want ...string) bool {
 	set := make(map[string]struct{}, len(fields))
 	for _, field := range fields {
@@ -0,0 +1,64 @@
+package tlsconfig
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+// reloader caches a value derived from files on disk and rebuilds it whenever
+// any of their modification times change. If a reload fails after a successful
+// load, such as when a rotation is only half written, the last good value is
+// kept and the reload is retried on the next call.
+type reloader[T any] struct {
+	paths []string
+	load  func() (T, error)
+
+	mu       sync.Mutex
+	modTimes []time.Time
+	value    T
+}
+
+func newReloader[T any](load func() (T, error), paths ...string) (*reloader[T], error) {
+	r := &reloader[T]{paths: paths, load: load}
+	if _, err := r.get(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *reloader[T]) get() (T, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	loaded := r.modTimes != nil
+	modTimes := make([]time.Time, len(r.paths))
+	changed := !loaded
+	for i, path := range r.paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			if loaded {
+				return r.value, nil
+			}
+			var zero T
+			return zero, err
+		}
+		modTimes[i] = info.ModTime()
+		if loaded && !modTimes[i].Equal(r.modTimes[i]) {
+			changed = true
+		}
+	}
+	if !changed {
+		return r.value, nil
+	}
+
+	value, err := r.load()
+	if err != nil {
+		if loaded {
+			return r.value, nil
+		}
+		return value, err
+	}
+	r.value, r.modTimes = value, modTimes
+	return value, nil
+}
@@ -0,0 +1,331 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) writeCA(t *testing.T, dir string) string {
+	t.Helper()
+	path := filepath.Join(dir, "ca.pem")
+	writePEM(t, path, "CERTIFICATE", ca.cert.Raw)
+	return path
+}
+
+// issue writes a leaf certificate for commonName signed by the CA and returns
+// the cert and key paths.
+func (ca *testCA) issue(t *testing.T, dir, commonName string) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPath := filepath.Join(dir, commonName+".pem")
+	keyPath := filepath.Join(dir, commonName+"-key.pem")
+	writePEM(t, certPath, "CERTIFICATE", der)
+	writePEM(t, keyPath, "EC PRIVATE KEY", keyDER)
+	return certPath, keyPath
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	t.Helper()
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func startHealthServer(t *testing.T, creds credentials.TransportCredentials) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(grpc.Creds(creds))
+	grpc_health_v1.RegisterHealthServer(srv, health.NewServer())
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+func checkHealth(t *testing.T, addr string, creds credentials.TransportCredentials) error {
+	t.Helper()
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	return err
+}
+
+func TestConfig_Enabled(t *testing.T) {
+	if (Config{}).ServerEnabled() || (Config{}).ClientEnabled() {
+		t.Fatal("empty config should not enable TLS")
+	}
+	if !(Config{DevMode: true}).ServerEnabled() {
+		t.Fatal("DevMode should enable server TLS")
+	}
+	if !(Config{CAFile: "ca.pem"}).ClientEnabled() {
+		t.Fatal("CAFile should enable client TLS")
+	}
+	if (Config{CAFile: "ca.pem"}).ServerEnabled() {
+		t.Fatal("CAFile alone should not enable server TLS")
+	}
+}
+
+func TestCredentials_EmptyConfigIsInsecure(t *testing.T) {
+	serverCreds, err := ServerCredentials(Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCreds, err := ClientCredentials(Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := serverCreds.Info().SecurityProtocol; got != "insecure" {
+		t.Fatalf("expected insecure server credentials, got %q", got)
+	}
+	if got := clientCreds.Info().SecurityProtocol; got != "insecure" {
+		t.Fatalf("expected insecure client credentials, got %q", got)
+	}
+}
+
+func TestCredentials_CertWithoutKey(t *testing.T) {
+	if _, err := ServerCredentials(Config{CertFile: "cert.pem"}); err == nil {
+		t.Fatal("expected error when KeyFile is missing")
+	}
+	if _, err := ClientCredentials(Config{KeyFile: "key.pem"}); err == nil {
+		t.Fatal("expected error when CertFile is missing")
+	}
+}
+
+func TestCredentials_MissingFiles(t *testing.T) {
+	dir := t.TempDir()
+	_, err := ServerCredentials(Config{
+		CertFile: filepath.Join(dir, "missing.pem"),
+		KeyFile:  filepath.Join(dir, "missing-key.pem"),
+	})
+	if err == nil {
+		t.Fatal("expected error for missing certificate files")
+	}
+}
+
+func TestCredentials_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caFile := ca.writeCA(t, dir)
+	serverCert, serverKey := ca.issue(t, dir, "server")
+	clientCert, clientKey := ca.issue(t, dir, "client")
+
+	serverCreds, err := ServerCredentials(Config{CertFile: serverCert, KeyFile: serverKey, CAFile: caFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := startHealthServer(t, serverCreds)
+
+	clientCreds, err := ClientCredentials(Config{CertFile: clientCert, KeyFile: clientKey, CAFile: caFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := checkHealth(t, addr, clientCreds); err != nil {
+		t.Fatalf("mTLS call failed: %v", err)
+	}
+
+	noCertCreds, err := ClientCredentials(Config{CAFile: caFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := checkHealth(t, addr, noCertCreds); err == nil {
+		t.Fatal("expected server to reject a client without a certificate")
+	}
+}
+
+func TestCredentials_RejectsUntrustedServer(t *testing.T) {
+	dir := t.TempDir()
+	serverCA := newTestCA(t)
+	serverCert, serverKey := serverCA.issue(t, dir, "server")
+
+	serverCreds, err := ServerCredentials(Config{CertFile: serverCert, KeyFile: serverKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := startHealthServer(t, serverCreds)
+
+	otherDir := t.TempDir()
+	otherCA := newTestCA(t).writeCA(t, otherDir)
+	clientCreds, err := ClientCredentials(Config{CAFile: otherCA})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := checkHealth(t, addr, clientCreds); err == nil {
+		t.Fatal("expected client to reject a server signed by an unknown CA")
+	}
+}
+
+func TestCredentials_DevMode(t *testing.T) {
+	serverCreds, err := ServerCredentials(Config{DevMode: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := serverCreds.Info().SecurityProtocol; got != "tls" {
+		t.Fatalf("expected tls server credentials, got %q", got)
+	}
+	addr := startHealthServer(t, serverCreds)
+
+	clientCreds, err := ClientCredentials(Config{DevMode: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := checkHealth(t, addr, clientCreds); err != nil {
+		t.Fatalf("dev mode call failed: %v", err)
+	}
+}
+
+func TestCredentials_ReloadsRotatedCA(t *testing.T) {
+	dir := t.TempDir()
+	oldCA := newTestCA(t)
+	caFile := oldCA.writeCA(t, dir)
+
+	newCA := newTestCA(t)
+	serverCert, serverKey := newCA.issue(t, t.TempDir(), "server")
+	serverCreds, err := ServerCredentials(Config{CertFile: serverCert, KeyFile: serverKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := startHealthServer(t, serverCreds)
+
+	clientCreds, err := ClientCredentials(Config{CAFile: caFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := checkHealth(t, addr, clientCreds); err == nil {
+		t.Fatal("expected handshake to fail before the CA bundle is rotated")
+	}
+
+	newCA.writeCA(t, dir)
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(caFile, later, later); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkHealth(t, addr, clientCreds); err != nil {
+		t.Fatalf("expected rotated CA bundle to be picked up, got: %v", err)
+	}
+}
+
+func TestReloader_KeepsLastGoodValue(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "value")
+	if err := os.WriteFile(path, []byte("one"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := newReloader(func() (string, error) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		if len(data) == 0 {
+			return "", os.ErrInvalid
+		}
+		return string(data), nil
+	}, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bump := func(offset time.Duration) {
+		ts := time.Now().Add(offset)
+		if err := os.Chtimes(path, ts, ts); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	bump(time.Minute)
+	if got, err := r.get(); err != nil || got != "one" {
+		t.Fatalf("expected last good value %q, got %q (err %v)", "one", got, err)
+	}
+
+	if err := os.WriteFile(path, []byte("two"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	bump(2 * time.Minute)
+	if got, err := r.get(); err != nil || got != "two" {
+		t.Fatalf("expected reloaded value %q, got %q (err %v)", "two", got, err)
+	}
+}
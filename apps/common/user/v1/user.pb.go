@@ -698,6 +698,222 @@ func (x *ListUsersResponse) GetUsers() []*User {
 	return nil
 }
 
+type Quota struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxFiles      int64                  `protobuf:"varint,1,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`
+	MaxBytes      int64                  `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Quota) Reset() {
+	*x = Quota{}
+	mi := &file_user_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quota) ProtoMessage() {}
+
+func (x *Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quota.ProtoReflect.Descriptor instead.
+func (*Quota) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Quota) GetMaxFiles() int64 {
+	if x != nil {
+		return x.MaxFiles
+	}
+	return 0
+}
+
+func (x *Quota) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+type GetQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaRequest) Reset() {
+	*x = GetQuotaRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaRequest) ProtoMessage() {}
+
+func (x *GetQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetQuotaRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *Quota                 `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	RoleDefault   *Quota                 `protobuf:"bytes,2,opt,name=role_default,json=roleDefault,proto3" json:"role_default,omitempty"`
+	Custom        bool                   `protobuf:"varint,3,opt,name=custom,proto3" json:"custom,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaResponse) Reset() {
+	*x = GetQuotaResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaResponse) ProtoMessage() {}
+
+func (x *GetQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaResponse.ProtoReflect.Descriptor instead.
+func (*GetQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetQuotaResponse) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *GetQuotaResponse) GetRoleDefault() *Quota {
+	if x != nil {
+		return x.RoleDefault
+	}
+	return nil
+}
+
+func (x *GetQuotaResponse) GetCustom() bool {
+	if x != nil {
+		return x.Custom
+	}
+	return false
+}
+
+type SetQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MaxFiles      *int64                 `protobuf:"varint,2,opt,name=max_files,json=maxFiles,proto3,oneof" json:"max_files,omitempty"`
+	MaxBytes      *int64                 `protobuf:"varint,3,opt,name=max_bytes,json=maxBytes,proto3,oneof" json:"max_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQuotaRequest) Reset() {
+	*x = SetQuotaRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuotaRequest) ProtoMessage() {}
+
+func (x *SetQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQuotaRequest.ProtoReflect.Descriptor instead.
+func (*SetQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetQuotaRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetQuotaRequest) GetMaxFiles() int64 {
+	if x != nil && x.MaxFiles != nil {
+		return *x.MaxFiles
+	}
+	return 0
+}
+
+func (x *SetQuotaRequest) GetMaxBytes() int64 {
+	if x != nil && x.MaxBytes != nil {
+		return *x.MaxBytes
+	}
+	return 0
+}
+
 var File_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_user_v1_user_proto_rawDesc = "" +
@@ -735,12 +951,29 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x04role\x18\x01 \x01(\x0e2\r.user.v1.RoleR\x04role\x12'\n" +
 	"\x0fusername_filter\x18\x02 \x01(\tR\x0eusernameFilter\"8\n" +
 	"\x11ListUsersResponse\x12#\n" +
-	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users*;\n" +
+	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\"A\n" +
+	"\x05Quota\x12\x1b\n" +
+	"\tmax_files\x18\x01 \x01(\x03R\bmaxFiles\x12\x1b\n" +
+	"\tmax_bytes\x18\x02 \x01(\x03R\bmaxBytes\"*\n" +
+	"\x0fGetQuotaRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x83\x01\n" +
+	"\x10GetQuotaResponse\x12$\n" +
+	"\x05quota\x18\x01 \x01(\v2\x0e.user.v1.QuotaR\x05quota\x121\n" +
+	"\frole_default\x18\x02 \x01(\v2\x0e.user.v1.QuotaR\vroleDefault\x12\x16\n" +
+	"\x06custom\x18\x03 \x01(\bR\x06custom\"\x8a\x01\n" +
+	"\x0fSetQuotaRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\tmax_files\x18\x02 \x01(\x03H\x00R\bmaxFiles\x88\x01\x01\x12 \n" +
+	"\tmax_bytes\x18\x03 \x01(\x03H\x01R\bmaxBytes\x88\x01\x01B\f\n" +
+	"\n" +
+	"_max_filesB\f\n" +
+	"\n" +
+	"_max_bytes*;\n" +
 	"\x04Role\x12\x14\n" +
 	"\x10ROLE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tROLE_USER\x10\x01\x12\x0e\n" +
 	"\n" +
-	"ROLE_ADMIN\x10\x022\xd4\x03\n" +
+	"ROLE_ADMIN\x10\x022\xd6\x04\n" +
 	"\vUserService\x12E\n" +
 	"\n" +
 	"CreateUser\x12\x1a.user.v1.CreateUserRequest\x1a\x1b.user.v1.CreateUserResponse\x12<\n" +
@@ -749,7 +982,9 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x0eVerifyPassword\x12\x1e.user.v1.VerifyPasswordRequest\x1a\x1f.user.v1.VerifyPasswordResponse\x12M\n" +
 	"\n" +
 	"DeleteUser\x12\x1e.user.v1.DeleteUserByIdRequest\x1a\x1f.user.v1.DeleteUserByIdResponse\x12B\n" +
-	"\tListUsers\x12\x19.user.v1.ListUsersRequest\x1a\x1a.user.v1.ListUsersResponseB\x8e\x01\n" +
+	"\tListUsers\x12\x19.user.v1.ListUsersRequest\x1a\x1a.user.v1.ListUsersResponse\x12?\n" +
+	"\bGetQuota\x12\x18.user.v1.GetQuotaRequest\x1a\x19.user.v1.GetQuotaResponse\x12?\n" +
+	"\bSetQuota\x12\x18.user.v1.SetQuotaRequest\x1a\x19.user.v1.GetQuotaResponseB\x8e\x01\n" +
 	"\vcom.user.v1B\tUserProtoP\x01Z7github.com/provsalt/DOP_P01_Team1/common/user/v1;userv1\xa2\x02\x03UXX\xaa\x02\aUser.V1\xca\x02\aUser\\V1\xe2\x02\x13User\\V1\\GPBMetadata\xea\x02\bUser::V1b\x06proto3"
 
 var (
@@ -765,7 +1000,7 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 }
 
 var file_user_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_user_v1_user_proto_goTypes = []any{
 	(Role)(0),                         // 0: user.v1.Role
 	(*User)(nil),                      // 1: user.v1.User
@@ -781,6 +1016,10 @@ var file_user_v1_user_proto_goTypes = []any{
 	(*DeleteUserByIdResponse)(nil),    // 11: user.v1.DeleteUserByIdResponse
 	(*ListUsersRequest)(nil),          // 12: user.v1.ListUsersRequest
 	(*ListUsersResponse)(nil),         // 13: user.v1.ListUsersResponse
+	(*Quota)(nil),                     // 14: user.v1.Quota
+	(*GetQuotaRequest)(nil),           // 15: user.v1.GetQuotaRequest
+	(*GetQuotaResponse)(nil),          // 16: user.v1.GetQuotaResponse
+	(*SetQuotaRequest)(nil),           // 17: user.v1.SetQuotaRequest
 }
 var file_user_v1_user_proto_depIdxs = []int32{
 	0,  // 0: user.v1.User.role:type_name -> user.v1.Role
@@ -791,23 +1030,29 @@ var file_user_v1_user_proto_depIdxs = []int32{
 	1,  // 5: user.v1.VerifyPasswordResponse.user:type_name -> user.v1.User
 	0,  // 6: user.v1.ListUsersRequest.role:type_name -> user.v1.Role
 	1,  // 7: user.v1.ListUsersResponse.users:type_name -> user.v1.User
-	2,  // 8: user.v1.UserService.CreateUser:input_type -> user.v1.CreateUserRequest
-	4,  // 9: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
-	6,  // 10: user.v1.UserService.GetUserByUsername:input_type -> user.v1.GetUserByUsernameRequest
-	8,  // 11: user.v1.UserService.VerifyPassword:input_type -> user.v1.VerifyPasswordRequest
-	10, // 12: user.v1.UserService.DeleteUser:input_type -> user.v1.DeleteUserByIdRequest
-	12, // 13: user.v1.UserService.ListUsers:input_type -> user.v1.ListUsersRequest
-	3,  // 14: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserResponse
-	5,  // 15: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
-	7,  // 16: user.v1.UserService.GetUserByUsername:output_type -> user.v1.GetUserByUsernameResponse
-	9,  // 17: user.v1.UserService.VerifyPassword:output_type -> user.v1.VerifyPasswordResponse
-	11, // 18: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserByIdResponse
-	13, // 19: user.v1.UserService.ListUsers:output_type -> user.v1.ListUsersResponse
-	14, // [14:20] is the sub-list for method output_type
-	8,  // [8:14] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	14, // 8: user.v1.GetQuotaResponse.quota:type_name -> user.v1.Quota
+	14, // 9: user.v1.GetQuotaResponse.role_default:type_name -> user.v1.Quota
+	2,  // 10: user.v1.UserService.CreateUser:input_type -> user.v1.CreateUserRequest
+	4,  // 11: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
+	6,  // 12: user.v1.UserService.GetUserByUsername:input_type -> user.v1.GetUserByUsernameRequest
+	8,  // 13: user.v1.UserService.VerifyPassword:input_type -> user.v1.VerifyPasswordRequest
+	10, // 14: user.v1.UserService.DeleteUser:input_type -> user.v1.DeleteUserByIdRequest
+	12, // 15: user.v1.UserService.ListUsers:input_type -> user.v1.ListUsersRequest
+	15, // 16: user.v1.UserService.GetQuota:input_type -> user.v1.GetQuotaRequest
+	17, // 17: user.v1.UserService.SetQuota:input_type -> user.v1.SetQuotaRequest
+	3,  // 18: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserResponse
+	5,  // 19: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
+	7,  // 20: user.v1.UserService.GetUserByUsername:output_type -> user.v1.GetUserByUsernameResponse
+	9,  // 21: user.v1.UserService.VerifyPassword:output_type -> user.v1.VerifyPasswordResponse
+	11, // 22: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserByIdResponse
+	13, // 23: user.v1.UserService.ListUsers:output_type -> user.v1.ListUsersResponse
+	16, // 24: user.v1.UserService.GetQuota:output_type -> user.v1.GetQuotaResponse
+	16, // 25: user.v1.UserService.SetQuota:output_type -> user.v1.GetQuotaResponse
+	18, // [18:26] is the sub-list for method output_type
+	10, // [10:18] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_user_v1_user_proto_init() }
@@ -815,13 +1060,14 @@ func file_user_v1_user_proto_init() {
 	if File_user_v1_user_proto != nil {
 		return
 	}
+	file_user_v1_user_proto_msgTypes[16].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   13,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,9 +22,10 @@ _runtime_version.ValidateProtobufRuntimeVersion(
 _sym_db = _symbol_database.Default()
 
 
+from google.protobuf import field_mask_pb2 as google_dot_protobuf_dot_field__mask__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x12\x66ile/v1/file.proto\x12\x07\x66ile.v1\"\xa1\x01\n\x04\x46ile\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n\x07user_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n\x08\x66ilename\x18\x03 \x01(\tR\x08\x66ilename\x12\x12\n\x04size\x18\x04 \x01(\x03R\x04size\x12!\n\x0c\x63ontent_type\x18\x05 \x01(\tR\x0b\x63ontentType\x12\x1d\n\ncreated_at\x18\x06 \x01(\x03R\tcreatedAt\"f\n\x11\x43reateFileRequest\x12\x1a\n\x08\x66ilename\x18\x01 \x01(\tR\x08\x66ilename\x12\x12\n\x04size\x18\x02 \x01(\x03R\x04size\x12!\n\x0c\x63ontent_type\x18\x03 \x01(\tR\x0b\x63ontentType\" \n\x0eGetFileRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"\x12\n\x10ListFilesRequest\"8\n\x11ListFilesResponse\x12#\n\x05\x66iles\x18\x01 \x03(\x0b\x32\r.file.v1.FileR\x05\x66iles\"1\n\x0c\x46ileResponse\x12!\n\x04\x66ile\x18\x01 \x01(\x0b\x32\r.file.v1.FileR\x04\x66ile\"<\n\x11\x44\x65leteFileRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n\x07user_id\x18\x02 \x01(\tR\x06userId\".\n\x12\x44\x65leteFileResponse\x12\x18\n\x07success\x18\x01 \x01(\x08R\x07success\"n\n\x11UploadFileRequest\x12\x39\n\x08metadata\x18\x01 \x01(\x0b\x32\x1b.file.v1.UploadFileMetadataH\x00R\x08metadata\x12\x16\n\x05\x63hunk\x18\x02 \x01(\x0cH\x00R\x05\x63hunkB\x06\n\x04\x64\x61ta\"S\n\x12UploadFileMetadata\x12\x1a\n\x08\x66ilename\x18\x01 \x01(\tR\x08\x66ilename\x12!\n\x0c\x63ontent_type\x18\x02 \x01(\tR\x0b\x63ontentType\"%\n\x13\x44ownloadFileRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"s\n\x14\x44ownloadFileResponse\x12;\n\x08metadata\x18\x01 \x01(\x0b\x32\x1d.file.v1.DownloadFileMetadataH\x00R\x08metadata\x12\x16\n\x05\x63hunk\x18\x02 \x01(\x0cH\x00R\x05\x63hunkB\x06\n\x04\x64\x61ta\"i\n\x14\x44ownloadFileMetadata\x12\x1a\n\x08\x66ilename\x18\x01 \x01(\tR\x08\x66ilename\x12!\n\x0c\x63ontent_type\x18\x02 \x01(\tR\x0b\x63ontentType\x12\x12\n\x04size\x18\x03 \x01(\x03R\x04size\"~\n\x1eInitiateMultipartUploadRequest\x12\x1a\n\x08\x66ilename\x18\x01 \x01(\tR\x08\x66ilename\x12!\n\x0c\x63ontent_type\x18\x02 \x01(\tR\x0b\x63ontentType\x12\x1d\n\ntotal_size\x18\x03 \x01(\x03R\ttotalSize\"~\n\x1fInitiateMultipartUploadResponse\x12\x1b\n\tupload_id\x18\x01 \x01(\tR\x08uploadId\x12\x1d\n\nchunk_size\x18\x02 \x01(\x05R\tchunkSize\x12\x1f\n\x0btotal_parts\x18\x03 \x01(\x05R\ntotalParts\"g\n\x11UploadPartRequest\x12\x1b\n\tupload_id\x18\x01 \x01(\tR\x08uploadId\x12\x1f\n\x0bpart_number\x18\x02 \x01(\x05R\npartNumber\x12\x14\n\x05\x63hunk\x18\x03 \x01(\x0cR\x05\x63hunk\"I\n\x12UploadPartResponse\x12\x12\n\x04\x65tag\x18\x01 \x01(\tR\x04\x65tag\x12\x1f\n\x0bpart_number\x18\x02 \x01(\x05R\npartNumber\"f\n\x1e\x43ompleteMultipartUploadRequest\x12\x1b\n\tupload_id\x18\x01 \x01(\tR\x08uploadId\x12\'\n\x05parts\x18\x02 \x03(\x0b\x32\x11.file.v1.PartInfoR\x05parts\"?\n\x08PartInfo\x12\x1f\n\x0bpart_number\x18\x01 \x01(\x05R\npartNumber\x12\x12\n\x04\x65tag\x18\x02 \x01(\tR\x04\x65tag\":\n\x1b\x41\x62ortMultipartUploadRequest\x12\x1b\n\tupload_id\x18\x01 \x01(\tR\x08uploadId\"8\n\x1c\x41\x62ortMultipartUploadResponse\x12\x18\n\x07success\x18\x01 \x01(\x08R\x07success2\x9b\x06\n\x0b\x46ileService\x12\x41\n\nUploadFile\x12\x1a.file.v1.UploadFileRequest\x1a\x15.file.v1.FileResponse(\x01\x12?\n\nCreateFile\x12\x1a.file.v1.CreateFileRequest\x1a\x15.file.v1.FileResponse\x12\x42\n\tListFiles\x12\x19.file.v1.ListFilesRequest\x1a\x1a.file.v1.ListFilesResponse\x12\x39\n\x07GetFile\x12\x17.file.v1.GetFileRequest\x1a\x15.file.v1.FileResponse\x12M\n\x0c\x44ownloadFile\x12\x1c.file.v1.DownloadFileRequest\x1a\x1d.file.v1.DownloadFileResponse0\x01\x12\x45\n\nDeleteFile\x12\x1a.file.v1.DeleteFileRequest\x1a\x1b.file.v1.DeleteFileResponse\x12l\n\x17InitiateMultipartUpload\x12\'.file.v1.InitiateMultipartUploadRequest\x1a(.file.v1.InitiateMultipartUploadResponse\x12\x45\n\nUploadPart\x12\x1a.file.v1.UploadPartRequest\x1a\x1b.file.v1.UploadPartResponse\x12Y\n\x17\x43ompleteMultipartUpload\x12\'.file.v1.CompleteMultipartUploadRequest\x1a\x15.file.v1.FileResponse\x12\x63\n\x14\x41\x62ortMultipartUpload\x12$.file.v1.AbortMultipartUploadRequest\x1a%.file.v1.AbortMultipartUploadResponseB\x8e\x01\n\x0b\x63om.file.v1B\tFileProtoP\x01Z7github.com/provsalt/DOP_P01_Team1/common/file/v1;filev1\xa2\x02\x03\x46XX\xaa\x02\x07\x46ile.V1\xca\x02\x07\x46ile\\V1\xe2\x02\x13\x46ile\\V1\\GPBMetadata\xea\x02\x08\x46ile::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x12\x66ile/v1/file.proto\x12\x07\x66ile.v1\x1a google/protobuf/field_mask.proto\"\x83\x03\n\x04\x46ile\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n\x07user_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n\x08\x66ilename\x18\x03 \x01(\tR\x08\x66ilename\x12\x12\n\x04size\x18\x04 \x01(\x03R\x04size\x12!\n\x0c\x63ontent_type\x18\x05 \x01(\tR\x0b\x63ontentType\x12\x1d\n\ncreated_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1b\n\tparent_id\x18\x07 \x01(\tR\x08parentId\x12\x12\n\x04tags\x18\x08 \x03(\tR\x04tags\x12\x37\n\x08metadata\x18\t \x03(\x0b\x32\x1b.file.v1.File.MetadataEntryR\x08metadata\x12\x16\n\x06sha256\x18\n \x01(\tR\x06sha256\x12!\n\x0cparts_sha256\x18\x0b \x01(\tR\x0bpartsSha256\x1a;\n\rMetadataEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\"f\n\x11\x43reateFileRequest\x12\x1a\n\x08\x66ilename\x18\x01 \x01(\tR\x08\x66ilename\x12\x12\n\x04size\x18\x02 \x01(\x03R\x04size\x12!\n\x0c\x63ontent_type\x18\x03 \x01(\tR\x0b\x63ontentType\"O\n\x0eGetFileRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n\x07link_id\x18\x02 \x01(\tR\x06linkId\x12\x14\n\x05\x61\x64min\x18\x03 \x01(\x08R\x05\x61\x64min\"\xa2\x04\n\x10ListFilesRequest\x12 \n\tfolder_id\x18\x01 \x01(\tH\x00R\x08\x66olderId\x88\x01\x01\x12\x14\n\x05query\x18\x02 \x01(\tR\x05query\x12\x12\n\x04tags\x18\x03 \x03(\tR\x04tags\x12!\n\x0c\x63ontent_type\x18\x04 \x01(\tR\x0b\x63ontentType\x12\x1e\n\x08min_size\x18\x05 \x01(\x03H\x01R\x07minSize\x88\x01\x01\x12\x1e\n\x08max_size\x18\x06 \x01(\x03H\x02R\x07maxSize\x88\x01\x01\x12(\n\rcreated_after\x18\x07 \x01(\x03H\x03R\x0c\x63reatedAfter\x88\x01\x01\x12*\n\x0e\x63reated_before\x18\x08 \x01(\x03H\x04R\rcreatedBefore\x88\x01\x01\x12\x1b\n\tpage_size\x18\t \x01(\x05R\x08pageSize\x12\x1d\n\npage_token\x18\n \x01(\tR\tpageToken\x12/\n\x07sort_by\x18\x0b \x01(\x0e\x32\x16.file.v1.FileSortFieldR\x06sortBy\x12\x1e\n\ndescending\x18\x0c \x01(\x08R\ndescending\x12\x14\n\x05\x61\x64min\x18\r \x01(\x08R\x05\x61\x64min\x12\x19\n\x08owner_id\x18\x0e \x01(\tR\x07ownerIdB\x0c\n\n_folder_idB\x0b\n\t_min_sizeB\x0b\n\t_max_sizeB\x10\n\x0e_created_afterB\x11\n\x0f_created_before\"\x87\x02\n\x11ListFilesResponse\x12#\n\x05\x66iles\x18\x01 \x03(\x0b\x32\r.file.v1.FileR\x05\x66iles\x12)\n\x07\x66olders\x18\x02 \x03(\x0b\x32\x0f.file.v1.FolderR\x07\x66olders\x12\x31\n\x0b\x62readcrumbs\x18\x03 \x03(\x0b\x32\x0f.file.v1.FolderR\x0b\x62readcrumbs\x12&\n\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\x12&\n\x0fprev_page_token\x18\x05 \x01(\tR\rprevPageToken\x12\x1f\n\x0btotal_count\x18\x06 \x01(\x03R\ntotalCount\"1\n\x0c\x46ileResponse\x12!\n\x04\x66ile\x18\x01 \x01(\x0b\x32\r.file.v1.FileR\x04\x66ile\"R\n\x11\x44\x65leteFileRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n\x07user_id\x18\x02 \x01(\tR\x06userId\x12\x14\n\x05\x61\x64min\x18\x03 \x01(\x08R\x05\x61\x64min\"\x8d\x03\n\x11UpdateFileRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n\x08\x66ilename\x18\x02 \x01(\tR\x08\x66ilename\x12!\n\x0c\x63ontent_type\x18\x03 \x01(\tR\x0b\x63ontentType\x12\x1b\n\tparent_id\x18\x04 \x01(\tR\x08parentId\x12;\n\x0bupdate_mask\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.FieldMaskR\nupdateMask\x12\x38\n\x0bon_conflict\x18\x06 \x01(\x0e\x32\x17.file.v1.ConflictPolicyR\nonConflict\x12\x12\n\x04tags\x18\x07 \x03(\tR\x04tags\x12\x44\n\x08metadata\x18\x08 \x03(\x0b\x32(.file.v1.UpdateFileRequest.MetadataEntryR\x08metadata\x1a;\n\rMetadataEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\">\n\x0f\x46ileTagsRequest\x12\x17\n\x07\x66ile_id\x18\x01 \x01(\tR\x06\x66ileId\x12\x12\n\x04tags\x18\x02 \x03(\tR\x04tags\"\x11\n\x0fListTagsRequest\"/\n\x03Tag\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n\x05\x63ount\x18\x02 \x01(\x05R\x05\x63ount\"4\n\x10ListTagsResponse\x12 \n\x04tags\x18\x01 \x03(\x0b\x32\x0c.file.v1.TagR\x04tags\".\n\x12\x44\x65leteFileResponse\x12\x18\n\x07success\x18\x01 \x01(\x08R\x07success\"n\n\x11UploadFileRequest\x12\x39\n\x08metadata\x18\x01 \x01(\x0b\x32\x1b.file.v1.UploadFileMetadataH\x00R\x08metadata\x12\x16\n\x05\x63hunk\x18\x02 \x01(\x0cH\x00R\x05\x63hunkB\x06\n\x04\x64\x61ta\"\xb5\x01\n\x12UploadFileMetadata\x12\x1a\n\x08\x66ilename\x18\x01 \x01(\tR\x08\x66ilename\x12!\n\x0c\x63ontent_type\x18\x02 \x01(\tR\x0b\x63ontentType\x12\x1b\n\tparent_id\x18\x03 \x01(\tR\x08parentId\x12+\n\x05quota\x18\x04 \x01(\x0b\x32\x15.file.v1.StorageQuotaR\x05quota\x12\x16\n\x06sha256\x18\x05 \x01(\tR\x06sha256\"H\n\x0cStorageQuota\x12\x1b\n\tmax_files\x18\x01 \x01(\x03R\x08maxFiles\x12\x1b\n\tmax_bytes\x18\x02 \x01(\x03R\x08maxBytes\"\x11\n\x0fGetUsageRequest\"\xa0\x01\n\x10GetUsageResponse\x12\x1d\n\nfile_count\x18\x01 \x01(\x03R\tfileCount\x12\x1f\n\x0btotal_bytes\x18\x02 \x01(\x03R\ntotalBytes\x12\'\n\x0fpending_uploads\x18\x03 \x01(\x03R\x0ependingUploads\x12#\n\rpending_bytes\x18\x04 \x01(\x03R\x0cpendingBytes\"\x84\x01\n\x13\x44ownloadFileRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x16\n\x06length\x18\x03 \x01(\x03R\x06length\x12\x17\n\x07link_id\x18\x04 \x01(\tR\x06linkId\x12\x14\n\x05\x61\x64min\x18\x05 \x01(\x08R\x05\x61\x64min\"s\n\x14\x44ownloadFileResponse\x12;\n\x08metadata\x18\x01 \x01(\x0b\x32\x1d.file.v1.DownloadFileMetadataH\x00R\x08metadata\x12\x16\n\x05\x63hunk\x18\x02 \x01(\x0cH\x00R\x05\x63hunkB\x06\n\x04\x64\x61ta\"i\n\x14\x44ownloadFileMetadata\x12\x1a\n\x08\x66ilename\x18\x01 \x01(\tR\x08\x66ilename\x12!\n\x0c\x63ontent_type\x18\x02 \x01(\tR\x0b\x63ontentType\x12\x12\n\x04size\x18\x03 \x01(\x03R\x04size\"\xc8\x01\n\x1eInitiateMultipartUploadRequest\x12\x1a\n\x08\x66ilename\x18\x01 \x01(\tR\x08\x66ilename\x12!\n\x0c\x63ontent_type\x18\x02 \x01(\tR\x0b\x63ontentType\x12\x1d\n\ntotal_size\x18\x03 \x01(\x03R\ttotalSize\x12\x1b\n\tparent_id\x18\x04 \x01(\tR\x08parentId\x12+\n\x05quota\x18\x05 \x01(\x0b\x32\x15.file.v1.StorageQuotaR\x05quota\"~\n\x1fInitiateMultipartUploadResponse\x12\x1b\n\tupload_id\x18\x01 \x01(\tR\x08uploadId\x12\x1d\n\nchunk_size\x18\x02 \x01(\x05R\tchunkSize\x12\x1f\n\x0btotal_parts\x18\x03 \x01(\x05R\ntotalParts\"\x7f\n\x11UploadPartRequest\x12\x1b\n\tupload_id\x18\x01 \x01(\tR\x08uploadId\x12\x1f\n\x0bpart_number\x18\x02 \x01(\x05R\npartNumber\x12\x14\n\x05\x63hunk\x18\x03 \x01(\x0cR\x05\x63hunk\x12\x16\n\x06sha256\x18\x04 \x01(\tR\x06sha256\"t\n\x17UploadPartStreamRequest\x12\x39\n\x08metadata\x18\x01 \x01(\x0b\x32\x1b.file.v1.UploadPartMetadataH\x00R\x08metadata\x12\x16\n\x05\x63hunk\x18\x02 \x01(\x0cH\x00R\x05\x63hunkB\x06\n\x04\x64\x61ta\"j\n\x12UploadPartMetadata\x12\x1b\n\tupload_id\x18\x01 \x01(\tR\x08uploadId\x12\x1f\n\x0bpart_number\x18\x02 \x01(\x05R\npartNumber\x12\x16\n\x06sha256\x18\x03 \x01(\tR\x06sha256\"a\n\x12UploadPartResponse\x12\x12\n\x04\x65tag\x18\x01 \x01(\tR\x04\x65tag\x12\x1f\n\x0bpart_number\x18\x02 \x01(\x05R\npartNumber\x12\x16\n\x06sha256\x18\x03 \x01(\tR\x06sha256\"\x89\x01\n\x1e\x43ompleteMultipartUploadRequest\x12\x1b\n\tupload_id\x18\x01 \x01(\tR\x08uploadId\x12\'\n\x05parts\x18\x02 \x03(\x0b\x32\x11.file.v1.PartInfoR\x05parts\x12!\n\x0cparts_sha256\x18\x03 \x01(\tR\x0bpartsSha256\"?\n\x08PartInfo\x12\x1f\n\x0bpart_number\x18\x01 \x01(\x05R\npartNumber\x12\x12\n\x04\x65tag\x18\x02 \x01(\tR\x04\x65tag\":\n\x1b\x41\x62ortMultipartUploadRequest\x12\x1b\n\tupload_id\x18\x01 \x01(\tR\x08uploadId\"8\n\x1c\x41\x62ortMultipartUploadResponse\x12\x18\n\x07success\x18\x01 \x01(\x08R\x07success\"\xf5\x02\n\x0fMultipartUpload\x12\x1b\n\tupload_id\x18\x01 \x01(\tR\x08uploadId\x12\x1a\n\x08\x66ilename\x18\x02 \x01(\tR\x08\x66ilename\x12!\n\x0c\x63ontent_type\x18\x03 \x01(\tR\x0b\x63ontentType\x12\x1d\n\ntotal_size\x18\x04 \x01(\x03R\ttotalSize\x12\x1b\n\tparent_id\x18\x05 \x01(\tR\x08parentId\x12\x1d\n\nchunk_size\x18\x06 \x01(\x05R\tchunkSize\x12\x1f\n\x0btotal_parts\x18\x07 \x01(\x05R\ntotalParts\x12%\n\x0eparts_uploaded\x18\x08 \x01(\x05R\rpartsUploaded\x12%\n\x0euploaded_bytes\x18\t \x01(\x03R\ruploadedBytes\x12\x1d\n\ncreated_at\x18\n \x01(\x03R\tcreatedAt\x12\x1d\n\nexpires_at\x18\x0b \x01(\x03R\texpiresAt\"o\n\x0cUploadedPart\x12\x1f\n\x0bpart_number\x18\x01 \x01(\x05R\npartNumber\x12\x12\n\x04\x65tag\x18\x02 \x01(\tR\x04\x65tag\x12\x12\n\x04size\x18\x03 \x01(\x03R\x04size\x12\x16\n\x06sha256\x18\x04 \x01(\tR\x06sha256\"\x1d\n\x1bListMultipartUploadsRequest\"R\n\x1cListMultipartUploadsResponse\x12\x32\n\x07uploads\x18\x01 \x03(\x0b\x32\x18.file.v1.MultipartUploadR\x07uploads\"/\n\x10ListPartsRequest\x12\x1b\n\tupload_id\x18\x01 \x01(\tR\x08uploadId\"r\n\x11ListPartsResponse\x12\x30\n\x06upload\x18\x01 \x01(\x0b\x32\x18.file.v1.MultipartUploadR\x06upload\x12+\n\x05parts\x18\x02 \x03(\x0b\x32\x15.file.v1.UploadedPartR\x05parts\"\xc1\x01\n\x0c\x44ownloadLink\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n\x07\x66ile_id\x18\x02 \x01(\tR\x06\x66ileId\x12\x1d\n\nexpires_at\x18\x03 \x01(\x03R\texpiresAt\x12#\n\rmax_downloads\x18\x04 \x01(\x05R\x0cmaxDownloads\x12%\n\x0e\x64ownload_count\x18\x05 \x01(\x05R\rdownloadCount\x12\x1d\n\ncreated_at\x18\x06 \x01(\x03R\tcreatedAt\"x\n\x19\x43reateDownloadLinkRequest\x12\x17\n\x07\x66ile_id\x18\x01 \x01(\tR\x06\x66ileId\x12\x1d\n\nexpires_at\x18\x02 \x01(\x03R\texpiresAt\x12#\n\rmax_downloads\x18\x03 \x01(\x05R\x0cmaxDownloads\"3\n\x18ListDownloadLinksRequest\x12\x17\n\x07\x66ile_id\x18\x01 \x01(\tR\x06\x66ileId\"H\n\x19ListDownloadLinksResponse\x12+\n\x05links\x18\x01 \x03(\x0b\x32\x15.file.v1.DownloadLinkR\x05links\"D\n\x19RevokeDownloadLinkRequest\x12\x17\n\x07\x66ile_id\x18\x01 \x01(\tR\x06\x66ileId\x12\x0e\n\x02id\x18\x02 \x01(\tR\x02id\"6\n\x1aRevokeDownloadLinkResponse\x12\x18\n\x07success\x18\x01 \x01(\x08R\x07success\"\xbe\x01\n\x05Share\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n\x07\x66ile_id\x18\x02 \x01(\tR\x06\x66ileId\x12\x17\n\x07user_id\x18\x03 \x01(\tR\x06userId\x12\x1a\n\x08username\x18\x04 \x01(\tR\x08username\x12\x38\n\npermission\x18\x05 \x01(\x0e\x32\x18.file.v1.SharePermissionR\npermission\x12\x1d\n\ncreated_at\x18\x06 \x01(\x03R\tcreatedAt\"\x9a\x01\n\x10ShareFileRequest\x12\x17\n\x07\x66ile_id\x18\x01 \x01(\tR\x06\x66ileId\x12\x17\n\x07user_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n\x08username\x18\x03 \x01(\tR\x08username\x12\x38\n\npermission\x18\x04 \x01(\x0e\x32\x18.file.v1.SharePermissionR\npermission\",\n\x11ListSharesRequest\x12\x17\n\x07\x66ile_id\x18\x01 \x01(\tR\x06\x66ileId\"<\n\x12ListSharesResponse\x12&\n\x06shares\x18\x01 \x03(\x0b\x32\x0e.file.v1.ShareR\x06shares\"=\n\x12RevokeShareRequest\x12\x17\n\x07\x66ile_id\x18\x01 \x01(\tR\x06\x66ileId\x12\x0e\n\x02id\x18\x02 \x01(\tR\x02id\"/\n\x13RevokeShareResponse\x12\x18\n\x07success\x18\x01 \x01(\x08R\x07success\"\x19\n\x17ListSharedWithMeRequest\"i\n\nSharedFile\x12!\n\x04\x66ile\x18\x01 \x01(\x0b\x32\r.file.v1.FileR\x04\x66ile\x12\x38\n\npermission\x18\x02 \x01(\x0e\x32\x18.file.v1.SharePermissionR\npermission\"E\n\x18ListSharedWithMeResponse\x12)\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x13.file.v1.SharedFileR\x05\x66iles\"h\n\x06\x46older\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n\tparent_id\x18\x03 \x01(\tR\x08parentId\x12\x1d\n\ncreated_at\x18\x04 \x01(\x03R\tcreatedAt\"F\n\x13\x43reateFolderRequest\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n\tparent_id\x18\x02 \x01(\tR\x08parentId\"9\n\x13RenameFolderRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\"@\n\x11MoveFolderRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n\tparent_id\x18\x02 \x01(\tR\x08parentId\"%\n\x13\x44\x65leteFolderRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"~\n\x14\x44\x65leteFolderResponse\x12\x18\n\x07success\x18\x01 \x01(\x08R\x07success\x12\'\n\x0f\x66olders_deleted\x18\x02 \x01(\x05R\x0e\x66oldersDeleted\x12#\n\rfiles_deleted\x18\x03 \x01(\x05R\x0c\x66ilesDeleted\"\xe6\x01\n\x0b\x41\x64minAction\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n\x08\x61\x64min_id\x18\x02 \x01(\tR\x07\x61\x64minId\x12%\n\x0e\x61\x64min_username\x18\x03 \x01(\tR\radminUsername\x12\x16\n\x06\x61\x63tion\x18\x04 \x01(\tR\x06\x61\x63tion\x12\x17\n\x07\x66ile_id\x18\x05 \x01(\tR\x06\x66ileId\x12\x19\n\x08owner_id\x18\x06 \x01(\tR\x07ownerId\x12\x1a\n\x08\x66ilename\x18\x07 \x01(\tR\x08\x66ilename\x12\x1d\n\ncreated_at\x18\x08 \x01(\x03R\tcreatedAt\"c\n\x17ListAdminActionsRequest\x12\x17\n\x07\x66ile_id\x18\x01 \x01(\tR\x06\x66ileId\x12\x19\n\x08\x61\x64min_id\x18\x02 \x01(\tR\x07\x61\x64minId\x12\x14\n\x05limit\x18\x03 \x01(\x05R\x05limit\"J\n\x18ListAdminActionsResponse\x12.\n\x07\x61\x63tions\x18\x01 \x03(\x0b\x32\x14.file.v1.AdminActionR\x07\x61\x63tions*\x84\x01\n\rFileSortField\x12\x1f\n\x1b\x46ILE_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x18\n\x14\x46ILE_SORT_FIELD_NAME\x10\x01\x12\x18\n\x14\x46ILE_SORT_FIELD_SIZE\x10\x02\x12\x1e\n\x1a\x46ILE_SORT_FIELD_CREATED_AT\x10\x03*\x84\x01\n\x0e\x43onflictPolicy\x12\x1f\n\x1b\x43ONFLICT_POLICY_UNSPECIFIED\x10\x00\x12\x18\n\x14\x43ONFLICT_POLICY_FAIL\x10\x01\x12\x1a\n\x16\x43ONFLICT_POLICY_RENAME\x10\x02\x12\x1b\n\x17\x43ONFLICT_POLICY_REPLACE\x10\x03*m\n\x0fSharePermission\x12 \n\x1cSHARE_PERMISSION_UNSPECIFIED\x10\x00\x12\x19\n\x15SHARE_PERMISSION_VIEW\x10\x01\x12\x1d\n\x19SHARE_PERMISSION_DOWNLOAD\x10\x02\x32\xec\x11\n\x0b\x46ileService\x12\x41\n\nUploadFile\x12\x1a.file.v1.UploadFileRequest\x1a\x15.file.v1.FileResponse(\x01\x12?\n\nCreateFile\x12\x1a.file.v1.CreateFileRequest\x1a\x15.file.v1.FileResponse\x12\x42\n\tListFiles\x12\x19.file.v1.ListFilesRequest\x1a\x1a.file.v1.ListFilesResponse\x12\x39\n\x07GetFile\x12\x17.file.v1.GetFileRequest\x1a\x15.file.v1.FileResponse\x12M\n\x0c\x44ownloadFile\x12\x1c.file.v1.DownloadFileRequest\x1a\x1d.file.v1.DownloadFileResponse0\x01\x12\x45\n\nDeleteFile\x12\x1a.file.v1.DeleteFileRequest\x1a\x1b.file.v1.DeleteFileResponse\x12?\n\nUpdateFile\x12\x1a.file.v1.UpdateFileRequest\x1a\x15.file.v1.FileResponse\x12>\n\x0b\x41\x64\x64\x46ileTags\x12\x18.file.v1.FileTagsRequest\x1a\x15.file.v1.FileResponse\x12\x41\n\x0eRemoveFileTags\x12\x18.file.v1.FileTagsRequest\x1a\x15.file.v1.FileResponse\x12?\n\x08ListTags\x12\x18.file.v1.ListTagsRequest\x1a\x19.file.v1.ListTagsResponse\x12W\n\x10ListAdminActions\x12 .file.v1.ListAdminActionsRequest\x1a!.file.v1.ListAdminActionsResponse\x12?\n\x08GetUsage\x12\x18.file.v1.GetUsageRequest\x1a\x19.file.v1.GetUsageResponse\x12l\n\x17InitiateMultipartUpload\x12\'.file.v1.InitiateMultipartUploadRequest\x1a(.file.v1.InitiateMultipartUploadResponse\x12\x45\n\nUploadPart\x12\x1a.file.v1.UploadPartRequest\x1a\x1b.file.v1.UploadPartResponse\x12S\n\x10UploadPartStream\x12 .file.v1.UploadPartStreamRequest\x1a\x1b.file.v1.UploadPartResponse(\x01\x12Y\n\x17\x43ompleteMultipartUpload\x12\'.file.v1.CompleteMultipartUploadRequest\x1a\x15.file.v1.FileResponse\x12\x63\n\x14\x41\x62ortMultipartUpload\x12$.file.v1.AbortMultipartUploadRequest\x1a%.file.v1.AbortMultipartUploadResponse\x12\x63\n\x14ListMultipartUploads\x12$.file.v1.ListMultipartUploadsRequest\x1a%.file.v1.ListMultipartUploadsResponse\x12\x42\n\tListParts\x12\x19.file.v1.ListPartsRequest\x1a\x1a.file.v1.ListPartsResponse\x12O\n\x12\x43reateDownloadLink\x12\".file.v1.CreateDownloadLinkRequest\x1a\x15.file.v1.DownloadLink\x12Z\n\x11ListDownloadLinks\x12!.file.v1.ListDownloadLinksRequest\x1a\".file.v1.ListDownloadLinksResponse\x12]\n\x12RevokeDownloadLink\x12\".file.v1.RevokeDownloadLinkRequest\x1a#.file.v1.RevokeDownloadLinkResponse\x12\x36\n\tShareFile\x12\x19.file.v1.ShareFileRequest\x1a\x0e.file.v1.Share\x12\x45\n\nListShares\x12\x1a.file.v1.ListSharesRequest\x1a\x1b.file.v1.ListSharesResponse\x12H\n\x0bRevokeShare\x12\x1b.file.v1.RevokeShareRequest\x1a\x1c.file.v1.RevokeShareResponse\x12W\n\x10ListSharedWithMe\x12 .file.v1.ListSharedWithMeRequest\x1a!.file.v1.ListSharedWithMeResponse\x12=\n\x0c\x43reateFolder\x12\x1c.file.v1.CreateFolderRequest\x1a\x0f.file.v1.Folder\x12=\n\x0cRenameFolder\x12\x1c.file.v1.RenameFolderRequest\x1a\x0f.file.v1.Folder\x12\x39\n\nMoveFolder\x12\x1a.file.v1.MoveFolderRequest\x1a\x0f.file.v1.Folder\x12K\n\x0c\x44\x65leteFolder\x12\x1c.file.v1.DeleteFolderRequest\x1a\x1d.file.v1.DeleteFolderResponseB\x8e\x01\n\x0b\x63om.file.v1B\tFileProtoP\x01Z7github.com/provsalt/DOP_P01_Team1/common/file/v1;filev1\xa2\x02\x03\x46XX\xaa\x02\x07\x46ile.V1\xca\x02\x07\x46ile\\V1\xe2\x02\x13\x46ile\\V1\\GPBMetadata\xea\x02\x08\x46ile::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -32,48 +33,142 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'file.v1.file_pb2', _globals
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'\n\013com.file.v1B\tFileProtoP\001Z7github.com/provsalt/DOP_P01_Team1/common/file/v1;filev1\242\002\003FXX\252\002\007File.V1\312\002\007File\\V1\342\002\023File\\V1\\GPBMetadata\352\002\010File::V1'
-  _globals['_FILE']._serialized_start=32
-  _globals['_FILE']._serialized_end=193
-  _globals['_CREATEFILEREQUEST']._serialized_start=195
-  _globals['_CREATEFILEREQUEST']._serialized_end=297
-  _globals['_GETFILEREQUEST']._serialized_start=299
-  _globals['_GETFILEREQUEST']._serialized_end=331
-  _globals['_LISTFILESREQUEST']._serialized_start=333
-  _globals['_LISTFILESREQUEST']._serialized_end=351
-  _globals['_LISTFILESRESPONSE']._serialized_start=353
-  _globals['_LISTFILESRESPONSE']._serialized_end=409
-  _globals['_FILERESPONSE']._serialized_start=411
-  _globals['_FILERESPONSE']._serialized_end=460
-  _globals['_DELETEFILEREQUEST']._serialized_start=462
-  _globals['_DELETEFILEREQUEST']._serialized_end=522
-  _globals['_DELETEFILERESPONSE']._serialized_start=524
-  _globals['_DELETEFILERESPONSE']._serialized_end=570
-  _globals['_UPLOADFILEREQUEST']._serialized_start=572
-  _globals['_UPLOADFILEREQUEST']._serialized_end=682
-  _globals['_UPLOADFILEMETADATA']._serialized_start=684
-  _globals['_UPLOADFILEMETADATA']._serialized_end=767
-  _globals['_DOWNLOADFILEREQUEST']._serialized_start=769
-  _globals['_DOWNLOADFILEREQUEST']._serialized_end=806
-  _globals['_DOWNLOADFILERESPONSE']._serialized_start=808
-  _globals['_DOWNLOADFILERESPONSE']._serialized_end=923
-  _globals['_DOWNLOADFILEMETADATA']._serialized_start=925
-  _globals['_DOWNLOADFILEMETADATA']._serialized_end=1030
-  _globals['_INITIATEMULTIPARTUPLOADREQUEST']._serialized_start=1032
-  _globals['_INITIATEMULTIPARTUPLOADREQUEST']._serialized_end=1158
-  _globals['_INITIATEMULTIPARTUPLOADRESPONSE']._serialized_start=1160
-  _globals['_INITIATEMULTIPARTUPLOADRESPONSE']._serialized_end=1286
-  _globals['_UPLOADPARTREQUEST']._serialized_start=1288
-  _globals['_UPLOADPARTREQUEST']._serialized_end=1391
-  _globals['_UPLOADPARTRESPONSE']._serialized_start=1393
-  _globals['_UPLOADPARTRESPONSE']._serialized_end=1466
-  _globals['_COMPLETEMULTIPARTUPLOADREQUEST']._serialized_start=1468
-  _globals['_COMPLETEMULTIPARTUPLOADREQUEST']._serialized_end=1570
-  _globals['_PARTINFO']._serialized_start=1572
-  _globals['_PARTINFO']._serialized_end=1635
-  _globals['_ABORTMULTIPARTUPLOADREQUEST']._serialized_start=1637
-  _globals['_ABORTMULTIPARTUPLOADREQUEST']._serialized_end=1695
-  _globals['_ABORTMULTIPARTUPLOADRESPONSE']._serialized_start=1697
-  _globals['_ABORTMULTIPARTUPLOADRESPONSE']._serialized_end=1753
-  _globals['_FILESERVICE']._serialized_start=1756
-  _globals['_FILESERVICE']._serialized_end=2551
+  _globals['_FILE_METADATAENTRY']._loaded_options = None
+  _globals['_FILE_METADATAENTRY']._serialized_options = b'8\001'
+  _globals['_UPDATEFILEREQUEST_METADATAENTRY']._loaded_options = None
+  _globals['_UPDATEFILEREQUEST_METADATAENTRY']._serialized_options = b'8\001'
+  _globals['_FILESORTFIELD']._serialized_start=7239
+  _globals['_FILESORTFIELD']._serialized_end=7371
+  _globals['_CONFLICTPOLICY']._serialized_start=7374
+  _globals['_CONFLICTPOLICY']._serialized_end=7506
+  _globals['_SHAREPERMISSION']._serialized_start=7508
+  _globals['_SHAREPERMISSION']._serialized_end=7617
+  _globals['_FILE']._serialized_start=66
+  _globals['_FILE']._serialized_end=453
+  _globals['_FILE_METADATAENTRY']._serialized_start=394
+  _globals['_FILE_METADATAENTRY']._serialized_end=453
+  _globals['_CREATEFILEREQUEST']._serialized_start=455
+  _globals['_CREATEFILEREQUEST']._serialized_end=557
+  _globals['_GETFILEREQUEST']._serialized_start=559
+  _globals['_GETFILEREQUEST']._serialized_end=638
+  _globals['_LISTFILESREQUEST']._serialized_start=641
+  _globals['_LISTFILESREQUEST']._serialized_end=1187
+  _globals['_LISTFILESRESPONSE']._serialized_start=1190
+  _globals['_LISTFILESRESPONSE']._serialized_end=1453
+  _globals['_FILERESPONSE']._serialized_start=1455
+  _globals['_FILERESPONSE']._serialized_end=1504
+  _globals['_DELETEFILEREQUEST']._serialized_start=1506
+  _globals['_DELETEFILEREQUEST']._serialized_end=1588
+  _globals['_UPDATEFILEREQUEST']._serialized_start=1591
+  _globals['_UPDATEFILEREQUEST']._serialized_end=1988
+  _globals['_UPDATEFILEREQUEST_METADATAENTRY']._serialized_start=1929
+  _globals['_UPDATEFILEREQUEST_METADATAENTRY']._serialized_end=1988
+  _globals['_FILETAGSREQUEST']._serialized_start=1990
+  _globals['_FILETAGSREQUEST']._serialized_end=2052
+  _globals['_LISTTAGSREQUEST']._serialized_start=2054
+  _globals['_LISTTAGSREQUEST']._serialized_end=2071
+  _globals['_TAG']._serialized_start=2073
+  _globals['_TAG']._serialized_end=2120
+  _globals['_LISTTAGSRESPONSE']._serialized_start=2122
+  _globals['_LISTTAGSRESPONSE']._serialized_end=2174
+  _globals['_DELETEFILERESPONSE']._serialized_start=2176
+  _globals['_DELETEFILERESPONSE']._serialized_end=2222
+  _globals['_UPLOADFILEREQUEST']._serialized_start=2224
+  _globals['_UPLOADFILEREQUEST']._serialized_end=2334
+  _globals['_UPLOADFILEMETADATA']._serialized_start=2337
+  _globals['_UPLOADFILEMETADATA']._serialized_end=2518
+  _globals['_STORAGEQUOTA']._serialized_start=2520
+  _globals['_STORAGEQUOTA']._serialized_end=2592
+  _globals['_GETUSAGEREQUEST']._serialized_start=2594
+  _globals['_GETUSAGEREQUEST']._serialized_end=2611
+  _globals['_GETUSAGERESPONSE']._serialized_start=2614
+  _globals['_GETUSAGERESPONSE']._serialized_end=2774
+  _globals['_DOWNLOADFILEREQUEST']._serialized_start=2777
+  _globals['_DOWNLOADFILEREQUEST']._serialized_end=2909
+  _globals['_DOWNLOADFILERESPONSE']._serialized_start=2911
+  _globals['_DOWNLOADFILERESPONSE']._serialized_end=3026
+  _globals['_DOWNLOADFILEMETADATA']._serialized_start=3028
+  _globals['_DOWNLOADFILEMETADATA']._serialized_end=3133
+  _globals['_INITIATEMULTIPARTUPLOADREQUEST']._serialized_start=3136
+  _globals['_INITIATEMULTIPARTUPLOADREQUEST']._serialized_end=3336
+  _globals['_INITIATEMULTIPARTUPLOADRESPONSE']._serialized_start=3338
+  _globals['_INITIATEMULTIPARTUPLOADRESPONSE']._serialized_end=3464
+  _globals['_UPLOADPARTREQUEST']._serialized_start=3466
+  _globals['_UPLOADPARTREQUEST']._serialized_end=3593
+  _globals['_UPLOADPARTSTREAMREQUEST']._serialized_start=3595
+  _globals['_UPLOADPARTSTREAMREQUEST']._serialized_end=3711
+  _globals['_UPLOADPARTMETADATA']._serialized_start=3713
+  _globals['_UPLOADPARTMETADATA']._serialized_end=3819
+  _globals['_UPLOADPARTRESPONSE']._serialized_start=3821
+  _globals['_UPLOADPARTRESPONSE']._serialized_end=3918
+  _globals['_COMPLETEMULTIPARTUPLOADREQUEST']._serialized_start=3921
+  _globals['_COMPLETEMULTIPARTUPLOADREQUEST']._serialized_end=4058
+  _globals['_PARTINFO']._serialized_start=4060
+  _globals['_PARTINFO']._serialized_end=4123
+  _globals['_ABORTMULTIPARTUPLOADREQUEST']._serialized_start=4125
+  _globals['_ABORTMULTIPARTUPLOADREQUEST']._serialized_end=4183
+  _globals['_ABORTMULTIPARTUPLOADRESPONSE']._serialized_start=4185
+  _globals['_ABORTMULTIPARTUPLOADRESPONSE']._serialized_end=4241
+  _globals['_MULTIPARTUPLOAD']._serialized_start=4244
+  _globals['_MULTIPARTUPLOAD']._serialized_end=4617
+  _globals['_UPLOADEDPART']._serialized_start=4619
+  _globals['_UPLOADEDPART']._serialized_end=4730
+  _globals['_LISTMULTIPARTUPLOADSREQUEST']._serialized_start=4732
+  _globals['_LISTMULTIPARTUPLOADSREQUEST']._serialized_end=4761
+  _globals['_LISTMULTIPARTUPLOADSRESPONSE']._serialized_start=4763
+  _globals['_LISTMULTIPARTUPLOADSRESPONSE']._serialized_end=4845
+  _globals['_LISTPARTSREQUEST']._serialized_start=4847
+  _globals['_LISTPARTSREQUEST']._serialized_end=4894
+  _globals['_LISTPARTSRESPONSE']._serialized_start=4896
+  _globals['_LISTPARTSRESPONSE']._serialized_end=5010
+  _globals['_DOWNLOADLINK']._serialized_start=5013
+  _globals['_DOWNLOADLINK']._serialized_end=5206
+  _globals['_CREATEDOWNLOADLINKREQUEST']._serialized_start=5208
+  _globals['_CREATEDOWNLOADLINKREQUEST']._serialized_end=5328
+  _globals['_LISTDOWNLOADLINKSREQUEST']._serialized_start=5330
+  _globals['_LISTDOWNLOADLINKSREQUEST']._serialized_end=5381
+  _globals['_LISTDOWNLOADLINKSRESPONSE']._serialized_start=5383
+  _globals['_LISTDOWNLOADLINKSRESPONSE']._serialized_end=5455
+  _globals['_REVOKEDOWNLOADLINKREQUEST']._serialized_start=5457
+  _globals['_REVOKEDOWNLOADLINKREQUEST']._serialized_end=5525
+  _globals['_REVOKEDOWNLOADLINKRESPONSE']._serialized_start=5527
+  _globals['_REVOKEDOWNLOADLINKRESPONSE']._serialized_end=5581
+  _globals['_SHARE']._serialized_start=5584
+  _globals['_SHARE']._serialized_end=5774
+  _globals['_SHAREFILEREQUEST']._serialized_start=5777
+  _globals['_SHAREFILEREQUEST']._serialized_end=5931
+  _globals['_LISTSHARESREQUEST']._serialized_start=5933
+  _globals['_LISTSHARESREQUEST']._serialized_end=5977
+  _globals['_LISTSHARESRESPONSE']._serialized_start=5979
+  _globals['_LISTSHARESRESPONSE']._serialized_end=6039
+  _globals['_REVOKESHAREREQUEST']._serialized_start=6041
+  _globals['_REVOKESHAREREQUEST']._serialized_end=6102
+  _globals['_REVOKESHARERESPONSE']._serialized_start=6104
+  _globals['_REVOKESHARERESPONSE']._serialized_end=6151
+  _globals['_LISTSHAREDWITHMEREQUEST']._serialized_start=6153
+  _globals['_LISTSHAREDWITHMEREQUEST']._serialized_end=6178
+  _globals['_SHAREDFILE']._serialized_start=6180
+  _globals['_SHAREDFILE']._serialized_end=6285
+  _globals['_LISTSHAREDWITHMERESPONSE']._serialized_start=6287
+  _globals['_LISTSHAREDWITHMERESPONSE']._serialized_end=6356
+  _globals['_FOLDER']._serialized_start=6358
+  _globals['_FOLDER']._serialized_end=6462
+  _globals['_CREATEFOLDERREQUEST']._serialized_start=6464
+  _globals['_CREATEFOLDERREQUEST']._serialized_end=6534
+  _globals['_RENAMEFOLDERREQUEST']._serialized_start=6536
+  _globals['_RENAMEFOLDERREQUEST']._serialized_end=6593
+  _globals['_MOVEFOLDERREQUEST']._serialized_start=6595
+  _globals['_MOVEFOLDERREQUEST']._serialized_end=6659
+  _globals['_DELETEFOLDERREQUEST']._serialized_start=6661
+  _globals['_DELETEFOLDERREQUEST']._serialized_end=6698
+  _globals['_DELETEFOLDERRESPONSE']._serialized_start=6700
+  _globals['_DELETEFOLDERRESPONSE']._serialized_end=6826
+  _globals['_ADMINACTION']._serialized_start=6829
+  _globals['_ADMINACTION']._serialized_end=7059
+  _globals['_LISTADMINACTIONSREQUEST']._serialized_start=7061
+  _globals['_LISTADMINACTIONSREQUEST']._serialized_end=7160
+  _globals['_LISTADMINACTIONSRESPONSE']._serialized_start=7162
+  _globals['_LISTADMINACTIONSRESPONSE']._serialized_end=7236
+  _globals['_FILESERVICE']._serialized_start=7620
+  _globals['_FILESERVICE']._serialized_end=9904
 # @@protoc_insertion_point(module_scope)
@@ -44,6 +44,36 @@ class FileServiceStub(object):
                 request_serializer=file_dot_v1_dot_file__pb2.DeleteFileRequest.SerializeToString,
                 response_deserializer=file_dot_v1_dot_file__pb2.DeleteFileResponse.FromString,
                 _registered_method=True)
+        self.UpdateFile = channel.unary_unary(
+                '/file.v1.FileService/UpdateFile',
+                request_serializer=file_dot_v1_dot_file__pb2.UpdateFileRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.FileResponse.FromString,
+                _registered_method=True)
+        self.AddFileTags = channel.unary_unary(
+                '/file.v1.FileService/AddFileTags',
+                request_serializer=file_dot_v1_dot_file__pb2.FileTagsRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.FileResponse.FromString,
+                _registered_method=True)
+        self.RemoveFileTags = channel.unary_unary(
+                '/file.v1.FileService/RemoveFileTags',
+                request_serializer=file_dot_v1_dot_file__pb2.FileTagsRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.FileResponse.FromString,
+                _registered_method=True)
+        self.ListTags = channel.unary_unary(
+                '/file.v1.FileService/ListTags',
+                request_serializer=file_dot_v1_dot_file__pb2.ListTagsRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.ListTagsResponse.FromString,
+                _registered_method=True)
+        self.ListAdminActions = channel.unary_unary(
+                '/file.v1.FileService/ListAdminActions',
+                request_serializer=file_dot_v1_dot_file__pb2.ListAdminActionsRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.ListAdminActionsResponse.FromString,
+                _registered_method=True)
+        self.GetUsage = channel.unary_unary(
+                '/file.v1.FileService/GetUsage',
+                request_serializer=file_dot_v1_dot_file__pb2.GetUsageRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.GetUsageResponse.FromString,
+                _registered_method=True)
         self.InitiateMultipartUpload = channel.unary_unary(
                 '/file.v1.FileService/InitiateMultipartUpload',
                 request_serializer=file_dot_v1_dot_file__pb2.InitiateMultipartUploadRequest.SerializeToString,
@@ -54,6 +84,11 @@ class FileServiceStub(object):
                 request_serializer=file_dot_v1_dot_file__pb2.UploadPartRequest.SerializeToString,
                 response_deserializer=file_dot_v1_dot_file__pb2.UploadPartResponse.FromString,
                 _registered_method=True)
+        self.UploadPartStream = channel.stream_unary(
+                '/file.v1.FileService/UploadPartStream',
+                request_serializer=file_dot_v1_dot_file__pb2.UploadPartStreamRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.UploadPartResponse.FromString,
+                _registered_method=True)
         self.CompleteMultipartUpload = channel.unary_unary(
                 '/file.v1.FileService/CompleteMultipartUpload',
                 request_serializer=file_dot_v1_dot_file__pb2.CompleteMultipartUploadRequest.SerializeToString,
@@ -64,6 +99,71 @@ class FileServiceStub(object):
                 request_serializer=file_dot_v1_dot_file__pb2.AbortMultipartUploadRequest.SerializeToString,
                 response_deserializer=file_dot_v1_dot_file__pb2.AbortMultipartUploadResponse.FromString,
                 _registered_method=True)
+        self.ListMultipartUploads = channel.unary_unary(
+                '/file.v1.FileService/ListMultipartUploads',
+                request_serializer=file_dot_v1_dot_file__pb2.ListMultipartUploadsRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.ListMultipartUploadsResponse.FromString,
+                _registered_method=True)
+        self.ListParts = channel.unary_unary(
+                '/file.v1.FileService/ListParts',
+                request_serializer=file_dot_v1_dot_file__pb2.ListPartsRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.ListPartsResponse.FromString,
+                _registered_method=True)
+        self.CreateDownloadLink = channel.unary_unary(
+                '/file.v1.FileService/CreateDownloadLink',
+                request_serializer=file_dot_v1_dot_file__pb2.CreateDownloadLinkRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.DownloadLink.FromString,
+                _registered_method=True)
+        self.ListDownloadLinks = channel.unary_unary(
+                '/file.v1.FileService/ListDownloadLinks',
+                request_serializer=file_dot_v1_dot_file__pb2.ListDownloadLinksRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.ListDownloadLinksResponse.FromString,
+                _registered_method=True)
+        self.RevokeDownloadLink = channel.unary_unary(
+                '/file.v1.FileService/RevokeDownloadLink',
+                request_serializer=file_dot_v1_dot_file__pb2.RevokeDownloadLinkRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.RevokeDownloadLinkResponse.FromString,
+                _registered_method=True)
+        self.ShareFile = channel.unary_unary(
+                '/file.v1.FileService/ShareFile',
+                request_serializer=file_dot_v1_dot_file__pb2.ShareFileRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.Share.FromString,
+                _registered_method=True)
+        self.ListShares = channel.unary_unary(
+                '/file.v1.FileService/ListShares',
+                request_serializer=file_dot_v1_dot_file__pb2.ListSharesRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.ListSharesResponse.FromString,
+                _registered_method=True)
+        self.RevokeShare = channel.unary_unary(
+                '/file.v1.FileService/RevokeShare',
+                request_serializer=file_dot_v1_dot_file__pb2.RevokeShareRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.RevokeShareResponse.FromString,
+                _registered_method=True)
+        self.ListSharedWithMe = channel.unary_unary(
+                '/file.v1.FileService/ListSharedWithMe',
+                request_serializer=file_dot_v1_dot_file__pb2.ListSharedWithMeRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.ListSharedWithMeResponse.FromString,
+                _registered_method=True)
+        self.CreateFolder = channel.unary_unary(
+                '/file.v1.FileService/CreateFolder',
+                request_serializer=file_dot_v1_dot_file__pb2.CreateFolderRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.Folder.FromString,
+                _registered_method=True)
+        self.RenameFolder = channel.unary_unary(
+                '/file.v1.FileService/RenameFolder',
+                request_serializer=file_dot_v1_dot_file__pb2.RenameFolderRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.Folder.FromString,
+                _registered_method=True)
+        self.MoveFolder = channel.unary_unary(
+                '/file.v1.FileService/MoveFolder',
+                request_serializer=file_dot_v1_dot_file__pb2.MoveFolderRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.Folder.FromString,
+                _registered_method=True)
+        self.DeleteFolder = channel.unary_unary(
+                '/file.v1.FileService/DeleteFolder',
+                request_serializer=file_dot_v1_dot_file__pb2.DeleteFolderRequest.SerializeToString,
+                response_deserializer=file_dot_v1_dot_file__pb2.DeleteFolderResponse.FromString,
+                _registered_method=True)
 
 
 class FileServiceServicer(object):
@@ -105,6 +205,42 @@ class FileServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def UpdateFile(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def AddFileTags(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RemoveFileTags(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListTags(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListAdminActions(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetUsage(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def InitiateMultipartUpload(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -117,6 +253,12 @@ class FileServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def UploadPartStream(self, request_iterator, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def CompleteMultipartUpload(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -129,6 +271,84 @@ class FileServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ListMultipartUploads(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListParts(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CreateDownloadLink(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListDownloadLinks(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RevokeDownloadLink(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ShareFile(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListShares(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RevokeShare(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListSharedWithMe(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CreateFolder(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RenameFolder(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def MoveFolder(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteFolder(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_FileServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -162,6 +382,36 @@ def add_FileServiceServicer_to_server(servicer, server):
                     request_deserializer=file_dot_v1_dot_file__pb2.DeleteFileRequest.FromString,
                     response_serializer=file_dot_v1_dot_file__pb2.DeleteFileResponse.SerializeToString,
             ),
+            'UpdateFile': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateFile,
+                    request_deserializer=file_dot_v1_dot_file__pb2.UpdateFileRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.FileResponse.SerializeToString,
+            ),
+            'AddFileTags': grpc.unary_unary_rpc_method_handler(
+                    servicer.AddFileTags,
+                    request_deserializer=file_dot_v1_dot_file__pb2.FileTagsRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.FileResponse.SerializeToString,
+            ),
+            'RemoveFileTags': grpc.unary_unary_rpc_method_handler(
+                    servicer.RemoveFileTags,
+                    request_deserializer=file_dot_v1_dot_file__pb2.FileTagsRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.FileResponse.SerializeToString,
+            ),
+            'ListTags': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListTags,
+                    request_deserializer=file_dot_v1_dot_file__pb2.ListTagsRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.ListTagsResponse.SerializeToString,
+            ),
+            'ListAdminActions': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListAdminActions,
+                    request_deserializer=file_dot_v1_dot_file__pb2.ListAdminActionsRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.ListAdminActionsResponse.SerializeToString,
+            ),
+            'GetUsage': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetUsage,
+                    request_deserializer=file_dot_v1_dot_file__pb2.GetUsageRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.GetUsageResponse.SerializeToString,
+            ),
             'InitiateMultipartUpload': grpc.unary_unary_rpc_method_handler(
                     servicer.InitiateMultipartUpload,
                     request_deserializer=file_dot_v1_dot_file__pb2.InitiateMultipartUploadRequest.FromString,
@@ -172,6 +422,11 @@ def add_FileServiceServicer_to_server(servicer, server):
                     request_deserializer=file_dot_v1_dot_file__pb2.UploadPartRequest.FromString,
                     response_serializer=file_dot_v1_dot_file__pb2.UploadPartResponse.SerializeToString,
             ),
+            'UploadPartStream': grpc.stream_unary_rpc_method_handler(
+                    servicer.UploadPartStream,
+                    request_deserializer=file_dot_v1_dot_file__pb2.UploadPartStreamRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.UploadPartResponse.SerializeToString,
+            ),
             'CompleteMultipartUpload': grpc.unary_unary_rpc_method_handler(
                     servicer.CompleteMultipartUpload,
                     request_deserializer=file_dot_v1_dot_file__pb2.CompleteMultipartUploadRequest.FromString,
@@ -182,6 +437,71 @@ def add_FileServiceServicer_to_server(servicer, server):
                     request_deserializer=file_dot_v1_dot_file__pb2.AbortMultipartUploadRequest.FromString,
                     response_serializer=file_dot_v1_dot_file__pb2.AbortMultipartUploadResponse.SerializeToString,
             ),
+            'ListMultipartUploads': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListMultipartUploads,
+                    request_deserializer=file_dot_v1_dot_file__pb2.ListMultipartUploadsRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.ListMultipartUploadsResponse.SerializeToString,
+            ),
+            'ListParts': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListParts,
+                    request_deserializer=file_dot_v1_dot_file__pb2.ListPartsRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.ListPartsResponse.SerializeToString,
+            ),
+            'CreateDownloadLink': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateDownloadLink,
+                    request_deserializer=file_dot_v1_dot_file__pb2.CreateDownloadLinkRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.DownloadLink.SerializeToString,
+            ),
+            'ListDownloadLinks': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListDownloadLinks,
+                    request_deserializer=file_dot_v1_dot_file__pb2.ListDownloadLinksRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.ListDownloadLinksResponse.SerializeToString,
+            ),
+            'RevokeDownloadLink': grpc.unary_unary_rpc_method_handler(
+                    servicer.RevokeDownloadLink,
+                    request_deserializer=file_dot_v1_dot_file__pb2.RevokeDownloadLinkRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.RevokeDownloadLinkResponse.SerializeToString,
+            ),
+            'ShareFile': grpc.unary_unary_rpc_method_handler(
+                    servicer.ShareFile,
+                    request_deserializer=file_dot_v1_dot_file__pb2.ShareFileRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.Share.SerializeToString,
+            ),
+            'ListShares': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListShares,
+                    request_deserializer=file_dot_v1_dot_file__pb2.ListSharesRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.ListSharesResponse.SerializeToString,
+            ),
+            'RevokeShare': grpc.unary_unary_rpc_method_handler(
+                    servicer.RevokeShare,
+                    request_deserializer=file_dot_v1_dot_file__pb2.RevokeShareRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.RevokeShareResponse.SerializeToString,
+            ),
+            'ListSharedWithMe': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListSharedWithMe,
+                    request_deserializer=file_dot_v1_dot_file__pb2.ListSharedWithMeRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.ListSharedWithMeResponse.SerializeToString,
+            ),
+            'CreateFolder': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateFolder,
+                    request_deserializer=file_dot_v1_dot_file__pb2.CreateFolderRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.Folder.SerializeToString,
+            ),
+            'RenameFolder': grpc.unary_unary_rpc_method_handler(
+                    servicer.RenameFolder,
+                    request_deserializer=file_dot_v1_dot_file__pb2.RenameFolderRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.Folder.SerializeToString,
+            ),
+            'MoveFolder': grpc.unary_unary_rpc_method_handler(
+                    servicer.MoveFolder,
+                    request_deserializer=file_dot_v1_dot_file__pb2.MoveFolderRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.Folder.SerializeToString,
+            ),
+            'DeleteFolder': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteFolder,
+                    request_deserializer=file_dot_v1_dot_file__pb2.DeleteFolderRequest.FromString,
+                    response_serializer=file_dot_v1_dot_file__pb2.DeleteFolderResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'file.v1.FileService', rpc_method_handlers)
@@ -356,7 +676,7 @@ class FileService(object):
             _registered_method=True)
 
     @staticmethod
-    def InitiateMultipartUpload(request,
+    def UpdateFile(request,
             target,
             options=(),
             channel_credentials=None,
@@ -369,9 +689,9 @@ class FileService(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/file.v1.FileService/InitiateMultipartUpload',
-            file_dot_v1_dot_file__pb2.InitiateMultipartUploadRequest.SerializeToString,
-            file_dot_v1_dot_file__pb2.InitiateMultipartUploadResponse.FromString,
+            '/file.v1.FileService/UpdateFile',
+            file_dot_v1_dot_file__pb2.UpdateFileRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.FileResponse.FromString,
             options,
             channel_credentials,
             insecure,
@@ -383,7 +703,7 @@ class FileService(object):
             _registered_method=True)
 
     @staticmethod
-    def UploadPart(request,
+    def AddFileTags(request,
             target,
             options=(),
             channel_credentials=None,
@@ -396,9 +716,9 @@ class FileService(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/file.v1.FileService/UploadPart',
-            file_dot_v1_dot_file__pb2.UploadPartRequest.SerializeToString,
-            file_dot_v1_dot_file__pb2.UploadPartResponse.FromString,
+            '/file.v1.FileService/AddFileTags',
+            file_dot_v1_dot_file__pb2.FileTagsRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.FileResponse.FromString,
             options,
             channel_credentials,
             insecure,
@@ -410,7 +730,7 @@ class FileService(object):
             _registered_method=True)
 
     @staticmethod
-    def CompleteMultipartUpload(request,
+    def RemoveFileTags(request,
             target,
             options=(),
             channel_credentials=None,
@@ -423,8 +743,8 @@ class FileService(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/file.v1.FileService/CompleteMultipartUpload',
-            file_dot_v1_dot_file__pb2.CompleteMultipartUploadRequest.SerializeToString,
+            '/file.v1.FileService/RemoveFileTags',
+            file_dot_v1_dot_file__pb2.FileTagsRequest.SerializeToString,
             file_dot_v1_dot_file__pb2.FileResponse.FromString,
             options,
             channel_credentials,
@@ -437,7 +757,7 @@ class FileService(object):
             _registered_method=True)
 
     @staticmethod
-    def AbortMultipartUpload(request,
+    def ListTags(request,
             target,
             options=(),
             channel_credentials=None,
@@ -450,9 +770,549 @@ class FileService(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/file.v1.FileService/AbortMultipartUpload',
-            file_dot_v1_dot_file__pb2.AbortMultipartUploadRequest.SerializeToString,
-            file_dot_v1_dot_file__pb2.AbortMultipartUploadResponse.FromString,
+            '/file.v1.FileService/ListTags',
+            file_dot_v1_dot_file__pb2.ListTagsRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.ListTagsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListAdminActions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/ListAdminActions',
+            file_dot_v1_dot_file__pb2.ListAdminActionsRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.ListAdminActionsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetUsage(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/GetUsage',
+            file_dot_v1_dot_file__pb2.GetUsageRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.GetUsageResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def InitiateMultipartUpload(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/InitiateMultipartUpload',
+            file_dot_v1_dot_file__pb2.InitiateMultipartUploadRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.InitiateMultipartUploadResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def UploadPart(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/UploadPart',
+            file_dot_v1_dot_file__pb2.UploadPartRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.UploadPartResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def UploadPartStream(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_unary(
+            request_iterator,
+            target,
+            '/file.v1.FileService/UploadPartStream',
+            file_dot_v1_dot_file__pb2.UploadPartStreamRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.UploadPartResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def CompleteMultipartUpload(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/CompleteMultipartUpload',
+            file_dot_v1_dot_file__pb2.CompleteMultipartUploadRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.FileResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def AbortMultipartUpload(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/AbortMultipartUpload',
+            file_dot_v1_dot_file__pb2.AbortMultipartUploadRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.AbortMultipartUploadResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListMultipartUploads(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/ListMultipartUploads',
+            file_dot_v1_dot_file__pb2.ListMultipartUploadsRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.ListMultipartUploadsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListParts(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/ListParts',
+            file_dot_v1_dot_file__pb2.ListPartsRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.ListPartsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def CreateDownloadLink(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/CreateDownloadLink',
+            file_dot_v1_dot_file__pb2.CreateDownloadLinkRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.DownloadLink.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListDownloadLinks(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/ListDownloadLinks',
+            file_dot_v1_dot_file__pb2.ListDownloadLinksRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.ListDownloadLinksResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def RevokeDownloadLink(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/RevokeDownloadLink',
+            file_dot_v1_dot_file__pb2.RevokeDownloadLinkRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.RevokeDownloadLinkResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ShareFile(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/ShareFile',
+            file_dot_v1_dot_file__pb2.ShareFileRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.Share.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListShares(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/ListShares',
+            file_dot_v1_dot_file__pb2.ListSharesRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.ListSharesResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def RevokeShare(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/RevokeShare',
+            file_dot_v1_dot_file__pb2.RevokeShareRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.RevokeShareResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListSharedWithMe(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/ListSharedWithMe',
+            file_dot_v1_dot_file__pb2.ListSharedWithMeRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.ListSharedWithMeResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def CreateFolder(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/CreateFolder',
+            file_dot_v1_dot_file__pb2.CreateFolderRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.Folder.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def RenameFolder(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/RenameFolder',
+            file_dot_v1_dot_file__pb2.RenameFolderRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.Folder.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def MoveFolder(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/MoveFolder',
+            file_dot_v1_dot_file__pb2.MoveFolderRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.Folder.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DeleteFolder(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/file.v1.FileService/DeleteFolder',
+            file_dot_v1_dot_file__pb2.DeleteFolderRequest.SerializeToString,
+            file_dot_v1_dot_file__pb2.DeleteFolderResponse.FromString,
             options,
             channel_credentials,
             insecure,
@@ -6,10 +6,12 @@ from file_service.service import FileService
 from file_service.auth_client import AuthClient
 from file.v1 import file_pb2_grpc
 from file_service.health import register_health
-from file_service.store import init_upload_session_indexes
+from file_service.store import init_file_indexes, init_upload_session_indexes, init_download_link_indexes, init_share_indexes, init_folder_indexes, init_admin_action_indexes
 from file_service.config import FILE_SERVICE_PORT, SERVICE_NAME, ENVIRONMENT, OTLP_ENDPOINT, AXIOM_TOKEN, DATASET
 SERVICE_PORT = FILE_SERVICE_PORT
 from file_service.telemetry import init_telemetry
+from file_service.tls import server_credentials
+from file_service.service_auth import service_auth_enabled
 
 def serve():
     init_telemetry(
@@ -22,7 +24,12 @@ def serve():
     )
 
     auth_client = AuthClient()
+    init_file_indexes()
     init_upload_session_indexes()
+    init_download_link_indexes()
+    init_share_indexes()
+    init_folder_indexes()
+    init_admin_action_indexes()
 
     max_msg_size = 20 * 1024 * 1024
     server = grpc.server(
@@ -35,8 +42,15 @@ def serve():
     file_pb2_grpc.add_FileServiceServicer_to_server(FileService(auth_client), server)
     register_health(server)
 
-    server.add_insecure_port(f"[::]:{FILE_SERVICE_PORT}")
-    print(f"File service gRPC running on port {FILE_SERVICE_PORT}")
+    if not service_auth_enabled():
+        print("Service authentication disabled: download links are refused until SERVICE_AUTH_SECRET or TLS_CA_FILE is set")
+
+    credentials = server_credentials()
+    if credentials is None:
+        server.add_insecure_port(f"[::]:{FILE_SERVICE_PORT}")
+    else:
+        server.add_secure_port(f"[::]:{FILE_SERVICE_PORT}", credentials)
+    print(f"File service gRPC running on port {FILE_SERVICE_PORT} (tls={credentials is not None})")
     server.start()
     try:
         server.wait_for_termination()
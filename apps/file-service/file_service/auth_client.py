@@ -1,17 +1,53 @@
+import threading
+
 import grpc
 from auth.v1 import auth_pb2, auth_pb2_grpc
 from file_service.config import AUTH_SERVICE_ADDR
+from file_service.tls import channel_credentials
+
+# How long a replaced channel stays open for calls already made on it.
+RETIRED_CHANNEL_GRACE = 60
 
 class AuthClient:
     def __init__(self):
-        self.channel = grpc.insecure_channel(AUTH_SERVICE_ADDR)
+        self._credentials = channel_credentials(AUTH_SERVICE_ADDR)
+        self._lock = threading.Lock()
+        self.channel = None
+        if self._credentials is None:
+            self.channel = grpc.insecure_channel(AUTH_SERVICE_ADDR)
+            self.stub = auth_pb2_grpc.AuthServiceStub(self.channel)
+        else:
+            self._connect()
+
+    def _connect(self):
+        """Dials with fresh credentials, keeping the old channel if they can't be loaded."""
+        try:
+            credentials = self._credentials.load()
+        except (OSError, ValueError) as e:
+            if self.channel is None:
+                raise
+            print(f"TLS credentials reload failed, keeping previous channel: {e}")
+            return
+        retired = self.channel
+        self.channel = grpc.secure_channel(AUTH_SERVICE_ADDR, credentials, options=self._credentials.options)
         self.stub = auth_pb2_grpc.AuthServiceStub(self.channel)
+        if retired is not None:
+            timer = threading.Timer(RETIRED_CHANNEL_GRACE, retired.close)
+            timer.daemon = True
+            timer.start()
 
     def validate_token(self, token: str) -> auth_pb2.ValidateTokenResponse:
+        if self._credentials is not None and self._credentials.stale():
+            with self._lock:
+                if self._credentials.stale():
+                    self._connect()
         try:
             return self.stub.ValidateToken(auth_pb2.ValidateTokenRequest(token=token))
         except grpc.RpcError as e:
             print(f"Auth verification failed: {e}")
+            if self._credentials is not None and e.code() == grpc.StatusCode.UNAVAILABLE:
+                # A dev peer may have restarted with a new certificate.
+                self._credentials.forget_peer()
             raise
 
     def close(self):
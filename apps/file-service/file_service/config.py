@@ -8,6 +8,22 @@ FILE_SERVICE_PORT = os.getenv("FILE_SERVICE_PORT", "50054")
 AUTH_SERVICE_ADDR = os.getenv("AUTH_SERVICE_ADDR", "localhost:8081")
 HTTP_PORT = os.getenv("HTTP_PORT", "3001")
 
+# Storage quota applied when a request doesn't carry the owner's from the
+# gateway, matching user-service's defaults for the user role. 0 is unlimited.
+DEFAULT_QUOTA_MAX_FILES = int(os.getenv("DEFAULT_QUOTA_MAX_FILES", "20"))
+DEFAULT_QUOTA_MAX_BYTES = int(os.getenv("DEFAULT_QUOTA_MAX_BYTES", "10737418240"))
+
+# Signs the service tokens internal services present to each other; must match
+# the other services. Download links are only honoured from a verified gateway.
+SERVICE_AUTH_SECRET = os.getenv("SERVICE_AUTH_SECRET", "")
+
+# gRPC TLS. CERT/KEY are this service's identity; CA verifies peers and
+# requires client certificates on the server. Files are reloaded on change.
+TLS_CERT_FILE = os.getenv("TLS_CERT_FILE", "")
+TLS_KEY_FILE = os.getenv("TLS_KEY_FILE", "")
+TLS_CA_FILE = os.getenv("TLS_CA_FILE", "")
+TLS_DEV_MODE = os.getenv("TLS_DEV_MODE", "false").lower() == "true"
+
 # S3 Configurationq
 S3_ENDPOINT = os.getenv("S3_ENDPOINT", "")
 S3_ACCESS_KEY = os.getenv("S3_ACCESS_KEY", "")
@@ -1,18 +1,81 @@
+import os
+import re
+import base64
+import hashlib
 import time
 import io
 import tempfile
 from datetime import datetime, timezone
 from bson import ObjectId
 from bson.errors import InvalidId
+from pymongo import ASCENDING, DESCENDING, ReturnDocument
+from pymongo.errors import DuplicateKeyError, PyMongoError
 import grpc
 from botocore.exceptions import ClientError
 from file.v1 import file_pb2, file_pb2_grpc
-from file_service.store import files_collection, s3_client, generate_s3_key, upload_sessions_collection
-from file_service.config import S3_BUCKET_NAME
+from user.v1 import user_pb2
+from file_service.store import files_collection, s3_client, generate_s3_key, upload_sessions_collection, UPLOAD_SESSION_TTL, download_links_collection, shares_collection, folders_collection, admin_actions_collection
+from file_service.config import S3_BUCKET_NAME, DEFAULT_QUOTA_MAX_FILES, DEFAULT_QUOTA_MAX_BYTES
 from file_service.auth_client import AuthClient
+from file_service.service_auth import from_gateway
+
+# Size of every multipart part except the last.
+PART_SIZE = 10 * 1024 * 1024
+
+# Largest single file, whatever the owner's quota.
+MAX_FILE_SIZE = 2 * 1024 * 1024 * 1024
+
+MAX_NAME_LENGTH = 255
+
+MAX_TAGS_PER_FILE = 20
+MAX_TAG_LENGTH = 50
+MAX_METADATA_KEYS = 50
+MAX_METADATA_VALUE_LENGTH = 1024
+# Metadata keys become MongoDB field names, so no dots or leading $.
+METADATA_KEY_PATTERN = re.compile(r"[A-Za-z0-9_-]{1,64}")
+
+DEFAULT_ADMIN_ACTIONS = 100
+MAX_ADMIN_ACTIONS = 500
+
+DEFAULT_PAGE_SIZE = 50
+MAX_PAGE_SIZE = 200
+
+SORT_FIELDS = {
+    file_pb2.FILE_SORT_FIELD_UNSPECIFIED: "created_at",
+    file_pb2.FILE_SORT_FIELD_NAME: "filename",
+    file_pb2.FILE_SORT_FIELD_SIZE: "size",
+    file_pb2.FILE_SORT_FIELD_CREATED_AT: "created_at",
+}
+
+# Fields an UpdateFile mask may name.
+UPDATABLE_FILE_FIELDS = {"filename", "content_type", "parent_id", "tags", "metadata"}
 
 
 def get_user_id(context, auth_client):
+    return get_user(context, auth_client).id
+
+
+def require_admin(context, auth_client):
+    user = get_user(context, auth_client)
+    if user.role != user_pb2.ROLE_ADMIN:
+        context.abort(grpc.StatusCode.PERMISSION_DENIED, "Admin role required")
+    return user
+
+
+def record_admin_action(admin, action, doc=None, owner_id=""):
+    """Keep a record of an admin reaching into other users' files."""
+    admin_actions_collection.insert_one({
+        "admin_id": admin.id,
+        "admin_username": admin.username,
+        "action": action,
+        "file_id": str(doc["_id"]) if doc else "",
+        "owner_id": doc["user_id"] if doc else owner_id,
+        "filename": doc["filename"] if doc else "",
+        "created_at": int(time.time()),
+    })
+
+
+def get_user(context, auth_client):
     """Extract and validate authorization token from gRPC metadata."""
     metadata = dict(context.invocation_metadata())
     authorization = metadata.get("authorization")
@@ -37,7 +100,454 @@ def get_user_id(context, auth_client):
     if not response.user:
         context.abort(grpc.StatusCode.UNAUTHENTICATED, "Invalid token: no user")
 
-    return response.user.id
+    return response.user
+
+
+def usage_totals(collection, match, size_field):
+    totals = list(collection.aggregate([
+        {"$match": match},
+        {"$group": {"_id": None, "count": {"$sum": 1}, "bytes": {"$sum": f"${size_field}"}}},
+    ]))
+    return (totals[0]["count"], totals[0]["bytes"]) if totals else (0, 0)
+
+
+def storage_usage(user_id):
+    """Count a user's stored files and bytes, and the multipart uploads still in progress."""
+    file_count, total_bytes = usage_totals(files_collection, {"user_id": user_id}, "size")
+    pending_uploads, pending_bytes = usage_totals(
+        upload_sessions_collection, {"user_id": user_id, "status": "in_progress"}, "total_size"
+    )
+    return file_pb2.GetUsageResponse(
+        file_count=file_count,
+        total_bytes=total_bytes,
+        pending_uploads=pending_uploads,
+        pending_bytes=pending_bytes,
+    )
+
+
+def request_quota(message, context):
+    """The owner's quota sent with message, or the default when it has none.
+
+    Only the gateway, which looks the quota up, is believed; anyone else could
+    send themselves any limit.
+    """
+    if message.HasField("quota") and from_gateway(context):
+        return message.quota
+    return file_pb2.StorageQuota(max_files=DEFAULT_QUOTA_MAX_FILES, max_bytes=DEFAULT_QUOTA_MAX_BYTES)
+
+
+def check_quota(user_id, quota, size, context):
+    """Reject a new file of size bytes that would take the user past quota.
+
+    Uploads in progress count as if they had finished. Nothing is reserved
+    between this check and the upload being recorded, so uploads started at
+    the same moment can still overshoot together. A limit of 0 is unlimited.
+    Returns the bytes left, or None when bytes are unlimited.
+    """
+    usage = storage_usage(user_id)
+    if quota.max_files and usage.file_count + usage.pending_uploads >= quota.max_files:
+        context.abort(
+            grpc.StatusCode.RESOURCE_EXHAUSTED,
+            f"File quota reached ({quota.max_files} files)"
+        )
+    if not quota.max_bytes:
+        return None
+    remaining = quota.max_bytes - usage.total_bytes - usage.pending_bytes
+    if size > remaining:
+        context.abort(grpc.StatusCode.RESOURCE_EXHAUSTED, "Storage quota exceeded")
+    return remaining
+
+
+def find_linked_file(link_id, context, redeem=False):
+    """Resolve an active download link to its file. Redeeming counts a download.
+
+    Link IDs are only honoured from the gateway, which checks the signed token
+    they came in; anyone else could guess one.
+    """
+    if not from_gateway(context):
+        context.abort(grpc.StatusCode.PERMISSION_DENIED, "Download links are only accepted from the gateway")
+    try:
+        link_oid = ObjectId(link_id)
+    except InvalidId:
+        context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Invalid link id format")
+
+    query = {"_id": link_oid, **active_link_filter()}
+    if redeem:
+        link = download_links_collection.find_one_and_update(query, {"$inc": {"download_count": 1}})
+    else:
+        link = download_links_collection.find_one(query)
+    if not link:
+        context.abort(grpc.StatusCode.NOT_FOUND, "Download link not found or expired")
+
+    doc = files_collection.find_one({"_id": ObjectId(link["file_id"]), "user_id": link["user_id"]})
+    if not doc:
+        context.abort(grpc.StatusCode.NOT_FOUND, "File not found")
+    return doc
+
+
+def active_link_filter():
+    """Links that have not expired or used up their downloads. A max_downloads of 0 means unlimited."""
+    return {
+        "expires_at": {"$gt": int(time.time())},
+        "$or": [
+            {"max_downloads": 0},
+            {"$expr": {"$lt": ["$download_count", "$max_downloads"]}},
+        ],
+    }
+
+
+def find_shared_file(file_oid, user_id, permission, context):
+    """Resolve a file someone else shared with user_id.
+
+    Permissions are ordered, so a download share also grants viewing.
+    """
+    share = shares_collection.find_one({"file_id": str(file_oid), "user_id": user_id})
+    doc = files_collection.find_one({"_id": file_oid}) if share else None
+    if not doc:
+        context.abort(grpc.StatusCode.NOT_FOUND, "File not found")
+    if share["permission"] < permission:
+        context.abort(grpc.StatusCode.PERMISSION_DENIED, "File is shared with you for viewing only")
+    return doc
+
+
+def validate_name(name, context):
+    """Reject names that could not be used as a single path segment."""
+    if not name or name in (".", "..") or len(name) > MAX_NAME_LENGTH:
+        context.abort(grpc.StatusCode.INVALID_ARGUMENT, f"name must be 1-{MAX_NAME_LENGTH} characters and not . or ..")
+    if any(c in "/\\" or ord(c) < 32 or ord(c) == 127 for c in name):
+        context.abort(grpc.StatusCode.INVALID_ARGUMENT, "name must not contain path separators or control characters")
+
+
+def validate_content_type(content_type, context):
+    kind, slash, subtype = content_type.partition("/")
+    if not (kind and slash and subtype) or any(ord(c) < 32 or ord(c) == 127 for c in content_type):
+        context.abort(grpc.StatusCode.INVALID_ARGUMENT, "content_type must be a media type such as text/plain")
+
+
+def normalize_tags(tags, context):
+    """Trim and lower-case tags, dropping duplicates but keeping their order."""
+    normalized = []
+    for tag in tags:
+        tag = tag.strip().lower()
+        if not tag or len(tag) > MAX_TAG_LENGTH or any(ord(c) < 32 or ord(c) == 127 for c in tag):
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, f"tags must be 1-{MAX_TAG_LENGTH} printable characters")
+        if tag not in normalized:
+            normalized.append(tag)
+    return normalized
+
+
+def check_tag_count(tags, context):
+    if len(tags) > MAX_TAGS_PER_FILE:
+        context.abort(grpc.StatusCode.INVALID_ARGUMENT, f"A file can have at most {MAX_TAGS_PER_FILE} tags")
+
+
+def validate_metadata(metadata, context):
+    if len(metadata) > MAX_METADATA_KEYS:
+        context.abort(grpc.StatusCode.INVALID_ARGUMENT, f"A file can have at most {MAX_METADATA_KEYS} metadata keys")
+    for key, value in metadata.items():
+        if not METADATA_KEY_PATTERN.fullmatch(key):
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, f"Invalid metadata key {key!r}: use 1-64 letters, digits, _ or -")
+        if len(value) > MAX_METADATA_VALUE_LENGTH:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, f"Metadata values must be at most {MAX_METADATA_VALUE_LENGTH} characters")
+
+
+def search_filter(request, context):
+    """Build the MongoDB conditions for the search fields of a ListFilesRequest."""
+    query = {}
+    if request.query:
+        query["filename"] = {"$regex": re.escape(request.query), "$options": "i"}
+    if request.tags:
+        query["tags"] = {"$all": normalize_tags(request.tags, context)}
+    if request.content_type:
+        # "image" and "image/*" match every image type; anything else must match exactly.
+        kind = request.content_type.lower().removesuffix("/*")
+        if "/" in kind:
+            query["content_type"] = {"$regex": f"^{re.escape(kind)}$", "$options": "i"}
+        else:
+            query["content_type"] = {"$regex": f"^{re.escape(kind)}/", "$options": "i"}
+
+    size = {}
+    if request.HasField("min_size"):
+        size["$gte"] = request.min_size
+    if request.HasField("max_size"):
+        size["$lte"] = request.max_size
+    if size:
+        query["size"] = size
+
+    created = {}
+    if request.HasField("created_after"):
+        created["$gte"] = request.created_after
+    if request.HasField("created_before"):
+        created["$lt"] = request.created_before
+    if created:
+        query["created_at"] = created
+    return query
+
+
+def page_fingerprint(request):
+    """Identify the folder, filters and sort order a page token belongs to."""
+    key = file_pb2.ListFilesRequest()
+    key.CopyFrom(request)
+    key.ClearField("page_token")
+    key.ClearField("page_size")
+    return hashlib.sha256(key.SerializeToString(deterministic=True)).hexdigest()[:16]
+
+
+def encode_page_token(offset, fingerprint):
+    return base64.urlsafe_b64encode(f"{offset}:{fingerprint}".encode()).decode().rstrip("=")
+
+
+def decode_page_token(token, fingerprint, context):
+    try:
+        raw = base64.urlsafe_b64decode(token + "=" * (-len(token) % 4)).decode()
+        offset, token_fingerprint = raw.split(":")
+        offset = int(offset)
+    except (ValueError, UnicodeDecodeError):
+        context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Invalid page token")
+    if offset < 0 or token_fingerprint != fingerprint:
+        context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Page token does not match this query")
+    return offset
+
+
+def page_files(request, query, response, context):
+    """Fill response with the page of files matching query that request asks for."""
+    page_size = request.page_size or DEFAULT_PAGE_SIZE
+    if not 0 < page_size <= MAX_PAGE_SIZE:
+        context.abort(grpc.StatusCode.INVALID_ARGUMENT, f"page_size must be between 1 and {MAX_PAGE_SIZE}")
+    field = SORT_FIELDS.get(request.sort_by)
+    if field is None:
+        context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Unknown sort field")
+
+    fingerprint = page_fingerprint(request)
+    offset = decode_page_token(request.page_token, fingerprint, context) if request.page_token else 0
+
+    # _id breaks ties so pages don't overlap when sort values repeat.
+    direction = DESCENDING if request.descending else ASCENDING
+    sort = [(field, direction), ("_id", direction)]
+    docs = list(files_collection.find(query, sort=sort, skip=offset, limit=page_size))
+
+    # A short page is the last one, so only full pages need a count.
+    if len(docs) < page_size and (docs or offset == 0):
+        total = offset + len(docs)
+    else:
+        total = files_collection.count_documents(query)
+
+    response.files.extend(file_to_pb(d) for d in docs)
+    response.total_count = total
+    if offset + page_size < total:
+        response.next_page_token = encode_page_token(offset + page_size, fingerprint)
+    if offset > 0:
+        response.prev_page_token = encode_page_token(max(offset - page_size, 0), fingerprint)
+
+
+def find_any_file(file_id, context):
+    """Look up a file whoever owns it. Only for admin requests."""
+    try:
+        file_oid = ObjectId(file_id)
+    except InvalidId:
+        context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Invalid file id format")
+
+    doc = files_collection.find_one({"_id": file_oid})
+    if not doc:
+        context.abort(grpc.StatusCode.NOT_FOUND, "File not found")
+    return doc
+
+
+def find_folder(user_id, folder_id, context):
+    try:
+        folder_oid = ObjectId(folder_id)
+    except InvalidId:
+        context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Invalid folder id format")
+
+    folder = folders_collection.find_one({"_id": folder_oid, "user_id": user_id})
+    if not folder:
+        context.abort(grpc.StatusCode.NOT_FOUND, "Folder not found")
+    return folder
+
+
+def check_parent_folder(user_id, parent_id, context):
+    """An empty parent_id is the top level; anything else must be one of the caller's folders."""
+    if parent_id:
+        find_folder(user_id, parent_id, context)
+
+
+def in_folder(parent_id):
+    """Match documents directly inside a folder. Files uploaded before folders existed have no parent_id."""
+    return parent_id if parent_id else {"$in": ["", None]}
+
+
+def folder_path(user_id, folder):
+    """The folders from the top level down to and including folder."""
+    path = []
+    while folder:
+        path.append(folder)
+        parent_id = folder.get("parent_id")
+        folder = folders_collection.find_one({"_id": ObjectId(parent_id), "user_id": user_id}) if parent_id else None
+    return path[::-1]
+
+
+def resolve_name_conflict(user_id, file_oid, filename, parent_id, policy, context):
+    """Apply the conflict policy when another file in the folder already has filename.
+
+    Returns the name the file should take: the requested one, or for
+    CONFLICT_POLICY_RENAME the first free "name (n).ext".
+    """
+    siblings = {"user_id": user_id, "parent_id": in_folder(parent_id), "_id": {"$ne": file_oid}}
+    existing = files_collection.find_one({**siblings, "filename": filename})
+    if not existing:
+        return filename
+
+    if policy == file_pb2.CONFLICT_POLICY_REPLACE:
+        remove_file(user_id, existing)
+        return filename
+    if policy == file_pb2.CONFLICT_POLICY_RENAME:
+        stem, ext = os.path.splitext(filename)
+        n = 1
+        while True:
+            candidate = f"{stem} ({n}){ext}"
+            if not files_collection.find_one({**siblings, "filename": candidate}):
+                validate_name(candidate, context)
+                return candidate
+            n += 1
+
+    context.abort(grpc.StatusCode.ALREADY_EXISTS, f"A file named {filename} already exists in this folder")
+
+
+def remove_file(user_id, doc):
+    """Delete a file's object and metadata along with its links and shares. Returns the number of files deleted."""
+    s3_key = doc.get("s3_key")
+    if s3_key:
+        try:
+            s3_client.delete_object(Bucket=S3_BUCKET_NAME, Key=s3_key)
+        except ClientError as e:
+            # Log error but continue with MongoDB deletion
+            print(f"Warning: Failed to delete file from S3: {str(e)}")
+
+    file_id = str(doc["_id"])
+    res = files_collection.delete_one({"_id": ObjectId(file_id), "user_id": user_id})
+    download_links_collection.delete_many({"file_id": file_id, "user_id": user_id})
+    shares_collection.delete_many({"file_id": file_id, "owner_id": user_id})
+    return res.deleted_count
+
+
+def folder_to_pb(folder):
+    return file_pb2.Folder(
+        id=str(folder["_id"]),
+        name=folder["name"],
+        parent_id=folder.get("parent_id", ""),
+        created_at=folder["created_at"],
+    )
+
+
+def file_to_pb(doc):
+    return file_pb2.File(
+        id=str(doc["_id"]),
+        user_id=doc["user_id"],
+        filename=doc["filename"],
+        size=doc["size"],
+        content_type=doc["content_type"],
+        created_at=doc["created_at"],
+        parent_id=doc.get("parent_id", ""),
+        tags=doc.get("tags", []),
+        metadata=doc.get("metadata", {}),
+        sha256=doc.get("sha256", ""),
+        parts_sha256=doc.get("parts_sha256", ""),
+    )
+
+
+def verify_checksum(digest, expected, context):
+    """Abort unless digest matches the hex SHA-256 the client sent, if any."""
+    if expected and digest != expected.lower():
+        context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Checksum mismatch")
+
+
+def parts_sha256(session, parts):
+    """Hash the listed parts' SHA-256 digests, joined in part order.
+
+    S3 can't hash a multipart object as a whole, and reading it back costs as
+    much as the upload, so files uploaded in parts carry this composite
+    instead. Returns None if any part was stored without a digest.
+    """
+    digests = {p["part_number"]: p.get("sha256") for p in session.get("parts", [])}
+    combined = hashlib.sha256()
+    for part in sorted(parts, key=lambda p: p.part_number):
+        digest = digests.get(part.part_number)
+        if not digest:
+            return None
+        combined.update(bytes.fromhex(digest))
+    return combined.hexdigest()
+
+
+def discard_completed_upload(upload_id, s3_key):
+    """Delete a joined multipart object and its session after failing to record it."""
+    try:
+        s3_client.delete_object(Bucket=S3_BUCKET_NAME, Key=s3_key)
+    except ClientError as e:
+        print(f"Warning: Failed to delete S3 object {s3_key}: {str(e)}")
+    try:
+        upload_sessions_collection.delete_one({"upload_id": upload_id})
+    except PyMongoError as e:
+        print(f"Warning: Failed to delete upload session {upload_id}: {str(e)}")
+
+
+def epoch_seconds(value):
+    """Mongo hands datetimes back naive, in UTC."""
+    if value.tzinfo is None:
+        value = value.replace(tzinfo=timezone.utc)
+    return int(value.timestamp())
+
+
+def upload_to_pb(session):
+    parts = session.get("parts", [])
+    created_at = epoch_seconds(session["created_at"])
+    return file_pb2.MultipartUpload(
+        upload_id=session["upload_id"],
+        filename=session["filename"],
+        content_type=session["content_type"],
+        total_size=session["total_size"],
+        parent_id=session.get("parent_id", ""),
+        chunk_size=PART_SIZE,
+        total_parts=(session["total_size"] + PART_SIZE - 1) // PART_SIZE,
+        parts_uploaded=len(parts),
+        uploaded_bytes=sum(part.get("size", 0) for part in parts),
+        created_at=created_at,
+        expires_at=created_at + UPLOAD_SESSION_TTL,
+    )
+
+
+def admin_action_to_pb(action):
+    return file_pb2.AdminAction(
+        id=str(action["_id"]),
+        admin_id=action["admin_id"],
+        admin_username=action.get("admin_username", ""),
+        action=action["action"],
+        file_id=action.get("file_id", ""),
+        owner_id=action.get("owner_id", ""),
+        filename=action.get("filename", ""),
+        created_at=action["created_at"],
+    )
+
+
+def share_to_pb(share):
+    return file_pb2.Share(
+        id=str(share["_id"]),
+        file_id=share["file_id"],
+        user_id=share["user_id"],
+        username=share["username"],
+        permission=share["permission"],
+        created_at=share["created_at"],
+    )
+
+
+def link_to_pb(link):
+    return file_pb2.DownloadLink(
+        id=str(link["_id"]),
+        file_id=link["file_id"],
+        expires_at=link["expires_at"],
+        max_downloads=link["max_downloads"],
+        download_count=link["download_count"],
+        created_at=link["created_at"],
+    )
 
 
 class FileService(file_pb2_grpc.FileServiceServicer):
@@ -48,6 +558,7 @@ class FileService(file_pb2_grpc.FileServiceServicer):
         user_id = get_user_id(context, self.auth_client)
 
         filename = request.filename
+        validate_name(filename, context)
         size = request.size
         content_type = request.content_type or "application/octet-stream"
 
@@ -80,16 +591,6 @@ class FileService(file_pb2_grpc.FileServiceServicer):
         """Stream upload file to S3 and save metadata."""
         user_id = get_user_id(context, self.auth_client)
 
-        MAX_FILES_PER_USER = 20
-        MAX_FILE_SIZE = 2 * 1024 * 1024 * 1024
-
-        file_count = files_collection.count_documents({"user_id": user_id})
-        if file_count >= MAX_FILES_PER_USER:
-            context.abort(
-                grpc.StatusCode.RESOURCE_EXHAUSTED,
-                "Maximum file limit reached (20 files per user)"
-            )
-
         try:
             first_request = next(request_iterator)
             if not first_request.HasField("metadata"):
@@ -97,13 +598,20 @@ class FileService(file_pb2_grpc.FileServiceServicer):
             
             metadata = first_request.metadata
             filename = metadata.filename
+            validate_name(filename, context)
             content_type = metadata.content_type or "application/octet-stream"
+            check_parent_folder(user_id, metadata.parent_id, context)
+
+            # The gateway sends the owner's quota; without one the default
+            # applies.
+            remaining = check_quota(user_id, request_quota(metadata, context), 0, context)
             
             # Generate unique file ID and S3 key
             file_id = str(ObjectId())
             s3_key = generate_s3_key(user_id, file_id, filename)
             
             file_buffer = tempfile.SpooledTemporaryFile(max_size=10 * 1024 * 1024)
+            digest = hashlib.sha256()
             total_size = 0
             for request in request_iterator:
                 if request.HasField("chunk"):
@@ -116,8 +624,24 @@ class FileService(file_pb2_grpc.FileServiceServicer):
                             grpc.StatusCode.RESOURCE_EXHAUSTED,
                             "File size exceeds maximum allowed size (2GB)"
                         )
+                    if remaining is not None and total_size > remaining:
+                        file_buffer.close()
+                        context.abort(grpc.StatusCode.RESOURCE_EXHAUSTED, "Storage quota exceeded")
 
                     file_buffer.write(request.chunk)
+                    digest.update(request.chunk)
+
+            sha256 = digest.hexdigest()
+            if metadata.sha256 and sha256 != metadata.sha256.lower():
+                file_buffer.close()
+                context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Checksum mismatch")
+
+            # The folder may have been deleted while the file streamed in.
+            if metadata.parent_id and not folders_collection.find_one(
+                {"_id": ObjectId(metadata.parent_id), "user_id": user_id}, {"_id": 1}
+            ):
+                file_buffer.close()
+                context.abort(grpc.StatusCode.NOT_FOUND, "Folder not found")
 
             # Upload to S3
             file_buffer.seek(0)
@@ -143,80 +667,110 @@ class FileService(file_pb2_grpc.FileServiceServicer):
                 "size": file_size,
                 "content_type": content_type,
                 "s3_key": s3_key,
+                "parent_id": metadata.parent_id,
+                "sha256": sha256,
                 "created_at": int(time.time())
             }
             files_collection.insert_one(doc)
             
             return file_pb2.FileResponse(
-                file=file_pb2.File(
-                    id=file_id,
-                    user_id=user_id,
-                    filename=filename,
-                    size=file_size,
-                    content_type=content_type,
-                    created_at=doc["created_at"]
-                )
+                file=file_to_pb(doc)
             )
         except StopIteration:
             context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Empty upload stream")
 
     def ListFiles(self, request, context):
+        if request.admin:
+            return self._admin_list_files(request, context)
+
         user_id = get_user_id(context, self.auth_client)
 
-        docs = files_collection.find({"user_id": user_id})
+        query = {"user_id": user_id, **search_filter(request, context)}
+        response = file_pb2.ListFilesResponse()
+
+        # Without a folder_id every file is listed, regardless of folder.
+        # Subfolders aren't paged; each page of a folder carries all of them.
+        if request.HasField("folder_id"):
+            folder = find_folder(user_id, request.folder_id, context) if request.folder_id else None
+            parent = in_folder(request.folder_id)
+            query["parent_id"] = parent
+            folders = folders_collection.find({"user_id": user_id, "parent_id": parent}).sort("name", 1)
+            response.folders.extend(folder_to_pb(f) for f in folders)
+            response.breadcrumbs.extend(folder_to_pb(f) for f in folder_path(user_id, folder))
+
+        page_files(request, query, response, context)
+        return response
+
+    def _admin_list_files(self, request, context):
+        """List files across every user, or one owner's with owner_id."""
+        admin = require_admin(context, self.auth_client)
+        if request.HasField("folder_id"):
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "folder_id cannot be used when listing as an admin")
+
+        query = search_filter(request, context)
+        if request.owner_id:
+            query["user_id"] = request.owner_id
+
+        response = file_pb2.ListFilesResponse()
+        page_files(request, query, response, context)
+        record_admin_action(admin, "list_files", owner_id=request.owner_id)
+        return response
+
+    def _find_file(self, request, context, redeem_link=False, permission=file_pb2.SHARE_PERMISSION_VIEW):
+        """Look up the requested file through a download link, or one the caller owns or has been shared.
+
+        An admin lookup is always recorded.
+        """
+        if request.link_id:
+            return find_linked_file(request.link_id, context, redeem=redeem_link)
+
+        if request.admin:
+            admin = require_admin(context, self.auth_client)
+            doc = find_any_file(request.id, context)
+            action = "download_file" if permission == file_pb2.SHARE_PERMISSION_DOWNLOAD else "view_file"
+            record_admin_action(admin, action, doc)
+            return doc
 
-        return file_pb2.ListFilesResponse(
-            files=[
-                file_pb2.File(
-                    id=str(d["_id"]),
-                    user_id=d["user_id"],
-                    filename=d["filename"],
-                    size=d["size"],
-                    content_type=d["content_type"],
-                    created_at=d["created_at"]
-                ) for d in docs
-            ]
-        )
-
-    def GetFile(self, request, context):
         user_id = get_user_id(context, self.auth_client)
 
         try:
-            doc = files_collection.find_one({"_id": ObjectId(request.id), "user_id": user_id})
+            file_oid = ObjectId(request.id)
         except InvalidId:
             context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Invalid file id format")
 
+        doc = files_collection.find_one({"_id": file_oid, "user_id": user_id})
         if not doc:
-            context.abort(grpc.StatusCode.NOT_FOUND, "File not found")
+            doc = find_shared_file(file_oid, user_id, permission, context)
+        return doc
+
+    def GetFile(self, request, context):
+        doc = self._find_file(request, context)
 
         return file_pb2.FileResponse(
-            file=file_pb2.File(
-                id=str(doc["_id"]),
-                user_id=doc["user_id"],
-                filename=doc["filename"],
-                size=doc["size"],
-                content_type=doc["content_type"],
-                created_at=doc["created_at"]
-            )
+            file=file_to_pb(doc)
         )
 
 
     def DownloadFile(self, request, context):
         """Stream download file from S3."""
-        user_id = get_user_id(context, self.auth_client)
-        
-        try:
-            doc = files_collection.find_one({"_id": ObjectId(request.id), "user_id": user_id})
-        except InvalidId:
-            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Invalid file id format")
-        
-        if not doc:
-            context.abort(grpc.StatusCode.NOT_FOUND, "File not found")
-        
+        doc = self._find_file(request, context, redeem_link=True, permission=file_pb2.SHARE_PERMISSION_DOWNLOAD)
+
         s3_key = doc.get("s3_key")
         if not s3_key:
             context.abort(grpc.StatusCode.INTERNAL, "File metadata missing S3 key")
-        
+
+        # offset/length select a byte range; a zero length reads to the end.
+        # The metadata still reports the size of the whole file.
+        if request.offset < 0 or request.length < 0:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "offset and length must not be negative")
+        if request.offset > 0 and request.offset >= doc["size"]:
+            context.abort(grpc.StatusCode.OUT_OF_RANGE, "offset is beyond the end of the file")
+        get_args = {"Bucket": S3_BUCKET_NAME, "Key": s3_key}
+        if request.length > 0:
+            get_args["Range"] = f"bytes={request.offset}-{request.offset + request.length - 1}"
+        elif request.offset > 0:
+            get_args["Range"] = f"bytes={request.offset}-"
+
         try:
             # First, yield metadata
             yield file_pb2.DownloadFileResponse(
@@ -228,7 +782,7 @@ class FileService(file_pb2_grpc.FileServiceServicer):
             )
             
             # Stream file from S3 in chunks
-            response = s3_client.get_object(Bucket=S3_BUCKET_NAME, Key=s3_key)
+            response = s3_client.get_object(**get_args)
             chunk_size = 64 * 1024  # 64KB chunks
             
             try:
@@ -244,6 +798,12 @@ class FileService(file_pb2_grpc.FileServiceServicer):
             context.abort(grpc.StatusCode.INTERNAL, f"Failed to download file from S3: {str(e)}")
 
     def DeleteFile(self, request, context):
+        if request.admin:
+            admin = require_admin(context, self.auth_client)
+            doc = find_any_file(request.id, context)
+            record_admin_action(admin, "delete_file", doc)
+            return file_pb2.DeleteFileResponse(success=remove_file(doc["user_id"], doc) == 1)
+
         user_id = get_user_id(context, self.auth_client)
 
         try:
@@ -254,46 +814,144 @@ class FileService(file_pb2_grpc.FileServiceServicer):
         if not doc:
             context.abort(grpc.StatusCode.NOT_FOUND, "File not found")
 
-        # Delete from S3 if s3_key exists
-        s3_key = doc.get("s3_key")
-        if s3_key:
-            try:
-                s3_client.delete_object(Bucket=S3_BUCKET_NAME, Key=s3_key)
-            except ClientError as e:
-                # Log error but continue with MongoDB deletion
-                print(f"Warning: Failed to delete file from S3: {str(e)}")
+        return file_pb2.DeleteFileResponse(success=remove_file(user_id, doc) == 1)
+
+    def UpdateFile(self, request, context):
+        """Change the fields named in update_mask; other fields in the request are ignored."""
+        user_id = get_user_id(context, self.auth_client)
+
+        paths = set(request.update_mask.paths)
+        if not paths:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "update_mask must name at least one field")
+        unknown = paths - UPDATABLE_FILE_FIELDS
+        if unknown:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, f"Cannot update {', '.join(sorted(unknown))}")
+
+        doc = self._owned_file(user_id, request.id, context)
+        file_oid = doc["_id"]
+
+        changes = {}
+        if "filename" in paths:
+            validate_name(request.filename, context)
+            changes["filename"] = request.filename
+        if "content_type" in paths:
+            validate_content_type(request.content_type, context)
+            changes["content_type"] = request.content_type
+        if "parent_id" in paths:
+            check_parent_folder(user_id, request.parent_id, context)
+            changes["parent_id"] = request.parent_id
+        if "tags" in paths:
+            changes["tags"] = normalize_tags(request.tags, context)
+            check_tag_count(changes["tags"], context)
+        if "metadata" in paths:
+            validate_metadata(request.metadata, context)
+            changes["metadata"] = dict(request.metadata)
+
+        if "filename" in changes or "parent_id" in changes:
+            changes["filename"] = resolve_name_conflict(
+                user_id,
+                file_oid,
+                changes.get("filename", doc["filename"]),
+                changes.get("parent_id", doc.get("parent_id", "")),
+                request.on_conflict,
+                context,
+            )
+
+        files_collection.update_one({"_id": file_oid, "user_id": user_id}, {"$set": changes})
+        doc.update(changes)
+        return file_pb2.FileResponse(file=file_to_pb(doc))
+
+    def AddFileTags(self, request, context):
+        user_id = get_user_id(context, self.auth_client)
+        doc = self._owned_file(user_id, request.file_id, context)
+
+        tags = doc.get("tags", [])
+        tags = tags + [t for t in normalize_tags(request.tags, context) if t not in tags]
+        check_tag_count(tags, context)
+
+        files_collection.update_one({"_id": doc["_id"], "user_id": user_id}, {"$set": {"tags": tags}})
+        doc["tags"] = tags
+        return file_pb2.FileResponse(file=file_to_pb(doc))
+
+    def RemoveFileTags(self, request, context):
+        user_id = get_user_id(context, self.auth_client)
+        doc = self._owned_file(user_id, request.file_id, context)
+
+        removed = normalize_tags(request.tags, context)
+        tags = [t for t in doc.get("tags", []) if t not in removed]
+
+        files_collection.update_one({"_id": doc["_id"], "user_id": user_id}, {"$set": {"tags": tags}})
+        doc["tags"] = tags
+        return file_pb2.FileResponse(file=file_to_pb(doc))
+
+    def ListTags(self, request, context):
+        """List every tag the caller uses with the number of files carrying it."""
+        user_id = get_user_id(context, self.auth_client)
+
+        results = files_collection.aggregate([
+            {"$match": {"user_id": user_id}},
+            {"$unwind": "$tags"},
+            {"$group": {"_id": "$tags", "count": {"$sum": 1}}},
+            {"$sort": {"_id": 1}},
+        ])
+        return file_pb2.ListTagsResponse(
+            tags=[file_pb2.Tag(name=r["_id"], count=r["count"]) for r in results]
+        )
+
+    def ListAdminActions(self, request, context):
+        """List recorded admin actions, newest first."""
+        require_admin(context, self.auth_client)
+
+        limit = request.limit or DEFAULT_ADMIN_ACTIONS
+        if not 0 < limit <= MAX_ADMIN_ACTIONS:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, f"limit must be between 1 and {MAX_ADMIN_ACTIONS}")
+
+        query = {}
+        if request.file_id:
+            query["file_id"] = request.file_id
+        if request.admin_id:
+            query["admin_id"] = request.admin_id
+
+        actions = admin_actions_collection.find(query, sort=[("created_at", DESCENDING), ("_id", DESCENDING)], limit=limit)
+        return file_pb2.ListAdminActionsResponse(actions=[admin_action_to_pb(a) for a in actions])
+
+    def GetUsage(self, request, context):
+        user_id = get_user_id(context, self.auth_client)
+        return storage_usage(user_id)
 
-        # Delete from MongoDB
-        res = files_collection.delete_one({"_id": ObjectId(request.id), "user_id": user_id})
-        return file_pb2.DeleteFileResponse(success=res.deleted_count == 1)
+    def _owned_file(self, user_id, file_id, context):
+        try:
+            file_oid = ObjectId(file_id)
+        except InvalidId:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Invalid file id format")
+
+        doc = files_collection.find_one({"_id": file_oid, "user_id": user_id})
+        if not doc:
+            context.abort(grpc.StatusCode.NOT_FOUND, "File not found")
+        return doc
 
     def InitiateMultipartUpload(self, request, context):
         user_id = get_user_id(context, self.auth_client)
 
         filename = request.filename
+        validate_name(filename, context)
         content_type = request.content_type or "application/octet-stream"
         total_size = request.total_size
 
         if total_size <= 0:
             context.abort(grpc.StatusCode.INVALID_ARGUMENT, "total_size must be greater than 0")
 
-        MAX_FILE_SIZE = 2 * 1024 * 1024 * 1024
         if total_size > MAX_FILE_SIZE:
             context.abort(grpc.StatusCode.INVALID_ARGUMENT, "File size exceeds maximum allowed size of 2GB")
 
-        MAX_FILES_PER_USER = 20
-        file_count = files_collection.count_documents({"user_id": user_id})
-        if file_count >= MAX_FILES_PER_USER:
-            context.abort(
-                grpc.StatusCode.RESOURCE_EXHAUSTED,
-                "Maximum file limit reached (20 files per user)"
-            )
+        check_quota(user_id, request_quota(request, context), total_size, context)
+
+        check_parent_folder(user_id, request.parent_id, context)
 
         file_id = str(ObjectId())
         s3_key = generate_s3_key(user_id, file_id, filename)
 
-        CHUNK_SIZE = 10 * 1024 * 1024
-        total_parts = (total_size + CHUNK_SIZE - 1) // CHUNK_SIZE
+        total_parts = (total_size + PART_SIZE - 1) // PART_SIZE
 
         try:
             response = s3_client.create_multipart_upload(
@@ -311,6 +969,7 @@ class FileService(file_pb2_grpc.FileServiceServicer):
                 "content_type": content_type,
                 "total_size": total_size,
                 "s3_key": s3_key,
+                "parent_id": request.parent_id,
                 "parts": [],
                 "created_at": datetime.now(timezone.utc),
                 "status": "in_progress"
@@ -319,7 +978,7 @@ class FileService(file_pb2_grpc.FileServiceServicer):
 
             return file_pb2.InitiateMultipartUploadResponse(
                 upload_id=upload_id,
-                chunk_size=CHUNK_SIZE,
+                chunk_size=PART_SIZE,
                 total_parts=total_parts
             )
         except ClientError as e:
@@ -328,17 +987,45 @@ class FileService(file_pb2_grpc.FileServiceServicer):
     def UploadPart(self, request, context):
         user_id = get_user_id(context, self.auth_client)
 
-        upload_id = request.upload_id
-        part_number = request.part_number
-        chunk = request.chunk
+        if len(request.chunk) > PART_SIZE:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Chunk size exceeds maximum allowed size of 10MB")
+
+        sha256 = hashlib.sha256(request.chunk).hexdigest()
+        verify_checksum(sha256, request.sha256, context)
+        return self._store_part(user_id, request.upload_id, request.part_number, request.chunk, len(request.chunk), sha256, context)
+
+    def UploadPartStream(self, request_iterator, context):
+        """Receive one part as a stream of chunks, so no single message holds the whole part."""
+        user_id = get_user_id(context, self.auth_client)
 
+        first_request = next(request_iterator, None)
+        if first_request is None or not first_request.HasField("metadata"):
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "First message must contain metadata")
+        metadata = first_request.metadata
+
+        part_buffer = tempfile.SpooledTemporaryFile(max_size=PART_SIZE)
+        try:
+            digest = hashlib.sha256()
+            part_size = 0
+            for request in request_iterator:
+                if request.HasField("chunk"):
+                    part_size += len(request.chunk)
+                    if part_size > PART_SIZE:
+                        context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Chunk size exceeds maximum allowed size of 10MB")
+                    part_buffer.write(request.chunk)
+                    digest.update(request.chunk)
+
+            sha256 = digest.hexdigest()
+            verify_checksum(sha256, metadata.sha256, context)
+            part_buffer.seek(0)
+            return self._store_part(user_id, metadata.upload_id, metadata.part_number, part_buffer, part_size, sha256, context)
+        finally:
+            part_buffer.close()
+
+    def _store_part(self, user_id, upload_id, part_number, body, size, sha256, context):
         if part_number < 1:
             context.abort(grpc.StatusCode.INVALID_ARGUMENT, "part_number must be >= 1")
 
-        CHUNK_SIZE = 10 * 1024 * 1024
-        if len(chunk) > CHUNK_SIZE:
-            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Chunk size exceeds maximum allowed size of 10MB")
-
         session = upload_sessions_collection.find_one({
             "upload_id": upload_id,
             "user_id": user_id
@@ -355,7 +1042,7 @@ class FileService(file_pb2_grpc.FileServiceServicer):
                 Key=s3_key,
                 UploadId=upload_id,
                 PartNumber=part_number,
-                Body=chunk
+                Body=body
             )
 
             etag = response['ETag']
@@ -370,7 +1057,7 @@ class FileService(file_pb2_grpc.FileServiceServicer):
                                     "input": "$parts",
                                     "cond": {"$ne": ["$$this.part_number", part_number]}
                                 }},
-                                [{"part_number": part_number, "etag": etag}]
+                                [{"part_number": part_number, "etag": etag, "size": size, "sha256": sha256}]
                             ]
                         }
                     }}
@@ -379,7 +1066,8 @@ class FileService(file_pb2_grpc.FileServiceServicer):
 
             return file_pb2.UploadPartResponse(
                 etag=etag,
-                part_number=part_number
+                part_number=part_number,
+                sha256=sha256
             )
         except ClientError as e:
             context.abort(grpc.StatusCode.INTERNAL, f"Failed to upload part: {str(e)}")
@@ -404,6 +1092,18 @@ class FileService(file_pb2_grpc.FileServiceServicer):
         total_size = session["total_size"]
         s3_key = session["s3_key"]
 
+        # DeleteFolder refuses while this session is in progress, but it may
+        # have started before the session was created.
+        check_parent_folder(user_id, session.get("parent_id", ""), context)
+
+        # Checked before the parts are joined, so a mismatch can be fixed by
+        # sending the right parts again.
+        checksum = parts_sha256(session, parts)
+        if request.parts_sha256:
+            if checksum is None:
+                context.abort(grpc.StatusCode.FAILED_PRECONDITION, "Some parts were uploaded without a checksum")
+            verify_checksum(checksum, request.parts_sha256, context)
+
         multipart_upload = {
             'Parts': [
                 {'PartNumber': part.part_number, 'ETag': part.etag}
@@ -418,32 +1118,33 @@ class FileService(file_pb2_grpc.FileServiceServicer):
                 UploadId=upload_id,
                 MultipartUpload=multipart_upload
             )
+        except ClientError as e:
+            context.abort(grpc.StatusCode.INTERNAL, f"Failed to complete multipart upload: {str(e)}")
 
-            doc = {
-                "_id": ObjectId(file_id),
-                "user_id": user_id,
-                "filename": filename,
-                "size": total_size,
-                "content_type": content_type,
-                "s3_key": s3_key,
-                "created_at": int(time.time())
-            }
+        doc = {
+            "_id": ObjectId(file_id),
+            "user_id": user_id,
+            "filename": filename,
+            "size": total_size,
+            "content_type": content_type,
+            "s3_key": s3_key,
+            "parent_id": session.get("parent_id", ""),
+            "parts_sha256": checksum or "",
+            "created_at": int(time.time())
+        }
+        try:
             files_collection.insert_one(doc)
+        except PyMongoError as e:
+            # The parts are joined and can't be resumed, so drop the object
+            # and the session rather than leave them counting against nothing.
+            discard_completed_upload(upload_id, s3_key)
+            context.abort(grpc.StatusCode.INTERNAL, f"Failed to record multipart upload: {str(e)}")
 
-            upload_sessions_collection.delete_one({"upload_id": upload_id})
+        upload_sessions_collection.delete_one({"upload_id": upload_id})
 
-            return file_pb2.FileResponse(
-                file=file_pb2.File(
-                    id=file_id,
-                    user_id=user_id,
-                    filename=filename,
-                    size=total_size,
-                    content_type=content_type,
-                    created_at=doc["created_at"]
-                )
-            )
-        except ClientError as e:
-            context.abort(grpc.StatusCode.INTERNAL, f"Failed to complete multipart upload: {str(e)}")
+        return file_pb2.FileResponse(
+            file=file_to_pb(doc)
+        )
 
     def AbortMultipartUpload(self, request, context):
         user_id = get_user_id(context, self.auth_client)
@@ -473,4 +1174,240 @@ class FileService(file_pb2_grpc.FileServiceServicer):
 
         return file_pb2.AbortMultipartUploadResponse(
             success=result.deleted_count == 1
-        )
\ No newline at end of file
+        )
+
+    def ListMultipartUploads(self, request, context):
+        user_id = get_user_id(context, self.auth_client)
+
+        sessions = upload_sessions_collection.find(
+            {"user_id": user_id, "status": "in_progress"}
+        ).sort("created_at", -1)
+        return file_pb2.ListMultipartUploadsResponse(uploads=[upload_to_pb(s) for s in sessions])
+
+    def ListParts(self, request, context):
+        user_id = get_user_id(context, self.auth_client)
+
+        session = upload_sessions_collection.find_one({
+            "upload_id": request.upload_id,
+            "user_id": user_id
+        })
+
+        if not session:
+            context.abort(grpc.StatusCode.NOT_FOUND, "Upload session not found or expired")
+
+        parts = sorted(session.get("parts", []), key=lambda p: p["part_number"])
+        return file_pb2.ListPartsResponse(
+            upload=upload_to_pb(session),
+            parts=[
+                file_pb2.UploadedPart(
+                    part_number=p["part_number"],
+                    etag=p["etag"],
+                    size=p.get("size", 0),
+                    sha256=p.get("sha256", ""),
+                )
+                for p in parts
+            ],
+        )
+
+    def CreateDownloadLink(self, request, context):
+        user_id = get_user_id(context, self.auth_client)
+
+        try:
+            doc = files_collection.find_one({"_id": ObjectId(request.file_id), "user_id": user_id})
+        except InvalidId:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Invalid file id format")
+
+        if not doc:
+            context.abort(grpc.StatusCode.NOT_FOUND, "File not found")
+
+        now = int(time.time())
+        if request.expires_at <= now:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "expires_at must be in the future")
+        if request.max_downloads < 0:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "max_downloads must not be negative")
+
+        link = {
+            "_id": ObjectId(),
+            "user_id": user_id,
+            "file_id": request.file_id,
+            "expires_at": request.expires_at,
+            "max_downloads": request.max_downloads,
+            "download_count": 0,
+            "created_at": now,
+        }
+        download_links_collection.insert_one(link)
+        return link_to_pb(link)
+
+    def ListDownloadLinks(self, request, context):
+        user_id = get_user_id(context, self.auth_client)
+
+        links = download_links_collection.find(
+            {"user_id": user_id, "file_id": request.file_id, **active_link_filter()}
+        ).sort("created_at", 1)
+        return file_pb2.ListDownloadLinksResponse(links=[link_to_pb(link) for link in links])
+
+    def RevokeDownloadLink(self, request, context):
+        user_id = get_user_id(context, self.auth_client)
+
+        try:
+            link_oid = ObjectId(request.id)
+        except InvalidId:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Invalid link id format")
+
+        res = download_links_collection.delete_one(
+            {"_id": link_oid, "user_id": user_id, "file_id": request.file_id}
+        )
+        if res.deleted_count == 0:
+            context.abort(grpc.StatusCode.NOT_FOUND, "Download link not found")
+        return file_pb2.RevokeDownloadLinkResponse(success=True)
+
+    def ShareFile(self, request, context):
+        user_id = get_user_id(context, self.auth_client)
+
+        try:
+            doc = files_collection.find_one({"_id": ObjectId(request.file_id), "user_id": user_id})
+        except InvalidId:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Invalid file id format")
+
+        if not doc:
+            context.abort(grpc.StatusCode.NOT_FOUND, "File not found")
+        if not request.user_id:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "user_id is required")
+        if request.user_id == user_id:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Cannot share a file with yourself")
+        if request.permission not in (file_pb2.SHARE_PERMISSION_VIEW, file_pb2.SHARE_PERMISSION_DOWNLOAD):
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "permission must be view or download")
+
+        # Sharing again with the same user updates the permission in place.
+        share = shares_collection.find_one_and_update(
+            {"file_id": request.file_id, "user_id": request.user_id},
+            {
+                "$set": {"username": request.username, "permission": request.permission},
+                "$setOnInsert": {"owner_id": user_id, "created_at": int(time.time())},
+            },
+            upsert=True,
+            return_document=ReturnDocument.AFTER,
+        )
+        return share_to_pb(share)
+
+    def ListShares(self, request, context):
+        user_id = get_user_id(context, self.auth_client)
+
+        shares = shares_collection.find(
+            {"owner_id": user_id, "file_id": request.file_id}
+        ).sort("created_at", 1)
+        return file_pb2.ListSharesResponse(shares=[share_to_pb(share) for share in shares])
+
+    def RevokeShare(self, request, context):
+        user_id = get_user_id(context, self.auth_client)
+
+        try:
+            share_oid = ObjectId(request.id)
+        except InvalidId:
+            context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Invalid share id format")
+
+        res = shares_collection.delete_one(
+            {"_id": share_oid, "owner_id": user_id, "file_id": request.file_id}
+        )
+        if res.deleted_count == 0:
+            context.abort(grpc.StatusCode.NOT_FOUND, "Share not found")
+        return file_pb2.RevokeShareResponse(success=True)
+
+    def ListSharedWithMe(self, request, context):
+        user_id = get_user_id(context, self.auth_client)
+
+        permissions = {share["file_id"]: share["permission"] for share in shares_collection.find({"user_id": user_id})}
+        docs = files_collection.find({"_id": {"$in": [ObjectId(file_id) for file_id in permissions]}})
+
+        return file_pb2.ListSharedWithMeResponse(
+            files=[
+                file_pb2.SharedFile(
+                    file=file_to_pb(d),
+                    permission=permissions[str(d["_id"])],
+                ) for d in docs
+            ]
+        )
+
+    def CreateFolder(self, request, context):
+        user_id = get_user_id(context, self.auth_client)
+
+        validate_name(request.name, context)
+        check_parent_folder(user_id, request.parent_id, context)
+
+        folder = {
+            "_id": ObjectId(),
+            "user_id": user_id,
+            "name": request.name,
+            "parent_id": request.parent_id,
+            "created_at": int(time.time()),
+        }
+        try:
+            folders_collection.insert_one(folder)
+        except DuplicateKeyError:
+            context.abort(grpc.StatusCode.ALREADY_EXISTS, "A folder with that name already exists here")
+        return folder_to_pb(folder)
+
+    def RenameFolder(self, request, context):
+        user_id = get_user_id(context, self.auth_client)
+
+        validate_name(request.name, context)
+        folder = find_folder(user_id, request.id, context)
+
+        try:
+            folders_collection.update_one({"_id": folder["_id"], "user_id": user_id}, {"$set": {"name": request.name}})
+        except DuplicateKeyError:
+            context.abort(grpc.StatusCode.ALREADY_EXISTS, "A folder with that name already exists here")
+        folder["name"] = request.name
+        return folder_to_pb(folder)
+
+    def MoveFolder(self, request, context):
+        user_id = get_user_id(context, self.auth_client)
+
+        folder = find_folder(user_id, request.id, context)
+        if request.parent_id:
+            parent = find_folder(user_id, request.parent_id, context)
+            if any(f["_id"] == folder["_id"] for f in folder_path(user_id, parent)):
+                context.abort(grpc.StatusCode.INVALID_ARGUMENT, "Cannot move a folder into itself or one of its subfolders")
+
+        try:
+            folders_collection.update_one({"_id": folder["_id"], "user_id": user_id}, {"$set": {"parent_id": request.parent_id}})
+        except DuplicateKeyError:
+            context.abort(grpc.StatusCode.ALREADY_EXISTS, "A folder with that name already exists here")
+        folder["parent_id"] = request.parent_id
+        return folder_to_pb(folder)
+
+    def DeleteFolder(self, request, context):
+        """Delete a folder with all of its subfolders and the files in them.
+
+        Refused while an upload into any of them is in progress, so it can't
+        finish into a folder that no longer exists.
+        """
+        user_id = get_user_id(context, self.auth_client)
+
+        folder = find_folder(user_id, request.id, context)
+
+        folder_ids = [str(folder["_id"])]
+        frontier = folder_ids
+        while frontier:
+            children = folders_collection.find({"user_id": user_id, "parent_id": {"$in": frontier}}, {"_id": 1})
+            frontier = [str(child["_id"]) for child in children]
+            folder_ids = folder_ids + frontier
+
+        pending = upload_sessions_collection.count_documents(
+            {"user_id": user_id, "status": "in_progress", "parent_id": {"$in": folder_ids}}, limit=1
+        )
+        if pending:
+            context.abort(grpc.StatusCode.FAILED_PRECONDITION, "Folder has uploads in progress; complete or abort them first")
+
+        files_deleted = 0
+        for doc in files_collection.find({"user_id": user_id, "parent_id": {"$in": folder_ids}}):
+            files_deleted += remove_file(user_id, doc)
+
+        res = folders_collection.delete_many(
+            {"_id": {"$in": [ObjectId(folder_id) for folder_id in folder_ids]}, "user_id": user_id}
+        )
+        return file_pb2.DeleteFolderResponse(
+            success=True,
+            folders_deleted=res.deleted_count,
+            files_deleted=files_deleted,
+        )
@@ -0,0 +1,48 @@
+import jwt
+
+from file_service.config import SERVICE_AUTH_SECRET, TLS_CA_FILE
+
+# Matches common/serviceauth in the Go services.
+METADATA_KEY = "x-service-token"
+AUDIENCE = "file-service"
+
+GATEWAY = "api-gateway"
+
+
+def calling_service(context):
+    """Name the internal service making a call, or None if it can't be verified.
+
+    A client certificate is only requested, and so verified, when TLS_CA_FILE
+    is set. Otherwise the caller must send a token signed with
+    SERVICE_AUTH_SECRET for this service.
+    """
+    if TLS_CA_FILE:
+        names = context.auth_context().get("x509_common_name")
+        if names:
+            return names[0].decode()
+
+    if not SERVICE_AUTH_SECRET:
+        return None
+    token = dict(context.invocation_metadata()).get(METADATA_KEY)
+    if not token:
+        return None
+    try:
+        claims = jwt.decode(
+            token,
+            SERVICE_AUTH_SECRET,
+            algorithms=["HS256"],
+            audience=AUDIENCE,
+            options={"require": ["exp", "sub"]},
+        )
+    except jwt.InvalidTokenError:
+        return None
+    return claims["sub"] or None
+
+
+def from_gateway(context):
+    """Whether the call comes from the API gateway, which checks what users can't be trusted to send."""
+    return calling_service(context) == GATEWAY
+
+
+def service_auth_enabled():
+    return bool(SERVICE_AUTH_SECRET or TLS_CA_FILE)
@@ -8,6 +8,10 @@ DB_NAME = os.getenv("MONGODB_DATABASE", "file_service")
 db = client[DB_NAME]
 files_collection = db["files"]
 upload_sessions_collection = db["upload_sessions"]
+download_links_collection = db["download_links"]
+shares_collection = db["shares"]
+folders_collection = db["folders"]
+admin_actions_collection = db["admin_actions"]
 
 # Initialize S3 client
 s3_config = {
@@ -26,13 +30,33 @@ if S3_SECRET_KEY:
 
 s3_client = boto3.client(**s3_config)
 
+# How long a multipart upload session lives before it expires.
+UPLOAD_SESSION_TTL = 7 * 24 * 60 * 60
+
 def generate_s3_key(user_id: str, file_id: str, filename: str) -> str:
     """Generate unique S3 key for file storage."""
     return f"{user_id}/{file_id}/{filename}"
 
+def init_file_indexes():
+    files_collection.create_index([("user_id", 1), ("tags", 1)])
+
 def init_upload_session_indexes():
     upload_sessions_collection.create_index(
         "created_at",
-        expireAfterSeconds=7 * 24 * 60 * 60
+        expireAfterSeconds=UPLOAD_SESSION_TTL
     )
-    upload_sessions_collection.create_index("upload_id", unique=True)
\ No newline at end of file
+    upload_sessions_collection.create_index("upload_id", unique=True)
+
+def init_download_link_indexes():
+    download_links_collection.create_index([("user_id", 1), ("file_id", 1)])
+
+def init_share_indexes():
+    shares_collection.create_index([("file_id", 1), ("user_id", 1)], unique=True)
+    shares_collection.create_index("user_id")
+
+def init_folder_indexes():
+    folders_collection.create_index([("user_id", 1), ("parent_id", 1), ("name", 1)], unique=True)
+
+def init_admin_action_indexes():
+    admin_actions_collection.create_index([("created_at", -1)])
+    admin_actions_collection.create_index("file_id")
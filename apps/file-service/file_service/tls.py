@@ -0,0 +1,150 @@
+import os
+import ssl
+import threading
+
+import grpc
+
+from file_service.config import TLS_CA_FILE, TLS_CERT_FILE, TLS_DEV_MODE, TLS_KEY_FILE
+
+
+def _read(path: str) -> bytes:
+    with open(path, "rb") as f:
+        return f.read()
+
+
+def _stat(paths):
+    return [os.stat(p).st_mtime_ns for p in paths]
+
+
+def _check_config():
+    if bool(TLS_CERT_FILE) != bool(TLS_KEY_FILE):
+        raise ValueError("TLS_CERT_FILE and TLS_KEY_FILE must be set together")
+    if TLS_DEV_MODE and not TLS_CERT_FILE:
+        # The Go services mint a throwaway certificate in dev mode; file-service
+        # has no X.509 tooling, so it needs a key pair on disk instead.
+        raise ValueError("TLS_DEV_MODE requires TLS_CERT_FILE and TLS_KEY_FILE for file-service")
+
+
+def server_enabled() -> bool:
+    return bool(TLS_CERT_FILE) or TLS_DEV_MODE
+
+
+class _CertificateFetcher:
+    """Re-reads the key pair and CA bundle whenever their mtimes change.
+
+    grpc calls the fetcher on each new handshake; returning None keeps the
+    current configuration, so a half-written rotation never takes effect.
+    """
+
+    def __init__(self):
+        self._lock = threading.Lock()
+        self._paths = [p for p in (TLS_CERT_FILE, TLS_KEY_FILE, TLS_CA_FILE) if p]
+        self._mtimes = _stat(self._paths)
+
+    def initial(self) -> grpc.ServerCertificateConfiguration:
+        return self._load()
+
+    def _load(self) -> grpc.ServerCertificateConfiguration:
+        root_certificates = _read(TLS_CA_FILE) if TLS_CA_FILE else None
+        return grpc.ssl_server_certificate_configuration(
+            [(_read(TLS_KEY_FILE), _read(TLS_CERT_FILE))],
+            root_certificates=root_certificates,
+        )
+
+    def __call__(self):
+        with self._lock:
+            try:
+                mtimes = _stat(self._paths)
+                if mtimes == self._mtimes:
+                    return None
+                config = self._load()
+            except (OSError, ValueError) as e:
+                print(f"TLS certificate reload failed, keeping previous certificate: {e}")
+                return None
+            self._mtimes = mtimes
+            print("TLS certificates reloaded")
+            return config
+
+
+def server_credentials():
+    """Returns grpc server credentials, or None to listen in plaintext."""
+    _check_config()
+    if not server_enabled():
+        return None
+
+    fetcher = _CertificateFetcher()
+    return grpc.dynamic_ssl_server_credentials(
+        fetcher.initial(),
+        fetcher,
+        require_client_authentication=bool(TLS_CA_FILE),
+    )
+
+
+def _peer_certificate(target: str) -> bytes:
+    """Fetches the certificate target presents, without verifying it."""
+    host, _, port = target.rpartition(":")
+    return ssl.get_server_certificate((host, int(port)), timeout=5).encode()
+
+
+class ChannelCredentials:
+    """Builds channel credentials for calls to target and notices when they go stale.
+
+    Credentials are rebuilt when the key pair or CA bundle changes on disk. In
+    dev mode without a CA, the peer's self-signed certificate is trusted as
+    presented, as the Go services skip verification; it is fetched again after
+    forget_peer, such as when the peer restarts with a new certificate.
+    """
+
+    def __init__(self, target: str):
+        self._target = target
+        self._lock = threading.Lock()
+        self._paths = [p for p in (TLS_CERT_FILE, TLS_KEY_FILE, TLS_CA_FILE) if p]
+        self._mtimes = None
+        self._trust_peer = TLS_DEV_MODE and not TLS_CA_FILE
+        self._peer = None
+        # The Go services' dev certificate always covers localhost, whatever
+        # name the peer is dialled by.
+        self.options = [("grpc.ssl_target_name_override", "localhost")] if self._trust_peer else []
+
+    def stale(self) -> bool:
+        with self._lock:
+            if self._mtimes is None or (self._trust_peer and self._peer is None):
+                return True
+            try:
+                return _stat(self._paths) != self._mtimes
+            except OSError:
+                # Mid-rotation; look again on the next call.
+                return False
+
+    def forget_peer(self):
+        with self._lock:
+            self._peer = None
+
+    def load(self) -> grpc.ChannelCredentials:
+        with self._lock:
+            mtimes = _stat(self._paths)
+            root_certificates = _read(TLS_CA_FILE) if TLS_CA_FILE else None
+            if self._trust_peer:
+                if self._peer is None:
+                    try:
+                        self._peer = _peer_certificate(self._target)
+                    except (OSError, ValueError) as e:
+                        # The peer may not be up yet; stale() stays true so
+                        # the next call tries again.
+                        print(f"Could not fetch {self._target}'s dev certificate: {e}")
+                root_certificates = self._peer
+            credentials = grpc.ssl_channel_credentials(
+                root_certificates=root_certificates,
+                private_key=_read(TLS_KEY_FILE) if TLS_KEY_FILE else None,
+                certificate_chain=_read(TLS_CERT_FILE) if TLS_CERT_FILE else None,
+            )
+            self._mtimes = mtimes
+            return credentials
+
+
+def channel_credentials(target: str):
+    """Returns ChannelCredentials for outgoing calls to target, or None for plaintext."""
+    _check_config()
+    if not (TLS_CERT_FILE or TLS_CA_FILE or TLS_DEV_MODE):
+        return None
+    return ChannelCredentials(target)
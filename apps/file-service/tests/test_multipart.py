@@ -1,6 +1,10 @@
+import hashlib
 import pytest
-from unittest.mock import Mock, patch
+import grpc
+from datetime import datetime
+from unittest.mock import ANY, Mock, patch
 from bson import ObjectId
+from pymongo.errors import PyMongoError
 from file.v1 import file_pb2
 from file_service.service import FileService
 
@@ -84,30 +88,78 @@ def test_upload_part(file_service, mock_context):
         mock_s3.upload_part.assert_called_once()
         mock_sessions.update_one.assert_called_once()
 
+def test_upload_part_stream(file_service, mock_context):
+    requests = iter([
+        file_pb2.UploadPartStreamRequest(
+            metadata=file_pb2.UploadPartMetadata(upload_id="test_upload_id_123", part_number=2)
+        ),
+        file_pb2.UploadPartStreamRequest(chunk=b"x" * 1024),
+        file_pb2.UploadPartStreamRequest(chunk=b"y" * 1024),
+    ])
+
+    with patch('file_service.service.s3_client') as mock_s3, \
+         patch('file_service.service.upload_sessions_collection') as mock_sessions:
+
+        uploaded = {}
+
+        def upload_part(**kwargs):
+            uploaded["body"] = kwargs["Body"].read()
+            return {'ETag': '"streametag"'}
+
+        mock_s3.upload_part.side_effect = upload_part
+        mock_sessions.find_one.return_value = {
+            "upload_id": "test_upload_id_123",
+            "user_id": "test_user_123",
+            "s3_key": "test_user_123/file_id/file.mp4",
+            "parts": []
+        }
+
+        response = file_service.UploadPartStream(requests, mock_context)
+
+        assert response.etag == '"streametag"'
+        assert response.part_number == 2
+        assert uploaded["body"] == b"x" * 1024 + b"y" * 1024
+        assert response.sha256 == hashlib.sha256(b"x" * 1024 + b"y" * 1024).hexdigest()
+        mock_sessions.update_one.assert_called_once()
+
+def parts_digest(*parts):
+    combined = hashlib.sha256()
+    for data in parts:
+        combined.update(hashlib.sha256(data).digest())
+    return combined.hexdigest()
+
+def multipart_session(file_id, total_size):
+    return {
+        "upload_id": "test_upload_id_123",
+        "user_id": "test_user_123",
+        "file_id": file_id,
+        "filename": "large_file.mp4",
+        "content_type": "video/mp4",
+        "total_size": total_size,
+        "s3_key": "test_user_123/file_id_123/large_file.mp4",
+        "parts": [
+            {"part_number": 1, "etag": '"etag1"', "sha256": hashlib.sha256(b"first").hexdigest()},
+            {"part_number": 2, "etag": '"etag2"', "sha256": hashlib.sha256(b"second").hexdigest()},
+        ],
+    }
+
 def test_complete_multipart_upload(file_service, mock_context):
     file_id = str(ObjectId())
     parts = [
-        file_pb2.PartInfo(part_number=1, etag='"etag1"'),
         file_pb2.PartInfo(part_number=2, etag='"etag2"'),
+        file_pb2.PartInfo(part_number=1, etag='"etag1"'),
     ]
     request = file_pb2.CompleteMultipartUploadRequest(
         upload_id="test_upload_id_123",
-        parts=parts
+        parts=parts,
+        parts_sha256=parts_digest(b"first", b"second"),
     )
 
     with patch('file_service.service.s3_client') as mock_s3, \
          patch('file_service.service.upload_sessions_collection') as mock_sessions, \
          patch('file_service.service.files_collection') as mock_files:
 
-        mock_sessions.find_one.return_value = {
-            "upload_id": "test_upload_id_123",
-            "user_id": "test_user_123",
-            "file_id": file_id,
-            "filename": "large_file.mp4",
-            "content_type": "video/mp4",
-            "total_size": 20 * 1024 * 1024,
-            "s3_key": "test_user_123/file_id_123/large_file.mp4"
-        }
+        mock_sessions.find_one.return_value = multipart_session(file_id, 20 * 1024 * 1024)
         mock_s3.complete_multipart_upload.return_value = {}
         mock_files.insert_one.return_value = Mock(inserted_id=ObjectId(file_id))
         mock_sessions.delete_one.return_value = Mock()
@@ -117,11 +169,83 @@ def test_complete_multipart_upload(file_service, mock_context):
         assert response.file.id == file_id
         assert response.file.filename == "large_file.mp4"
         assert response.file.user_id == "test_user_123"
+        assert response.file.parts_sha256 == parts_digest(b"first", b"second")
+        assert response.file.sha256 == ""
 
         mock_s3.complete_multipart_upload.assert_called_once()
+        mock_s3.get_object.assert_not_called()
         mock_files.insert_one.assert_called_once()
         mock_sessions.delete_one.assert_called_once()
 
+def test_complete_multipart_upload_checksum_mismatch(file_service, mock_context):
+    mock_context.abort.side_effect = Exception("Aborted")
+    request = file_pb2.CompleteMultipartUploadRequest(
+        upload_id="test_upload_id_123",
+        parts=[
+            file_pb2.PartInfo(part_number=1, etag='"etag1"'),
+            file_pb2.PartInfo(part_number=2, etag='"etag2"'),
+        ],
+        parts_sha256=parts_digest(b"first", b"what the client sent"),
+    )
+
+    with patch('file_service.service.s3_client') as mock_s3, \
+         patch('file_service.service.upload_sessions_collection') as mock_sessions, \
+         patch('file_service.service.files_collection') as mock_files:
+
+        mock_sessions.find_one.return_value = multipart_session(str(ObjectId()), 12)
+
+        with pytest.raises(Exception):
+            file_service.CompleteMultipartUpload(request, mock_context)
+
+        mock_context.abort.assert_called_once_with(grpc.StatusCode.INVALID_ARGUMENT, "Checksum mismatch")
+        mock_s3.complete_multipart_upload.assert_not_called()
+        mock_s3.delete_object.assert_not_called()
+        mock_sessions.delete_one.assert_not_called()
+        mock_files.insert_one.assert_not_called()
+
+def test_complete_multipart_upload_rolls_back_when_not_recorded(file_service, mock_context):
+    mock_context.abort.side_effect = Exception("Aborted")
+    request = file_pb2.CompleteMultipartUploadRequest(
+        upload_id="test_upload_id_123",
+        parts=[
+            file_pb2.PartInfo(part_number=1, etag='"etag1"'),
+            file_pb2.PartInfo(part_number=2, etag='"etag2"'),
+        ],
+    )
+
+    with patch('file_service.service.s3_client') as mock_s3, \
+         patch('file_service.service.upload_sessions_collection') as mock_sessions, \
+         patch('file_service.service.files_collection') as mock_files:
+
+        mock_sessions.find_one.return_value = multipart_session(str(ObjectId()), 12)
+        mock_s3.complete_multipart_upload.return_value = {}
+        mock_files.insert_one.side_effect = PyMongoError("connection lost")
+
+        with pytest.raises(Exception):
+            file_service.CompleteMultipartUpload(request, mock_context)
+
+        assert mock_context.abort.call_args[0][0] == grpc.StatusCode.INTERNAL
+        mock_s3.delete_object.assert_called_once_with(
+            Bucket=ANY, Key="test_user_123/file_id_123/large_file.mp4"
+        )
+        mock_sessions.delete_one.assert_called_once_with({"upload_id": "test_upload_id_123"})
+
+def test_upload_part_checksum_mismatch(file_service, mock_context):
+    mock_context.abort.side_effect = Exception("Aborted")
+    request = file_pb2.UploadPartRequest(
+        upload_id="test_upload_id_123",
+        part_number=1,
+        chunk=b"part data",
+        sha256=hashlib.sha256(b"other data").hexdigest(),
+    )
+
+    with patch('file_service.service.s3_client') as mock_s3:
+        with pytest.raises(Exception):
+            file_service.UploadPart(request, mock_context)
+
+        mock_context.abort.assert_called_once_with(grpc.StatusCode.INVALID_ARGUMENT, "Checksum mismatch")
+        mock_s3.upload_part.assert_not_called()
+
 def test_abort_multipart_upload(file_service, mock_context):
     request = file_pb2.AbortMultipartUploadRequest(
         upload_id="test_upload_id_123"
@@ -144,3 +268,126 @@ def test_abort_multipart_upload(file_service, mock_context):
 
         mock_s3.abort_multipart_upload.assert_called_once()
         mock_sessions.delete_one.assert_called_once()
+
+def test_initiate_multipart_upload_rejects_invalid_name(file_service, mock_context):
+    mock_context.abort.side_effect = Exception("Aborted")
+    request = file_pb2.InitiateMultipartUploadRequest(
+        filename="../large_file.mp4",
+        total_size=100 * 1024 * 1024,
+    )
+
+    with patch('file_service.service.s3_client') as mock_s3:
+        with pytest.raises(Exception):
+            file_service.InitiateMultipartUpload(request, mock_context)
+
+        assert mock_context.abort.call_args[0][0] == grpc.StatusCode.INVALID_ARGUMENT
+        mock_s3.create_multipart_upload.assert_not_called()
+
+def test_initiate_multipart_upload_over_quota(file_service, mock_context):
+    mock_context.abort.side_effect = Exception("Aborted")
+    request = file_pb2.InitiateMultipartUploadRequest(
+        filename="large_file.mp4",
+        total_size=100 * 1024 * 1024,
+        quota=file_pb2.StorageQuota(max_bytes=150 * 1024 * 1024),
+    )
+
+    with patch('file_service.service.s3_client') as mock_s3, \
+         patch('file_service.service.upload_sessions_collection') as mock_sessions, \
+         patch('file_service.service.files_collection') as mock_files, \
+         patch('file_service.service.from_gateway', return_value=True):
+
+        mock_files.aggregate.return_value = [{"count": 1, "bytes": 60 * 1024 * 1024}]
+        mock_sessions.aggregate.return_value = []
+
+        with pytest.raises(Exception):
+            file_service.InitiateMultipartUpload(request, mock_context)
+
+        mock_context.abort.assert_called_once_with(grpc.StatusCode.RESOURCE_EXHAUSTED, "Storage quota exceeded")
+        mock_s3.create_multipart_upload.assert_not_called()
+
+def test_initiate_multipart_upload_without_quota_uses_default(file_service, mock_context):
+    mock_context.abort.side_effect = Exception("Aborted")
+    request = file_pb2.InitiateMultipartUploadRequest(
+        filename="large_file.mp4",
+        total_size=100 * 1024 * 1024,
+    )
+
+    with patch('file_service.service.s3_client') as mock_s3, \
+         patch('file_service.service.upload_sessions_collection') as mock_sessions, \
+         patch('file_service.service.files_collection') as mock_files:
+
+        mock_files.aggregate.return_value = [{"count": 1, "bytes": 10 * 1024 * 1024 * 1024 - 50 * 1024 * 1024}]
+        mock_sessions.aggregate.return_value = []
+
+        with pytest.raises(Exception):
+            file_service.InitiateMultipartUpload(request, mock_context)
+
+        mock_context.abort.assert_called_once_with(grpc.StatusCode.RESOURCE_EXHAUSTED, "Storage quota exceeded")
+        mock_s3.create_multipart_upload.assert_not_called()
+
+def test_list_multipart_uploads(file_service, mock_context):
+    with patch('file_service.service.upload_sessions_collection') as mock_sessions:
+        mock_sessions.find.return_value.sort.return_value = [{
+            "upload_id": "test_upload_id_123",
+            "user_id": "test_user_123",
+            "filename": "large_file.mp4",
+            "content_type": "video/mp4",
+            "total_size": 25 * 1024 * 1024,
+            "parent_id": "",
+            "parts": [
+                {"part_number": 1, "etag": '"etag1"', "size": 10 * 1024 * 1024},
+                {"part_number": 2, "etag": '"etag2"', "size": 10 * 1024 * 1024},
+            ],
+            "created_at": datetime(2025, 1, 1),
+            "status": "in_progress"
+        }]
+
+        response = file_service.ListMultipartUploads(file_pb2.ListMultipartUploadsRequest(), mock_context)
+
+        mock_sessions.find.assert_called_once_with({"user_id": "test_user_123", "status": "in_progress"})
+        assert len(response.uploads) == 1
+        upload = response.uploads[0]
+        assert upload.total_parts == 3
+        assert upload.parts_uploaded == 2
+        assert upload.uploaded_bytes == 20 * 1024 * 1024
+        assert upload.created_at == 1735689600
+        assert upload.expires_at == 1735689600 + 7 * 24 * 60 * 60
+
+def test_list_parts(file_service, mock_context):
+    request = file_pb2.ListPartsRequest(upload_id="test_upload_id_123")
+
+    with patch('file_service.service.upload_sessions_collection') as mock_sessions:
+        mock_sessions.find_one.return_value = {
+            "upload_id": "test_upload_id_123",
+            "user_id": "test_user_123",
+            "filename": "large_file.mp4",
+            "content_type": "video/mp4",
+            "total_size": 15 * 1024 * 1024,
+            "parts": [
+                {"part_number": 2, "etag": '"etag2"', "size": 5 * 1024 * 1024, "sha256": "ab" * 32},
+                {"part_number": 1, "etag": '"etag1"'},
+            ],
+            "created_at": datetime(2025, 1, 1),
+            "status": "in_progress"
+        }
+
+        response = file_service.ListParts(request, mock_context)
+
+        mock_sessions.find_one.assert_called_once_with({"upload_id": "test_upload_id_123", "user_id": "test_user_123"})
+        assert response.upload.upload_id == "test_upload_id_123"
+        assert [p.part_number for p in response.parts] == [1, 2]
+        assert response.parts[0].size == 0
+        assert response.parts[1].etag == '"etag2"'
+        assert response.parts[1].size == 5 * 1024 * 1024
+        assert response.parts[1].sha256 == "ab" * 32
+
+def test_list_parts_not_found(file_service, mock_context):
+    mock_context.abort.side_effect = Exception("Aborted")
+
+    with patch('file_service.service.upload_sessions_collection') as mock_sessions:
+        mock_sessions.find_one.return_value = None
+
+        with pytest.raises(Exception):
+            file_service.ListParts(file_pb2.ListPartsRequest(upload_id="missing"), mock_context)
+
+        mock_context.abort.assert_called_once_with(grpc.StatusCode.NOT_FOUND, "Upload session not found or expired")
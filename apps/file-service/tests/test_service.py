@@ -1,6 +1,9 @@
+import hashlib
+import time
 import pytest
-from unittest.mock import Mock, MagicMock, patch
+from unittest.mock import ANY, Mock, MagicMock, patch
 import grpc
+from bson import ObjectId
 
 from file_service.service import FileService, get_user_id
 from file.v1 import file_pb2
@@ -174,7 +177,7 @@ class TestFileService:
         assert len(response.files) == 1
         assert response.files[0].filename == "file1.txt"
 
-        mock_collection.find.assert_called_once_with({"user_id": "user-123"})
+        mock_collection.find.assert_called_once_with({"user_id": "user-123"}, sort=ANY, skip=0, limit=50)
 
     @patch('file_service.service.files_collection')
     def test_list_files_ignores_user_id_in_request(self, mock_collection):
@@ -193,7 +196,7 @@ class TestFileService:
         request = file_pb2.ListFilesRequest()
         _ = service.ListFiles(request, context)
 
-        mock_collection.find.assert_called_once_with({"user_id": "user-123"})
+        mock_collection.find.assert_called_once_with({"user_id": "user-123"}, sort=ANY, skip=0, limit=50)
 
     @patch('file_service.service.files_collection')
     def test_get_file_success(self, mock_collection):
@@ -245,9 +248,11 @@ class TestFileService:
         )
         mock_collection.find_one.assert_not_called()
 
+    @patch('file_service.service.shares_collection')
     @patch('file_service.service.files_collection')
-    def test_get_file_not_found_aborts(self, mock_collection):
+    def test_get_file_not_found_aborts(self, mock_collection, mock_shares):
         mock_collection.find_one.return_value = None
+        mock_shares.find_one.return_value = None
 
         auth_client = Mock()
         auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
@@ -269,8 +274,10 @@ class TestFileService:
             "File not found",
         )
 
+    @patch('file_service.service.shares_collection')
+    @patch('file_service.service.download_links_collection')
     @patch('file_service.service.files_collection')
-    def test_delete_file(self, mock_collection):
+    def test_delete_file(self, mock_collection, mock_links, mock_shares):
         mock_collection.find_one.return_value = {"_id": "507f1f77bcf86cd799439011", "user_id": "user-123"}
         mock_collection.delete_one.return_value = Mock(deleted_count=1)
 
@@ -288,6 +295,8 @@ class TestFileService:
         response = service.DeleteFile(request, context)
 
         assert response.success is True
+        mock_links.delete_many.assert_called_once_with({"file_id": "507f1f77bcf86cd799439011", "user_id": "user-123"})
+        mock_shares.delete_many.assert_called_once_with({"file_id": "507f1f77bcf86cd799439011", "owner_id": "user-123"})
 
     @patch('file_service.service.files_collection')
     def test_delete_file_not_found(self, mock_collection):
@@ -338,9 +347,12 @@ class TestFileService:
 
 class TestBusinessRules:
 
+    @patch('file_service.service.from_gateway', return_value=True)
+    @patch('file_service.service.upload_sessions_collection')
     @patch('file_service.service.files_collection')
-    def test_upload_file_exceeds_max_files_per_user(self, mock_collection):
-        mock_collection.count_documents.return_value = 20
+    def test_upload_file_exceeds_file_quota(self, mock_collection, mock_sessions, _from_gateway):
+        mock_collection.aggregate.return_value = [{"count": 19, "bytes": 1024}]
+        mock_sessions.aggregate.return_value = [{"count": 1, "bytes": 1024}]
 
         auth_client = Mock()
         auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
@@ -357,7 +369,8 @@ class TestBusinessRules:
             yield file_pb2.UploadFileRequest(
                 metadata=file_pb2.UploadFileMetadata(
                     filename="test.txt",
-                    content_type="text/plain"
+                    content_type="text/plain",
+                    quota=file_pb2.StorageQuota(max_files=20),
                 )
             )
 
@@ -366,12 +379,251 @@ class TestBusinessRules:
 
         context.abort.assert_called_once_with(
             grpc.StatusCode.RESOURCE_EXHAUSTED,
-            "Maximum file limit reached (20 files per user)"
+            "File quota reached (20 files)"
         )
 
+    @patch('file_service.service.from_gateway', return_value=True)
+    @patch('file_service.service.s3_client')
+    @patch('file_service.service.upload_sessions_collection')
+    @patch('file_service.service.files_collection')
+    def test_upload_file_exceeds_byte_quota_while_streaming(self, mock_collection, mock_sessions, mock_s3, _from_gateway):
+        mock_collection.aggregate.return_value = [{"count": 2, "bytes": 90}]
+        mock_sessions.aggregate.return_value = []
+
+        auth_client = Mock()
+        auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
+            valid=True,
+            user=user_pb2.User(id="user-123", username="testuser", role=user_pb2.Role.ROLE_USER)
+        )
+
+        service = FileService(auth_client)
+        context = Mock()
+        context.invocation_metadata.return_value = [("authorization", "Bearer valid-token")]
+        context.abort.side_effect = Exception("Aborted")
+
+        def request_iterator():
+            yield file_pb2.UploadFileRequest(
+                metadata=file_pb2.UploadFileMetadata(
+                    filename="test.txt",
+                    quota=file_pb2.StorageQuota(max_files=20, max_bytes=100),
+                )
+            )
+            yield file_pb2.UploadFileRequest(chunk=b"x" * 8)
+            yield file_pb2.UploadFileRequest(chunk=b"x" * 8)
+
+        with pytest.raises(Exception):
+            service.UploadFile(request_iterator(), context)
+
+        context.abort.assert_called_once_with(grpc.StatusCode.RESOURCE_EXHAUSTED, "Storage quota exceeded")
+        mock_s3.upload_fileobj.assert_not_called()
+        mock_collection.insert_one.assert_not_called()
+
+    @patch('file_service.service.s3_client')
+    @patch('file_service.service.folders_collection')
+    @patch('file_service.service.upload_sessions_collection')
+    @patch('file_service.service.files_collection')
+    def test_upload_file_into_folder_deleted_while_streaming(self, mock_collection, mock_sessions, mock_folders, mock_s3):
+        mock_collection.aggregate.return_value = []
+        mock_sessions.aggregate.return_value = []
+        folder_id = "65a000000000000000000001"
+        mock_folders.find_one.side_effect = [{"_id": ObjectId(folder_id), "user_id": "user-123"}, None]
+
+        auth_client = Mock()
+        auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
+            valid=True,
+            user=user_pb2.User(id="user-123", username="testuser", role=user_pb2.Role.ROLE_USER)
+        )
+
+        service = FileService(auth_client)
+        context = Mock()
+        context.invocation_metadata.return_value = [("authorization", "Bearer valid-token")]
+        context.abort.side_effect = Exception("Aborted")
+
+        def request_iterator():
+            yield file_pb2.UploadFileRequest(
+                metadata=file_pb2.UploadFileMetadata(filename="test.txt", parent_id=folder_id)
+            )
+            yield file_pb2.UploadFileRequest(chunk=b"data")
+
+        with pytest.raises(Exception):
+            service.UploadFile(request_iterator(), context)
+
+        context.abort.assert_called_once_with(grpc.StatusCode.NOT_FOUND, "Folder not found")
+        mock_s3.upload_fileobj.assert_not_called()
+        mock_collection.insert_one.assert_not_called()
+
+    @pytest.mark.parametrize("filename", ["", "..", "a/b.txt", "tab\tname", "x" * 256])
+    @patch('file_service.service.files_collection')
+    def test_upload_file_rejects_names_a_rename_would(self, mock_collection, filename):
+        auth_client = Mock()
+        auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
+            valid=True,
+            user=user_pb2.User(id="user-123", username="testuser", role=user_pb2.Role.ROLE_USER)
+        )
+
+        service = FileService(auth_client)
+        context = Mock()
+        context.invocation_metadata.return_value = [("authorization", "Bearer valid-token")]
+        context.abort.side_effect = Exception("Aborted")
+
+        def request_iterator():
+            yield file_pb2.UploadFileRequest(metadata=file_pb2.UploadFileMetadata(filename=filename))
+
+        with pytest.raises(Exception):
+            service.UploadFile(request_iterator(), context)
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.INVALID_ARGUMENT
+        mock_collection.insert_one.assert_not_called()
+
+    @patch('file_service.service.upload_sessions_collection')
+    @patch('file_service.service.files_collection')
+    def test_upload_file_without_quota_uses_default(self, mock_collection, mock_sessions):
+        mock_collection.aggregate.return_value = [{"count": 20, "bytes": 1024}]
+        mock_sessions.aggregate.return_value = []
+
+        auth_client = Mock()
+        auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
+            valid=True,
+            user=user_pb2.User(id="user-123", username="testuser", role=user_pb2.Role.ROLE_USER)
+        )
+
+        service = FileService(auth_client)
+        context = Mock()
+        context.invocation_metadata.return_value = [("authorization", "Bearer valid-token")]
+        context.abort.side_effect = Exception("Aborted")
+
+        def request_iterator():
+            yield file_pb2.UploadFileRequest(
+                metadata=file_pb2.UploadFileMetadata(filename="test.txt")
+            )
+
+        with pytest.raises(Exception):
+            service.UploadFile(request_iterator(), context)
+
+        context.abort.assert_called_once_with(
+            grpc.StatusCode.RESOURCE_EXHAUSTED,
+            "File quota reached (20 files)"
+        )
+
+    @patch('file_service.service.from_gateway', return_value=False)
+    @patch('file_service.service.upload_sessions_collection')
+    @patch('file_service.service.files_collection')
+    def test_upload_file_ignores_quota_from_outside_the_gateway(self, mock_collection, mock_sessions, _from_gateway):
+        mock_collection.aggregate.return_value = [{"count": 20, "bytes": 1024}]
+        mock_sessions.aggregate.return_value = []
+
+        auth_client = Mock()
+        auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
+            valid=True,
+            user=user_pb2.User(id="user-123", username="testuser", role=user_pb2.Role.ROLE_USER)
+        )
+
+        service = FileService(auth_client)
+        context = Mock()
+        context.invocation_metadata.return_value = [("authorization", "Bearer valid-token")]
+        context.abort.side_effect = Exception("Aborted")
+
+        def request_iterator():
+            yield file_pb2.UploadFileRequest(
+                metadata=file_pb2.UploadFileMetadata(
+                    filename="test.txt",
+                    quota=file_pb2.StorageQuota(),
+                )
+            )
+
+        with pytest.raises(Exception):
+            service.UploadFile(request_iterator(), context)
+
+        context.abort.assert_called_once_with(
+            grpc.StatusCode.RESOURCE_EXHAUSTED,
+            "File quota reached (20 files)"
+        )
+
+    @patch('file_service.service.s3_client')
+    @patch('file_service.service.upload_sessions_collection')
+    @patch('file_service.service.files_collection')
+    def test_upload_file_stores_sha256(self, mock_collection, mock_sessions, mock_s3):
+        auth_client = Mock()
+        auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
+            valid=True,
+            user=user_pb2.User(id="user-123", username="testuser", role=user_pb2.Role.ROLE_USER)
+        )
+
+        service = FileService(auth_client)
+        context = Mock()
+        context.invocation_metadata.return_value = [("authorization", "Bearer valid-token")]
+
+        digest = hashlib.sha256(b"hello world").hexdigest()
+
+        def request_iterator():
+            yield file_pb2.UploadFileRequest(
+                metadata=file_pb2.UploadFileMetadata(filename="test.txt", sha256=digest.upper())
+            )
+            yield file_pb2.UploadFileRequest(chunk=b"hello ")
+            yield file_pb2.UploadFileRequest(chunk=b"world")
+
+        response = service.UploadFile(request_iterator(), context)
+
+        assert response.file.sha256 == digest
+        assert mock_collection.insert_one.call_args[0][0]["sha256"] == digest
+
+    @patch('file_service.service.s3_client')
+    @patch('file_service.service.upload_sessions_collection')
+    @patch('file_service.service.files_collection')
+    def test_upload_file_checksum_mismatch(self, mock_collection, mock_sessions, mock_s3):
+        auth_client = Mock()
+        auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
+            valid=True,
+            user=user_pb2.User(id="user-123", username="testuser", role=user_pb2.Role.ROLE_USER)
+        )
+
+        service = FileService(auth_client)
+        context = Mock()
+        context.invocation_metadata.return_value = [("authorization", "Bearer valid-token")]
+        context.abort.side_effect = Exception("Aborted")
+
+        def request_iterator():
+            yield file_pb2.UploadFileRequest(
+                metadata=file_pb2.UploadFileMetadata(
+                    filename="test.txt",
+                    sha256=hashlib.sha256(b"something else").hexdigest(),
+                )
+            )
+            yield file_pb2.UploadFileRequest(chunk=b"hello world")
+
+        with pytest.raises(Exception):
+            service.UploadFile(request_iterator(), context)
+
+        context.abort.assert_called_once_with(grpc.StatusCode.INVALID_ARGUMENT, "Checksum mismatch")
+        mock_s3.upload_fileobj.assert_not_called()
+        mock_collection.insert_one.assert_not_called()
+
+    @patch('file_service.service.upload_sessions_collection')
+    @patch('file_service.service.files_collection')
+    def test_get_usage_counts_pending_uploads(self, mock_collection, mock_sessions):
+        mock_collection.aggregate.return_value = [{"count": 3, "bytes": 300}]
+        mock_sessions.aggregate.return_value = [{"count": 1, "bytes": 50}]
+
+        auth_client = Mock()
+        auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
+            valid=True,
+            user=user_pb2.User(id="user-123", username="testuser", role=user_pb2.Role.ROLE_USER)
+        )
+
+        service = FileService(auth_client)
+        context = Mock()
+        context.invocation_metadata.return_value = [("authorization", "Bearer valid-token")]
+
+        response = service.GetUsage(file_pb2.GetUsageRequest(), context)
+
+        assert (response.file_count, response.total_bytes) == (3, 300)
+        assert (response.pending_uploads, response.pending_bytes) == (1, 50)
+        assert mock_sessions.aggregate.call_args[0][0][0] == {"$match": {"user_id": "user-123", "status": "in_progress"}}
+
     @pytest.mark.integration
+    @patch('file_service.service.upload_sessions_collection')
     @patch('file_service.service.files_collection')
-    def test_upload_file_exceeds_max_file_size(self, mock_collection):
+    def test_upload_file_exceeds_max_file_size(self, mock_collection, mock_sessions):
         mock_collection.count_documents.return_value = 5
 
         auth_client = Mock()
@@ -486,9 +738,45 @@ class TestDownloadFile:
         assert responses[1].chunk == b"a" * 100
         assert responses[2].chunk == b"b" * 100
 
+    @patch('file_service.service.s3_client')
+    @patch('file_service.service.files_collection')
+    def test_download_file_byte_range(self, mock_collection, mock_s3):
+        mock_collection.find_one.return_value = {
+            "_id": "507f1f77bcf86cd799439011",
+            "user_id": "user-123",
+            "filename": "big.bin",
+            "size": 200,
+            "content_type": "application/octet-stream",
+            "s3_key": "user-123/507f1f77bcf86cd799439011/big.bin",
+            "created_at": 1234567890,
+        }
+
+        body_mock = MagicMock()
+        body_mock.read.side_effect = [b"b" * 50, b""]
+        mock_s3.get_object.return_value = {"Body": body_mock}
+
+        auth_client = Mock()
+        auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
+            valid=True,
+            user=user_pb2.User(id="user-123", username="testuser", role=user_pb2.Role.ROLE_USER)
+        )
+
+        service = FileService(auth_client)
+        context = Mock()
+        context.invocation_metadata.return_value = [("authorization", "Bearer valid-token")]
+
+        request = file_pb2.DownloadFileRequest(id="507f1f77bcf86cd799439011", offset=100, length=50)
+        responses = list(service.DownloadFile(request, context))
+
+        assert responses[0].metadata.size == 200
+        assert responses[1].chunk == b"b" * 50
+        assert mock_s3.get_object.call_args.kwargs["Range"] == "bytes=100-149"
+
+    @patch('file_service.service.shares_collection')
     @patch('file_service.service.files_collection')
-    def test_download_file_not_found(self, mock_collection):
+    def test_download_file_not_found(self, mock_collection, mock_shares):
         mock_collection.find_one.return_value = None
+        mock_shares.find_one.return_value = None
 
         auth_client = Mock()
         auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
@@ -547,3 +835,932 @@ class TestDownloadFile:
 
         context.abort.assert_called_once()
         assert context.abort.call_args[0][0] == grpc.StatusCode.INTERNAL
+
+
+class TestDownloadLinks:
+
+    def _service(self):
+        auth_client = Mock()
+        auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
+            valid=True,
+            user=user_pb2.User(id="user-123", username="testuser", role=user_pb2.Role.ROLE_USER)
+        )
+        context = Mock()
+        context.invocation_metadata.return_value = [("authorization", "Bearer valid-token")]
+        context.abort.side_effect = Exception("Aborted")
+        return FileService(auth_client), context
+
+    @patch('file_service.service.download_links_collection')
+    @patch('file_service.service.files_collection')
+    def test_create_download_link(self, mock_collection, mock_links):
+        mock_collection.find_one.return_value = {"_id": "507f1f77bcf86cd799439011", "user_id": "user-123"}
+        service, context = self._service()
+
+        expires_at = int(time.time()) + 3600
+        request = file_pb2.CreateDownloadLinkRequest(
+            file_id="507f1f77bcf86cd799439011", expires_at=expires_at, max_downloads=3
+        )
+        link = service.CreateDownloadLink(request, context)
+
+        assert link.file_id == "507f1f77bcf86cd799439011"
+        assert link.expires_at == expires_at
+        assert link.max_downloads == 3
+        assert link.download_count == 0
+        stored = mock_links.insert_one.call_args[0][0]
+        assert stored["user_id"] == "user-123"
+        assert str(stored["_id"]) == link.id
+
+    @patch('file_service.service.files_collection')
+    def test_create_download_link_rejects_past_expiry(self, mock_collection):
+        mock_collection.find_one.return_value = {"_id": "507f1f77bcf86cd799439011", "user_id": "user-123"}
+        service, context = self._service()
+
+        request = file_pb2.CreateDownloadLinkRequest(file_id="507f1f77bcf86cd799439011", expires_at=1)
+        with pytest.raises(Exception):
+            service.CreateDownloadLink(request, context)
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.INVALID_ARGUMENT
+
+    @patch('file_service.service.from_gateway', return_value=True)
+    @patch('file_service.service.s3_client')
+    @patch('file_service.service.download_links_collection')
+    @patch('file_service.service.files_collection')
+    def test_download_through_link_counts_download(self, mock_collection, mock_links, mock_s3, _from_gateway):
+        mock_links.find_one_and_update.return_value = {
+            "_id": "65a000000000000000000001",
+            "user_id": "user-123",
+            "file_id": "507f1f77bcf86cd799439011",
+        }
+        mock_collection.find_one.return_value = {
+            "_id": "507f1f77bcf86cd799439011",
+            "user_id": "user-123",
+            "filename": "test.txt",
+            "size": 11,
+            "content_type": "text/plain",
+            "s3_key": "user-123/507f1f77bcf86cd799439011/test.txt",
+            "created_at": 1234567890,
+        }
+        body_mock = MagicMock()
+        body_mock.read.side_effect = [b"hello world", b""]
+        mock_s3.get_object.return_value = {"Body": body_mock}
+
+        auth_client = Mock()
+        service = FileService(auth_client)
+        context = Mock()
+        context.invocation_metadata.return_value = []
+
+        request = file_pb2.DownloadFileRequest(link_id="65a000000000000000000001")
+        responses = list(service.DownloadFile(request, context))
+
+        assert responses[1].chunk == b"hello world"
+        auth_client.validate_token.assert_not_called()
+        update = mock_links.find_one_and_update.call_args[0][1]
+        assert update == {"$inc": {"download_count": 1}}
+
+    @patch('file_service.service.from_gateway', return_value=True)
+    @patch('file_service.service.download_links_collection')
+    def test_expired_link_is_not_found(self, mock_links, _from_gateway):
+        mock_links.find_one.return_value = None
+        service, context = self._service()
+
+        request = file_pb2.GetFileRequest(link_id="65a000000000000000000001")
+        with pytest.raises(Exception):
+            service.GetFile(request, context)
+
+        context.abort.assert_called_once_with(grpc.StatusCode.NOT_FOUND, "Download link not found or expired")
+
+    @patch('file_service.service.from_gateway', return_value=False)
+    @patch('file_service.service.download_links_collection')
+    def test_link_is_refused_from_outside_the_gateway(self, mock_links, _from_gateway):
+        service = FileService(Mock())
+        context = Mock()
+        context.invocation_metadata.return_value = []
+        context.abort.side_effect = Exception("Aborted")
+
+        request = file_pb2.DownloadFileRequest(link_id="65a000000000000000000001")
+        with pytest.raises(Exception):
+            list(service.DownloadFile(request, context))
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.PERMISSION_DENIED
+        mock_links.find_one_and_update.assert_not_called()
+
+    @patch('file_service.service.download_links_collection')
+    def test_revoke_download_link(self, mock_links):
+        mock_links.delete_one.return_value = Mock(deleted_count=1)
+        service, context = self._service()
+
+        request = file_pb2.RevokeDownloadLinkRequest(file_id="507f1f77bcf86cd799439011", id="65a000000000000000000001")
+        response = service.RevokeDownloadLink(request, context)
+
+        assert response.success is True
+        query = mock_links.delete_one.call_args[0][0]
+        assert query["user_id"] == "user-123"
+        assert query["file_id"] == "507f1f77bcf86cd799439011"
+
+
+class TestShares:
+
+    FILE = {
+        "_id": "507f1f77bcf86cd799439011",
+        "user_id": "owner-1",
+        "filename": "shared.txt",
+        "size": 11,
+        "content_type": "text/plain",
+        "s3_key": "owner-1/507f1f77bcf86cd799439011/shared.txt",
+        "created_at": 1234567890,
+    }
+
+    def _service(self, user_id="user-123"):
+        auth_client = Mock()
+        auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
+            valid=True,
+            user=user_pb2.User(id=user_id, username="testuser", role=user_pb2.Role.ROLE_USER)
+        )
+        context = Mock()
+        context.invocation_metadata.return_value = [("authorization", "Bearer valid-token")]
+        context.abort.side_effect = Exception("Aborted")
+        return FileService(auth_client), context
+
+    @patch('file_service.service.shares_collection')
+    @patch('file_service.service.files_collection')
+    def test_share_file(self, mock_collection, mock_shares):
+        mock_collection.find_one.return_value = {"_id": "507f1f77bcf86cd799439011", "user_id": "user-123"}
+        mock_shares.find_one_and_update.return_value = {
+            "_id": "65a000000000000000000002",
+            "file_id": "507f1f77bcf86cd799439011",
+            "user_id": "friend-1",
+            "username": "friend",
+            "permission": file_pb2.SHARE_PERMISSION_DOWNLOAD,
+            "created_at": 1234567890,
+        }
+        service, context = self._service()
+
+        request = file_pb2.ShareFileRequest(
+            file_id="507f1f77bcf86cd799439011",
+            user_id="friend-1",
+            username="friend",
+            permission=file_pb2.SHARE_PERMISSION_DOWNLOAD,
+        )
+        share = service.ShareFile(request, context)
+
+        assert share.username == "friend"
+        assert share.permission == file_pb2.SHARE_PERMISSION_DOWNLOAD
+        query, update = mock_shares.find_one_and_update.call_args[0]
+        assert query == {"file_id": "507f1f77bcf86cd799439011", "user_id": "friend-1"}
+        assert update["$setOnInsert"]["owner_id"] == "user-123"
+
+    @patch('file_service.service.shares_collection')
+    @patch('file_service.service.files_collection')
+    def test_share_file_with_self_is_rejected(self, mock_collection, mock_shares):
+        mock_collection.find_one.return_value = {"_id": "507f1f77bcf86cd799439011", "user_id": "user-123"}
+        service, context = self._service()
+
+        request = file_pb2.ShareFileRequest(
+            file_id="507f1f77bcf86cd799439011",
+            user_id="user-123",
+            permission=file_pb2.SHARE_PERMISSION_VIEW,
+        )
+        with pytest.raises(Exception):
+            service.ShareFile(request, context)
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.INVALID_ARGUMENT
+        mock_shares.find_one_and_update.assert_not_called()
+
+    @patch('file_service.service.shares_collection')
+    @patch('file_service.service.files_collection')
+    def test_get_shared_file(self, mock_collection, mock_shares):
+        mock_collection.find_one.side_effect = [None, self.FILE]
+        mock_shares.find_one.return_value = {"permission": file_pb2.SHARE_PERMISSION_VIEW}
+        service, context = self._service()
+
+        request = file_pb2.GetFileRequest(id="507f1f77bcf86cd799439011")
+        response = service.GetFile(request, context)
+
+        assert response.file.filename == "shared.txt"
+        assert response.file.user_id == "owner-1"
+        assert mock_shares.find_one.call_args[0][0] == {"file_id": "507f1f77bcf86cd799439011", "user_id": "user-123"}
+
+    @patch('file_service.service.shares_collection')
+    @patch('file_service.service.files_collection')
+    def test_view_share_cannot_download(self, mock_collection, mock_shares):
+        mock_collection.find_one.side_effect = [None, self.FILE]
+        mock_shares.find_one.return_value = {"permission": file_pb2.SHARE_PERMISSION_VIEW}
+        service, context = self._service()
+
+        request = file_pb2.DownloadFileRequest(id="507f1f77bcf86cd799439011")
+        with pytest.raises(Exception):
+            list(service.DownloadFile(request, context))
+
+        context.abort.assert_called_once_with(
+            grpc.StatusCode.PERMISSION_DENIED,
+            "File is shared with you for viewing only",
+        )
+
+    @patch('file_service.service.s3_client')
+    @patch('file_service.service.shares_collection')
+    @patch('file_service.service.files_collection')
+    def test_download_share_can_download(self, mock_collection, mock_shares, mock_s3):
+        mock_collection.find_one.side_effect = [None, self.FILE]
+        mock_shares.find_one.return_value = {"permission": file_pb2.SHARE_PERMISSION_DOWNLOAD}
+        body_mock = MagicMock()
+        body_mock.read.side_effect = [b"hello world", b""]
+        mock_s3.get_object.return_value = {"Body": body_mock}
+        service, context = self._service()
+
+        request = file_pb2.DownloadFileRequest(id="507f1f77bcf86cd799439011")
+        responses = list(service.DownloadFile(request, context))
+
+        assert responses[1].chunk == b"hello world"
+        context.abort.assert_not_called()
+
+    @patch('file_service.service.shares_collection')
+    @patch('file_service.service.files_collection')
+    def test_list_shared_with_me(self, mock_collection, mock_shares):
+        mock_shares.find.return_value = [
+            {"file_id": "507f1f77bcf86cd799439011", "permission": file_pb2.SHARE_PERMISSION_DOWNLOAD},
+        ]
+        mock_collection.find.return_value = [self.FILE]
+        service, context = self._service()
+
+        response = service.ListSharedWithMe(file_pb2.ListSharedWithMeRequest(), context)
+
+        assert len(response.files) == 1
+        assert response.files[0].file.filename == "shared.txt"
+        assert response.files[0].permission == file_pb2.SHARE_PERMISSION_DOWNLOAD
+        mock_shares.find.assert_called_once_with({"user_id": "user-123"})
+
+    @patch('file_service.service.shares_collection')
+    def test_revoke_share_not_found(self, mock_shares):
+        mock_shares.delete_one.return_value = Mock(deleted_count=0)
+        service, context = self._service()
+
+        request = file_pb2.RevokeShareRequest(file_id="507f1f77bcf86cd799439011", id="65a000000000000000000002")
+        with pytest.raises(Exception):
+            service.RevokeShare(request, context)
+
+        context.abort.assert_called_once_with(grpc.StatusCode.NOT_FOUND, "Share not found")
+
+
+class TestFolders:
+
+    def _service(self):
+        auth_client = Mock()
+        auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
+            valid=True,
+            user=user_pb2.User(id="user-123", username="testuser", role=user_pb2.Role.ROLE_USER)
+        )
+        context = Mock()
+        context.invocation_metadata.return_value = [("authorization", "Bearer valid-token")]
+        context.abort.side_effect = Exception("Aborted")
+        return FileService(auth_client), context
+
+    @patch('file_service.service.folders_collection')
+    def test_create_folder(self, mock_folders):
+        service, context = self._service()
+
+        folder = service.CreateFolder(file_pb2.CreateFolderRequest(name="Reports"), context)
+
+        assert folder.name == "Reports"
+        assert folder.parent_id == ""
+        stored = mock_folders.insert_one.call_args[0][0]
+        assert stored["user_id"] == "user-123"
+        assert str(stored["_id"]) == folder.id
+
+    @pytest.mark.parametrize("name", ["", "..", "a/b", "a\\b", "tab\there"])
+    @patch('file_service.service.folders_collection')
+    def test_create_folder_rejects_invalid_name(self, mock_folders, name):
+        service, context = self._service()
+
+        with pytest.raises(Exception):
+            service.CreateFolder(file_pb2.CreateFolderRequest(name=name), context)
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.INVALID_ARGUMENT
+        mock_folders.insert_one.assert_not_called()
+
+    @patch('file_service.service.folders_collection')
+    def test_create_folder_duplicate_name(self, mock_folders):
+        from pymongo.errors import DuplicateKeyError
+        mock_folders.insert_one.side_effect = DuplicateKeyError("duplicate")
+        service, context = self._service()
+
+        with pytest.raises(Exception):
+            service.CreateFolder(file_pb2.CreateFolderRequest(name="Reports"), context)
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.ALREADY_EXISTS
+
+    @patch('file_service.service.folders_collection')
+    def test_move_folder_into_own_subfolder_is_rejected(self, mock_folders):
+        parent = {"_id": ObjectId("65a000000000000000000001"), "user_id": "user-123", "name": "a", "parent_id": "", "created_at": 1}
+        child = {"_id": ObjectId("65a000000000000000000002"), "user_id": "user-123", "name": "b", "parent_id": str(parent["_id"]), "created_at": 1}
+        by_id = {parent["_id"]: parent, child["_id"]: child}
+        mock_folders.find_one.side_effect = lambda query: by_id.get(query["_id"])
+        service, context = self._service()
+
+        request = file_pb2.MoveFolderRequest(id=str(parent["_id"]), parent_id=str(child["_id"]))
+        with pytest.raises(Exception):
+            service.MoveFolder(request, context)
+
+        context.abort.assert_called_once_with(
+            grpc.StatusCode.INVALID_ARGUMENT,
+            "Cannot move a folder into itself or one of its subfolders",
+        )
+        mock_folders.update_one.assert_not_called()
+
+    @patch('file_service.service.folders_collection')
+    @patch('file_service.service.files_collection')
+    def test_list_folder_contents_with_breadcrumbs(self, mock_collection, mock_folders):
+        root = {"_id": ObjectId("65a000000000000000000001"), "user_id": "user-123", "name": "a", "parent_id": "", "created_at": 1}
+        child = {"_id": ObjectId("65a000000000000000000002"), "user_id": "user-123", "name": "b", "parent_id": str(root["_id"]), "created_at": 2}
+        by_id = {root["_id"]: root, child["_id"]: child}
+        mock_folders.find_one.side_effect = lambda query: by_id.get(query["_id"])
+        mock_folders.find.return_value.sort.return_value = []
+        mock_collection.find.return_value = [{
+            "_id": "507f1f77bcf86cd799439011",
+            "user_id": "user-123",
+            "filename": "notes.txt",
+            "size": 10,
+            "content_type": "text/plain",
+            "created_at": 3,
+            "parent_id": str(child["_id"]),
+        }]
+        service, context = self._service()
+
+        response = service.ListFiles(file_pb2.ListFilesRequest(folder_id=str(child["_id"])), context)
+
+        assert [f.filename for f in response.files] == ["notes.txt"]
+        assert response.files[0].parent_id == str(child["_id"])
+        assert [b.name for b in response.breadcrumbs] == ["a", "b"]
+        mock_collection.find.assert_called_once_with({"user_id": "user-123", "parent_id": str(child["_id"])}, sort=ANY, skip=0, limit=50)
+
+    @patch('file_service.service.folders_collection')
+    @patch('file_service.service.files_collection')
+    def test_list_top_level_includes_files_without_parent(self, mock_collection, mock_folders):
+        mock_collection.find.return_value = []
+        mock_folders.find.return_value.sort.return_value = []
+        service, context = self._service()
+
+        response = service.ListFiles(file_pb2.ListFilesRequest(folder_id=""), context)
+
+        assert len(response.breadcrumbs) == 0
+        mock_collection.find.assert_called_once_with({"user_id": "user-123", "parent_id": {"$in": ["", None]}}, sort=ANY, skip=0, limit=50)
+
+    @patch('file_service.service.upload_sessions_collection')
+    @patch('file_service.service.shares_collection')
+    @patch('file_service.service.download_links_collection')
+    @patch('file_service.service.s3_client')
+    @patch('file_service.service.folders_collection')
+    @patch('file_service.service.files_collection')
+    def test_delete_folder_is_recursive(self, mock_collection, mock_folders, mock_s3, mock_links, mock_shares, mock_sessions):
+        top = {"_id": ObjectId("65a000000000000000000001"), "user_id": "user-123", "name": "a", "parent_id": "", "created_at": 1}
+        mock_folders.find_one.return_value = top
+        mock_folders.find.side_effect = [
+            [{"_id": ObjectId("65a000000000000000000002")}],
+            [],
+        ]
+        mock_folders.delete_many.return_value = Mock(deleted_count=2)
+        mock_sessions.count_documents.return_value = 0
+        mock_collection.find.return_value = [
+            {"_id": ObjectId("507f1f77bcf86cd799439011"), "s3_key": "user-123/a.txt"},
+            {"_id": ObjectId("507f1f77bcf86cd799439012"), "s3_key": "user-123/b.txt"},
+        ]
+        mock_collection.delete_one.return_value = Mock(deleted_count=1)
+        service, context = self._service()
+
+        response = service.DeleteFolder(file_pb2.DeleteFolderRequest(id=str(top["_id"])), context)
+
+        assert response.folders_deleted == 2
+        assert response.files_deleted == 2
+        assert mock_s3.delete_object.call_count == 2
+        files_query = mock_collection.find.call_args[0][0]
+        assert files_query["parent_id"] == {"$in": ["65a000000000000000000001", "65a000000000000000000002"]}
+
+    @patch('file_service.service.upload_sessions_collection')
+    @patch('file_service.service.s3_client')
+    @patch('file_service.service.folders_collection')
+    @patch('file_service.service.files_collection')
+    def test_delete_folder_with_upload_in_progress_is_refused(self, mock_collection, mock_folders, mock_s3, mock_sessions):
+        top = {"_id": ObjectId("65a000000000000000000001"), "user_id": "user-123", "name": "a", "parent_id": "", "created_at": 1}
+        mock_folders.find_one.return_value = top
+        mock_folders.find.side_effect = [
+            [{"_id": ObjectId("65a000000000000000000002")}],
+            [],
+        ]
+        mock_sessions.count_documents.return_value = 1
+        service, context = self._service()
+        context.abort.side_effect = Exception("Aborted")
+
+        with pytest.raises(Exception):
+            service.DeleteFolder(file_pb2.DeleteFolderRequest(id=str(top["_id"])), context)
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.FAILED_PRECONDITION
+        query = mock_sessions.count_documents.call_args[0][0]
+        assert query["parent_id"] == {"$in": ["65a000000000000000000001", "65a000000000000000000002"]}
+        mock_s3.delete_object.assert_not_called()
+        mock_folders.delete_many.assert_not_called()
+
+
+class TestUpdateFile:
+
+    FILE_ID = "507f1f77bcf86cd799439011"
+
+    def _service(self):
+        auth_client = Mock()
+        auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
+            valid=True,
+            user=user_pb2.User(id="user-123", username="testuser", role=user_pb2.Role.ROLE_USER)
+        )
+        context = Mock()
+        context.invocation_metadata.return_value = [("authorization", "Bearer valid-token")]
+        context.abort.side_effect = Exception("Aborted")
+        return FileService(auth_client), context
+
+    def _doc(self, **overrides):
+        doc = {
+            "_id": ObjectId(self.FILE_ID),
+            "user_id": "user-123",
+            "filename": "draft.txt",
+            "size": 10,
+            "content_type": "text/plain",
+            "created_at": 1,
+            "parent_id": "",
+        }
+        doc.update(overrides)
+        return doc
+
+    def _request(self, paths, **fields):
+        request = file_pb2.UpdateFileRequest(id=self.FILE_ID, **fields)
+        request.update_mask.paths.extend(paths)
+        return request
+
+    @patch('file_service.service.files_collection')
+    def test_rename_file(self, mock_collection):
+        mock_collection.find_one.side_effect = [self._doc(), None]
+        service, context = self._service()
+
+        response = service.UpdateFile(self._request(["filename"], filename="final.txt", content_type="ignored/type"), context)
+
+        assert response.file.filename == "final.txt"
+        assert response.file.content_type == "text/plain"
+        mock_collection.update_one.assert_called_once_with(
+            {"_id": ObjectId(self.FILE_ID), "user_id": "user-123"},
+            {"$set": {"filename": "final.txt"}},
+        )
+
+    @patch('file_service.service.files_collection')
+    def test_update_content_type_only(self, mock_collection):
+        mock_collection.find_one.return_value = self._doc()
+        service, context = self._service()
+
+        response = service.UpdateFile(self._request(["content_type"], content_type="text/markdown"), context)
+
+        assert response.file.content_type == "text/markdown"
+        mock_collection.update_one.assert_called_once_with(
+            {"_id": ObjectId(self.FILE_ID), "user_id": "user-123"},
+            {"$set": {"content_type": "text/markdown"}},
+        )
+
+    @pytest.mark.parametrize("paths", [[], ["size"]])
+    @patch('file_service.service.files_collection')
+    def test_update_rejects_bad_mask(self, mock_collection, paths):
+        service, context = self._service()
+
+        with pytest.raises(Exception):
+            service.UpdateFile(self._request(paths, filename="x.txt"), context)
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.INVALID_ARGUMENT
+        mock_collection.update_one.assert_not_called()
+
+    @pytest.mark.parametrize("filename", ["a/b.txt", "..", "line\nbreak.txt"])
+    @patch('file_service.service.files_collection')
+    def test_rename_rejects_invalid_filename(self, mock_collection, filename):
+        mock_collection.find_one.return_value = self._doc()
+        service, context = self._service()
+
+        with pytest.raises(Exception):
+            service.UpdateFile(self._request(["filename"], filename=filename), context)
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.INVALID_ARGUMENT
+
+    @patch('file_service.service.files_collection')
+    def test_rename_conflict_fails_by_default(self, mock_collection):
+        mock_collection.find_one.side_effect = [self._doc(), self._doc(_id=ObjectId(), filename="final.txt")]
+        service, context = self._service()
+
+        with pytest.raises(Exception):
+            service.UpdateFile(self._request(["filename"], filename="final.txt"), context)
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.ALREADY_EXISTS
+        mock_collection.update_one.assert_not_called()
+
+    @patch('file_service.service.files_collection')
+    def test_rename_conflict_picks_free_name(self, mock_collection):
+        taken = self._doc(_id=ObjectId())
+        mock_collection.find_one.side_effect = [self._doc(), taken, taken, None]
+        service, context = self._service()
+
+        request = self._request(["filename"], filename="final.txt", on_conflict=file_pb2.CONFLICT_POLICY_RENAME)
+        response = service.UpdateFile(request, context)
+
+        assert response.file.filename == "final (2).txt"
+
+    @patch('file_service.service.shares_collection')
+    @patch('file_service.service.download_links_collection')
+    @patch('file_service.service.files_collection')
+    def test_rename_conflict_replaces_existing(self, mock_collection, mock_links, mock_shares):
+        existing_id = ObjectId()
+        mock_collection.find_one.side_effect = [self._doc(), self._doc(_id=existing_id, filename="final.txt")]
+        mock_collection.delete_one.return_value = Mock(deleted_count=1)
+        service, context = self._service()
+
+        request = self._request(["filename"], filename="final.txt", on_conflict=file_pb2.CONFLICT_POLICY_REPLACE)
+        response = service.UpdateFile(request, context)
+
+        assert response.file.filename == "final.txt"
+        mock_collection.delete_one.assert_called_once_with({"_id": existing_id, "user_id": "user-123"})
+
+
+class TestTagsAndSearch:
+
+    FILE_ID = "507f1f77bcf86cd799439011"
+
+    def _service(self):
+        auth_client = Mock()
+        auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
+            valid=True,
+            user=user_pb2.User(id="user-123", username="testuser", role=user_pb2.Role.ROLE_USER)
+        )
+        context = Mock()
+        context.invocation_metadata.return_value = [("authorization", "Bearer valid-token")]
+        context.abort.side_effect = Exception("Aborted")
+        return FileService(auth_client), context
+
+    def _doc(self, **overrides):
+        doc = {
+            "_id": ObjectId(self.FILE_ID),
+            "user_id": "user-123",
+            "filename": "report.pdf",
+            "size": 2048,
+            "content_type": "application/pdf",
+            "created_at": 1700000000,
+            "tags": ["work"],
+        }
+        doc.update(overrides)
+        return doc
+
+    @patch('file_service.service.files_collection')
+    def test_search_builds_filter(self, mock_collection):
+        mock_collection.find.return_value = [self._doc(metadata={"project": "apollo"})]
+        service, context = self._service()
+
+        request = file_pb2.ListFilesRequest(
+            query="rep.rt",
+            tags=[" Work ", "2024"],
+            content_type="image/*",
+            min_size=1024,
+            created_before=1800000000,
+        )
+        response = service.ListFiles(request, context)
+
+        mock_collection.find.assert_called_once_with({
+            "user_id": "user-123",
+            "filename": {"$regex": r"rep\.rt", "$options": "i"},
+            "tags": {"$all": ["work", "2024"]},
+            "content_type": {"$regex": "^image/", "$options": "i"},
+            "size": {"$gte": 1024},
+            "created_at": {"$lt": 1800000000},
+        }, sort=ANY, skip=0, limit=50)
+        assert list(response.files[0].tags) == ["work"]
+        assert response.files[0].metadata["project"] == "apollo"
+
+    @patch('file_service.service.files_collection')
+    def test_search_exact_content_type_and_zero_max_size(self, mock_collection):
+        mock_collection.find.return_value = []
+        service, context = self._service()
+
+        service.ListFiles(file_pb2.ListFilesRequest(content_type="text/plain", max_size=0), context)
+
+        mock_collection.find.assert_called_once_with({
+            "user_id": "user-123",
+            "content_type": {"$regex": "^text/plain$", "$options": "i"},
+            "size": {"$lte": 0},
+        }, sort=ANY, skip=0, limit=50)
+
+    @patch('file_service.service.files_collection')
+    def test_add_tags_skips_existing(self, mock_collection):
+        mock_collection.find_one.return_value = self._doc()
+        service, context = self._service()
+
+        response = service.AddFileTags(file_pb2.FileTagsRequest(file_id=self.FILE_ID, tags=["WORK", "q3"]), context)
+
+        assert list(response.file.tags) == ["work", "q3"]
+        mock_collection.update_one.assert_called_once_with(
+            {"_id": ObjectId(self.FILE_ID), "user_id": "user-123"},
+            {"$set": {"tags": ["work", "q3"]}},
+        )
+
+    @patch('file_service.service.files_collection')
+    def test_add_tags_enforces_limit(self, mock_collection):
+        mock_collection.find_one.return_value = self._doc(tags=[f"t{i}" for i in range(20)])
+        service, context = self._service()
+
+        with pytest.raises(Exception):
+            service.AddFileTags(file_pb2.FileTagsRequest(file_id=self.FILE_ID, tags=["one-more"]), context)
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.INVALID_ARGUMENT
+        mock_collection.update_one.assert_not_called()
+
+    @patch('file_service.service.files_collection')
+    def test_remove_tags(self, mock_collection):
+        mock_collection.find_one.return_value = self._doc(tags=["work", "q3"])
+        service, context = self._service()
+
+        response = service.RemoveFileTags(file_pb2.FileTagsRequest(file_id=self.FILE_ID, tags=["Work"]), context)
+
+        assert list(response.file.tags) == ["q3"]
+
+    @patch('file_service.service.files_collection')
+    def test_add_tags_to_missing_file(self, mock_collection):
+        mock_collection.find_one.return_value = None
+        service, context = self._service()
+
+        with pytest.raises(Exception):
+            service.AddFileTags(file_pb2.FileTagsRequest(file_id=self.FILE_ID, tags=["work"]), context)
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.NOT_FOUND
+
+    @patch('file_service.service.files_collection')
+    def test_list_tags(self, mock_collection):
+        mock_collection.aggregate.return_value = [{"_id": "q3", "count": 1}, {"_id": "work", "count": 4}]
+        service, context = self._service()
+
+        response = service.ListTags(file_pb2.ListTagsRequest(), context)
+
+        assert [(t.name, t.count) for t in response.tags] == [("q3", 1), ("work", 4)]
+        pipeline = mock_collection.aggregate.call_args[0][0]
+        assert pipeline[0] == {"$match": {"user_id": "user-123"}}
+
+    @patch('file_service.service.files_collection')
+    def test_update_metadata(self, mock_collection):
+        mock_collection.find_one.return_value = self._doc()
+        service, context = self._service()
+
+        request = file_pb2.UpdateFileRequest(id=self.FILE_ID, metadata={"project": "apollo"})
+        request.update_mask.paths.append("metadata")
+        response = service.UpdateFile(request, context)
+
+        assert dict(response.file.metadata) == {"project": "apollo"}
+        mock_collection.update_one.assert_called_once_with(
+            {"_id": ObjectId(self.FILE_ID), "user_id": "user-123"},
+            {"$set": {"metadata": {"project": "apollo"}}},
+        )
+
+    @pytest.mark.parametrize("key", ["a.b", "$where", ""])
+    @patch('file_service.service.files_collection')
+    def test_update_rejects_bad_metadata_key(self, mock_collection, key):
+        mock_collection.find_one.return_value = self._doc()
+        service, context = self._service()
+
+        request = file_pb2.UpdateFileRequest(id=self.FILE_ID, metadata={key: "x"})
+        request.update_mask.paths.append("metadata")
+        with pytest.raises(Exception):
+            service.UpdateFile(request, context)
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.INVALID_ARGUMENT
+
+
+class TestListFilesPaging:
+
+    def _service(self):
+        auth_client = Mock()
+        auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
+            valid=True,
+            user=user_pb2.User(id="user-123", username="testuser", role=user_pb2.Role.ROLE_USER)
+        )
+        context = Mock()
+        context.invocation_metadata.return_value = [("authorization", "Bearer valid-token")]
+        context.abort.side_effect = Exception("Aborted")
+        return FileService(auth_client), context
+
+    def _docs(self, *names):
+        return [
+            {"_id": ObjectId(), "user_id": "user-123", "filename": name, "size": 1, "content_type": "text/plain", "created_at": 1}
+            for name in names
+        ]
+
+    @patch('file_service.service.files_collection')
+    def test_first_page_sorted_by_name(self, mock_collection):
+        mock_collection.find.return_value = self._docs("c.txt", "b.txt")
+        mock_collection.count_documents.return_value = 5
+        service, context = self._service()
+
+        request = file_pb2.ListFilesRequest(page_size=2, sort_by=file_pb2.FILE_SORT_FIELD_NAME, descending=True)
+        response = service.ListFiles(request, context)
+
+        mock_collection.find.assert_called_once_with(
+            {"user_id": "user-123"}, sort=[("filename", -1), ("_id", -1)], skip=0, limit=2
+        )
+        assert response.total_count == 5
+        assert response.next_page_token
+        assert response.prev_page_token == ""
+
+    @patch('file_service.service.files_collection')
+    def test_next_page_token_continues_listing(self, mock_collection):
+        mock_collection.find.return_value = self._docs("a.txt", "b.txt")
+        mock_collection.count_documents.return_value = 5
+        service, context = self._service()
+        first = service.ListFiles(file_pb2.ListFilesRequest(page_size=2, query="txt"), context)
+
+        mock_collection.find.reset_mock()
+        mock_collection.find.return_value = self._docs("e.txt")
+        last = service.ListFiles(file_pb2.ListFilesRequest(page_size=2, query="txt", page_token=first.next_page_token), context)
+
+        assert mock_collection.find.call_args.kwargs["skip"] == 2
+        assert last.total_count == 3
+        assert last.next_page_token == ""
+        assert last.prev_page_token
+
+    @patch('file_service.service.files_collection')
+    def test_short_last_page_skips_count(self, mock_collection):
+        mock_collection.find.return_value = self._docs("a.txt")
+        service, context = self._service()
+
+        response = service.ListFiles(file_pb2.ListFilesRequest(), context)
+
+        assert response.total_count == 1
+        assert response.next_page_token == ""
+        mock_collection.count_documents.assert_not_called()
+
+    @patch('file_service.service.files_collection')
+    def test_page_token_from_other_query_is_rejected(self, mock_collection):
+        mock_collection.find.return_value = self._docs("a.txt", "b.txt")
+        mock_collection.count_documents.return_value = 5
+        service, context = self._service()
+        first = service.ListFiles(file_pb2.ListFilesRequest(page_size=2), context)
+
+        with pytest.raises(Exception):
+            service.ListFiles(file_pb2.ListFilesRequest(page_size=2, descending=True, page_token=first.next_page_token), context)
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.INVALID_ARGUMENT
+
+    @pytest.mark.parametrize("request_kwargs", [{"page_size": 500}, {"page_size": -1}, {"page_token": "garbage!"}])
+    @patch('file_service.service.files_collection')
+    def test_rejects_bad_paging(self, mock_collection, request_kwargs):
+        service, context = self._service()
+
+        with pytest.raises(Exception):
+            service.ListFiles(file_pb2.ListFilesRequest(**request_kwargs), context)
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.INVALID_ARGUMENT
+        mock_collection.find.assert_not_called()
+
+
+class TestAdminFiles:
+
+    FILE_ID = "507f1f77bcf86cd799439011"
+    FILE = {
+        "_id": ObjectId(FILE_ID),
+        "user_id": "user-456",
+        "filename": "abuse.bin",
+        "size": 10,
+        "content_type": "application/octet-stream",
+        "created_at": 1,
+        "s3_key": "user-456/abuse.bin",
+    }
+
+    def _service(self, role=user_pb2.Role.ROLE_ADMIN):
+        auth_client = Mock()
+        auth_client.validate_token.return_value = auth_pb2.ValidateTokenResponse(
+            valid=True,
+            user=user_pb2.User(id="admin-1", username="root", role=role)
+        )
+        context = Mock()
+        context.invocation_metadata.return_value = [("authorization", "Bearer valid-token")]
+        context.abort.side_effect = Exception("Aborted")
+        return FileService(auth_client), context
+
+    @patch('file_service.service.admin_actions_collection')
+    @patch('file_service.service.files_collection')
+    def test_admin_lists_files_of_one_owner(self, mock_collection, mock_actions):
+        mock_collection.find.return_value = [self.FILE]
+        service, context = self._service()
+
+        response = service.ListFiles(file_pb2.ListFilesRequest(admin=True, owner_id="user-456", query="abuse"), context)
+
+        assert response.files[0].user_id == "user-456"
+        query = mock_collection.find.call_args[0][0]
+        assert query["user_id"] == "user-456"
+        assert query["filename"] == {"$regex": "abuse", "$options": "i"}
+        record = mock_actions.insert_one.call_args[0][0]
+        assert record["action"] == "list_files"
+        assert record["admin_id"] == "admin-1"
+        assert record["owner_id"] == "user-456"
+
+    @patch('file_service.service.admin_actions_collection')
+    @patch('file_service.service.files_collection')
+    def test_admin_lists_every_owner(self, mock_collection, mock_actions):
+        mock_collection.find.return_value = []
+        service, context = self._service()
+
+        service.ListFiles(file_pb2.ListFilesRequest(admin=True), context)
+
+        assert "user_id" not in mock_collection.find.call_args[0][0]
+
+    @patch('file_service.service.admin_actions_collection')
+    @patch('file_service.service.files_collection')
+    def test_non_admin_cannot_use_admin_flag(self, mock_collection, mock_actions):
+        service, context = self._service(role=user_pb2.Role.ROLE_USER)
+
+        with pytest.raises(Exception):
+            service.ListFiles(file_pb2.ListFilesRequest(admin=True), context)
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.PERMISSION_DENIED
+        mock_collection.find.assert_not_called()
+        mock_actions.insert_one.assert_not_called()
+
+    @patch('file_service.service.admin_actions_collection')
+    @patch('file_service.service.files_collection')
+    def test_admin_gets_any_file(self, mock_collection, mock_actions):
+        mock_collection.find_one.return_value = self.FILE
+        service, context = self._service()
+
+        response = service.GetFile(file_pb2.GetFileRequest(id=self.FILE_ID, admin=True), context)
+
+        assert response.file.filename == "abuse.bin"
+        mock_collection.find_one.assert_called_once_with({"_id": ObjectId(self.FILE_ID)})
+        record = mock_actions.insert_one.call_args[0][0]
+        assert (record["action"], record["file_id"], record["owner_id"]) == ("view_file", self.FILE_ID, "user-456")
+
+    @patch('file_service.service.admin_actions_collection')
+    @patch('file_service.service.files_collection')
+    def test_admin_lookup_ahead_of_a_download_is_recorded(self, mock_collection, mock_actions):
+        # The gateway looks a file up before downloading it and may stop
+        # there, on a 304, so the lookup is recorded on its own.
+        mock_collection.find_one.return_value = self.FILE
+        service, context = self._service()
+
+        service.GetFile(file_pb2.GetFileRequest(id=self.FILE_ID, admin=True), context)
+
+        assert mock_actions.insert_one.call_args[0][0]["action"] == "view_file"
+
+    @patch('file_service.service.admin_actions_collection')
+    @patch('file_service.service.s3_client')
+    @patch('file_service.service.files_collection')
+    def test_admin_download_is_recorded(self, mock_collection, mock_s3, mock_actions):
+        mock_collection.find_one.return_value = self.FILE
+        body_mock = MagicMock()
+        body_mock.read.side_effect = [b"data", b""]
+        mock_s3.get_object.return_value = {"Body": body_mock}
+        service, context = self._service()
+
+        list(service.DownloadFile(file_pb2.DownloadFileRequest(id=self.FILE_ID, admin=True), context))
+
+        assert mock_actions.insert_one.call_args[0][0]["action"] == "download_file"
+
+    @patch('file_service.service.shares_collection')
+    @patch('file_service.service.download_links_collection')
+    @patch('file_service.service.admin_actions_collection')
+    @patch('file_service.service.s3_client')
+    @patch('file_service.service.files_collection')
+    def test_admin_deletes_any_file(self, mock_collection, mock_s3, mock_actions, mock_links, mock_shares):
+        mock_collection.find_one.return_value = self.FILE
+        mock_collection.delete_one.return_value = Mock(deleted_count=1)
+        service, context = self._service()
+
+        response = service.DeleteFile(file_pb2.DeleteFileRequest(id=self.FILE_ID, admin=True), context)
+
+        assert response.success
+        mock_collection.delete_one.assert_called_once_with({"_id": ObjectId(self.FILE_ID), "user_id": "user-456"})
+        mock_s3.delete_object.assert_called_once()
+        assert mock_actions.insert_one.call_args[0][0]["action"] == "delete_file"
+
+    @patch('file_service.service.admin_actions_collection')
+    @patch('file_service.service.files_collection')
+    def test_admin_get_missing_file(self, mock_collection, mock_actions):
+        mock_collection.find_one.return_value = None
+        service, context = self._service()
+
+        with pytest.raises(Exception):
+            service.GetFile(file_pb2.GetFileRequest(id=self.FILE_ID, admin=True), context)
+
+        assert context.abort.call_args[0][0] == grpc.StatusCode.NOT_FOUND
+        mock_actions.insert_one.assert_not_called()
+
+    @patch('file_service.service.admin_actions_collection')
+    def test_list_admin_actions(self, mock_actions):
+        mock_actions.find.return_value = [{
+            "_id": ObjectId(),
+            "admin_id": "admin-1",
+            "admin_username": "root",
+            "action": "delete_file",
+            "file_id": self.FILE_ID,
+            "owner_id": "user-456",
+            "filename": "abuse.bin",
+            "created_at": 1700000000,
+        }]
+        service, context = self._service()
+
+        response = service.ListAdminActions(file_pb2.ListAdminActionsRequest(file_id=self.FILE_ID), context)
+
+        assert response.actions[0].action == "delete_file"
+        assert response.actions[0].admin_username == "root"
+        mock_actions.find.assert_called_once_with({"file_id": self.FILE_ID}, sort=ANY, limit=100)
@@ -0,0 +1,50 @@
+import time
+from unittest.mock import Mock, patch
+
+import jwt
+
+import file_service.service_auth as service_auth
+
+SECRET = "secret"
+
+
+def _token(sub="api-gateway", aud="file-service", secret=SECRET, expires_in=300):
+    now = int(time.time())
+    claims = {"iss": sub, "sub": sub, "aud": [aud], "iat": now, "exp": now + expires_in}
+    return jwt.encode(claims, secret, algorithm="HS256")
+
+
+def _context(token=None, common_name=None):
+    context = Mock()
+    context.invocation_metadata.return_value = [(service_auth.METADATA_KEY, token)] if token else []
+    context.auth_context.return_value = {"x509_common_name": [common_name.encode()]} if common_name else {}
+    return context
+
+
+def _config(secret=SECRET, ca=""):
+    return patch.multiple(service_auth, SERVICE_AUTH_SECRET=secret, TLS_CA_FILE=ca)
+
+
+def test_token_names_the_gateway():
+    with _config():
+        assert service_auth.calling_service(_context(_token())) == "api-gateway"
+        assert service_auth.from_gateway(_context(_token()))
+
+
+def test_rejects_tokens_for_another_audience_secret_or_expired():
+    with _config():
+        assert service_auth.calling_service(_context(_token(aud="user-service"))) is None
+        assert service_auth.calling_service(_context(_token(secret="other"))) is None
+        assert service_auth.calling_service(_context(_token(expires_in=-60))) is None
+
+
+def test_nothing_is_trusted_without_configuration():
+    with _config(secret=""):
+        assert service_auth.calling_service(_context(_token(), common_name="api-gateway")) is None
+        assert not service_auth.service_auth_enabled()
+
+
+def test_client_certificate_names_the_caller_under_mtls():
+    with _config(secret="", ca="/etc/tls/ca.pem"):
+        assert service_auth.from_gateway(_context(common_name="api-gateway"))
+        assert not service_auth.from_gateway(_context(common_name="auth-service"))
@@ -0,0 +1,136 @@
+import os
+from unittest.mock import Mock, patch
+
+import pytest
+
+import file_service.tls as tls
+
+
+def _config(cert="", key="", ca="", dev=False):
+    return patch.multiple(tls, TLS_CERT_FILE=cert, TLS_KEY_FILE=key, TLS_CA_FILE=ca, TLS_DEV_MODE=dev)
+
+
+def test_plaintext_when_tls_not_configured():
+    with _config():
+        assert tls.server_credentials() is None
+        assert tls.channel_credentials("localhost:8081") is None
+
+
+def test_cert_without_key_is_rejected():
+    with _config(cert="/tmp/cert.pem"):
+        with pytest.raises(ValueError):
+            tls.server_credentials()
+
+
+def test_dev_mode_requires_key_pair():
+    with _config(dev=True):
+        with pytest.raises(ValueError):
+            tls.server_credentials()
+
+
+def test_fetcher_reloads_only_when_files_change(tmp_path):
+    cert = tmp_path / "cert.pem"
+    key = tmp_path / "key.pem"
+    cert.write_bytes(b"cert")
+    key.write_bytes(b"key")
+
+    with _config(cert=str(cert), key=str(key)), patch.object(
+        tls.grpc, "ssl_server_certificate_configuration", return_value="config"
+    ) as make_config:
+        fetcher = tls._CertificateFetcher()
+        assert fetcher.initial() == "config"
+        assert fetcher() is None
+
+        cert.write_bytes(b"rotated")
+        stat = os.stat(cert)
+        os.utime(cert, ns=(stat.st_atime_ns, stat.st_mtime_ns + 1_000_000_000))
+
+        assert fetcher() == "config"
+        make_config.assert_called_with([(b"key", b"rotated")], root_certificates=None)
+        assert fetcher() is None
+
+
+def _touch(path):
+    stat = os.stat(path)
+    os.utime(path, ns=(stat.st_atime_ns, stat.st_mtime_ns + 1_000_000_000))
+
+
+def test_channel_credentials_rebuild_when_files_change(tmp_path):
+    cert = tmp_path / "cert.pem"
+    key = tmp_path / "key.pem"
+    ca = tmp_path / "ca.pem"
+    cert.write_bytes(b"cert")
+    key.write_bytes(b"key")
+    ca.write_bytes(b"ca")
+
+    with _config(cert=str(cert), key=str(key), ca=str(ca)), patch.object(
+        tls.grpc, "ssl_channel_credentials", return_value="credentials"
+    ) as make_credentials:
+        credentials = tls.channel_credentials("auth-service:8081")
+        assert credentials.stale()
+        assert credentials.load() == "credentials"
+        assert not credentials.stale()
+
+        cert.write_bytes(b"rotated")
+        _touch(cert)
+
+        assert credentials.stale()
+        credentials.load()
+        make_credentials.assert_called_with(root_certificates=b"ca", private_key=b"key", certificate_chain=b"rotated")
+        assert not credentials.stale()
+
+
+def test_dev_mode_trusts_peer_certificate(tmp_path):
+    cert = tmp_path / "cert.pem"
+    key = tmp_path / "key.pem"
+    cert.write_bytes(b"cert")
+    key.write_bytes(b"key")
+
+    with _config(cert=str(cert), key=str(key), dev=True), patch.object(
+        tls, "_peer_certificate", return_value=b"peer"
+    ) as fetch, patch.object(tls.grpc, "ssl_channel_credentials", return_value="credentials") as make_credentials:
+        credentials = tls.channel_credentials("auth-service:8081")
+        credentials.load()
+
+        fetch.assert_called_once_with("auth-service:8081")
+        make_credentials.assert_called_with(root_certificates=b"peer", private_key=b"key", certificate_chain=b"cert")
+        assert ("grpc.ssl_target_name_override", "localhost") in credentials.options
+        assert not credentials.stale()
+
+        # A peer that restarted presents a new certificate.
+        credentials.forget_peer()
+        assert credentials.stale()
+        credentials.load()
+        assert fetch.call_count == 2
+
+
+def test_dev_mode_retries_unreachable_peer(tmp_path):
+    cert = tmp_path / "cert.pem"
+    key = tmp_path / "key.pem"
+    cert.write_bytes(b"cert")
+    key.write_bytes(b"key")
+
+    with _config(cert=str(cert), key=str(key), dev=True), patch.object(
+        tls, "_peer_certificate", side_effect=ConnectionRefusedError()
+    ), patch.object(tls.grpc, "ssl_channel_credentials"):
+        credentials = tls.channel_credentials("auth-service:8081")
+        credentials.load()
+        assert credentials.stale()
+
+
+def test_auth_client_redials_when_credentials_change():
+    import file_service.auth_client as auth_client
+
+    credentials = Mock(options=[])
+    credentials.stale.side_effect = [True, True, False]
+    with patch.object(auth_client, "channel_credentials", return_value=credentials), patch.object(
+        auth_client.grpc, "secure_channel"
+    ) as dial, patch.object(auth_client.threading, "Timer") as timer:
+        client = auth_client.AuthClient()
+        first = client.channel
+
+        client.validate_token("token")
+        client.validate_token("token")
+
+        assert dial.call_count == 2
+        timer.assert_called_once_with(auth_client.RETIRED_CHANNEL_GRACE, first.close)
@@ -44,6 +44,16 @@ class UserServiceStub(object):
                 request_serializer=user_dot_v1_dot_user__pb2.ListUsersRequest.SerializeToString,
                 response_deserializer=user_dot_v1_dot_user__pb2.ListUsersResponse.FromString,
                 _registered_method=True)
+        self.GetQuota = channel.unary_unary(
+                '/user.v1.UserService/GetQuota',
+                request_serializer=user_dot_v1_dot_user__pb2.GetQuotaRequest.SerializeToString,
+                response_deserializer=user_dot_v1_dot_user__pb2.GetQuotaResponse.FromString,
+                _registered_method=True)
+        self.SetQuota = channel.unary_unary(
+                '/user.v1.UserService/SetQuota',
+                request_serializer=user_dot_v1_dot_user__pb2.SetQuotaRequest.SerializeToString,
+                response_deserializer=user_dot_v1_dot_user__pb2.GetQuotaResponse.FromString,
+                _registered_method=True)
 
 
 class UserServiceServicer(object):
@@ -85,6 +95,18 @@ class UserServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetQuota(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetQuota(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_UserServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -118,6 +140,16 @@ def add_UserServiceServicer_to_server(servicer, server):
                     request_deserializer=user_dot_v1_dot_user__pb2.ListUsersRequest.FromString,
                     response_serializer=user_dot_v1_dot_user__pb2.ListUsersResponse.SerializeToString,
             ),
+            'GetQuota': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetQuota,
+                    request_deserializer=user_dot_v1_dot_user__pb2.GetQuotaRequest.FromString,
+                    response_serializer=user_dot_v1_dot_user__pb2.GetQuotaResponse.SerializeToString,
+            ),
+            'SetQuota': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetQuota,
+                    request_deserializer=user_dot_v1_dot_user__pb2.SetQuotaRequest.FromString,
+                    response_serializer=user_dot_v1_dot_user__pb2.GetQuotaResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'user.v1.UserService', rpc_method_handlers)
@@ -290,3 +322,57 @@ class UserService(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def GetQuota(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/user.v1.UserService/GetQuota',
+            user_dot_v1_dot_user__pb2.GetQuotaRequest.SerializeToString,
+            user_dot_v1_dot_user__pb2.GetQuotaResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SetQuota(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/user.v1.UserService/SetQuota',
+            user_dot_v1_dot_user__pb2.SetQuotaRequest.SerializeToString,
+            user_dot_v1_dot_user__pb2.GetQuotaResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
@@ -53,7 +53,8 @@ type SecondParameter<T extends (...args: never) => unknown> = Parameters<T>[1];
 
 
 /**
- * Admin-only endpoint to create a new user account
+ * Admin-only endpoint to create a new user account.
+ * Send an Idempotency-Key header to make retries safe: a repeat returns the first response.
  * @summary Create a new user
  */
 export type postApiAdminCreateUserResponse200 = {
@@ -76,6 +77,16 @@ export type postApiAdminCreateUserResponse403 = {
   status: 403
 }
 
+export type postApiAdminCreateUserResponse409 = {
+  data: InternalHandlersErrorResponse
+  status: 409
+}
+
+export type postApiAdminCreateUserResponse422 = {
+  data: InternalHandlersErrorResponse
+  status: 422
+}
+
 export type postApiAdminCreateUserResponse500 = {
   data: InternalHandlersErrorResponse
   status: 500
@@ -84,7 +95,7 @@ export type postApiAdminCreateUserResponse500 = {
 export type postApiAdminCreateUserResponseSuccess = (postApiAdminCreateUserResponse200) & {
   headers: Headers;
 };
-export type postApiAdminCreateUserResponseError = (postApiAdminCreateUserResponse400 | postApiAdminCreateUserResponse401 | postApiAdminCreateUserResponse403 | postApiAdminCreateUserResponse500) & {
+export type postApiAdminCreateUserResponseError = (postApiAdminCreateUserResponse400 | postApiAdminCreateUserResponse401 | postApiAdminCreateUserResponse403 | postApiAdminCreateUserResponse409 | postApiAdminCreateUserResponse422 | postApiAdminCreateUserResponse500) & {
   headers: Headers;
 };
 
@@ -530,7 +541,10 @@ export function useGetApiFiles<TData = Awaited<ReturnType<typeof getApiFiles>>,
 
 
 /**
- * Upload a file to S3 and save metadata for the authenticated user
+ * Upload a file to S3 and save metadata for the authenticated user.
+ * The file is streamed to storage as it arrives. Send it as the "file" field of a multipart form,
+ * or as the raw request body with its name in the X-Filename header.
+ * Send an Idempotency-Key header to make retries safe: a repeat returns the first response.
  * @summary Upload a file
  */
 export type postApiFilesResponse200 = {
@@ -548,6 +562,21 @@ export type postApiFilesResponse401 = {
   status: 401
 }
 
+export type postApiFilesResponse409 = {
+  data: InternalHandlersErrorResponse
+  status: 409
+}
+
+export type postApiFilesResponse413 = {
+  data: InternalHandlersErrorResponse
+  status: 413
+}
+
+export type postApiFilesResponse422 = {
+  data: InternalHandlersErrorResponse
+  status: 422
+}
+
 export type postApiFilesResponse429 = {
   data: InternalHandlersErrorResponse
   status: 429
@@ -561,7 +590,7 @@ export type postApiFilesResponse500 = {
 export type postApiFilesResponseSuccess = (postApiFilesResponse200) & {
   headers: Headers;
 };
-export type postApiFilesResponseError = (postApiFilesResponse400 | postApiFilesResponse401 | postApiFilesResponse429 | postApiFilesResponse500) & {
+export type postApiFilesResponseError = (postApiFilesResponse400 | postApiFilesResponse401 | postApiFilesResponse409 | postApiFilesResponse413 | postApiFilesResponse422 | postApiFilesResponse429 | postApiFilesResponse500) & {
   headers: Headers;
 };
 
@@ -959,6 +988,11 @@ export type postApiFilesMultipartUploadIdPartPartNumberResponse401 = {
   status: 401
 }
 
+export type postApiFilesMultipartUploadIdPartPartNumberResponse429 = {
+  data: InternalHandlersErrorResponse
+  status: 429
+}
+
 export type postApiFilesMultipartUploadIdPartPartNumberResponse500 = {
   data: InternalHandlersErrorResponse
   status: 500
@@ -967,7 +1001,7 @@ export type postApiFilesMultipartUploadIdPartPartNumberResponse500 = {
 export type postApiFilesMultipartUploadIdPartPartNumberResponseSuccess = (postApiFilesMultipartUploadIdPartPartNumberResponse200) & {
   headers: Headers;
 };
-export type postApiFilesMultipartUploadIdPartPartNumberResponseError = (postApiFilesMultipartUploadIdPartPartNumberResponse400 | postApiFilesMultipartUploadIdPartPartNumberResponse401 | postApiFilesMultipartUploadIdPartPartNumberResponse500) & {
+export type postApiFilesMultipartUploadIdPartPartNumberResponseError = (postApiFilesMultipartUploadIdPartPartNumberResponse400 | postApiFilesMultipartUploadIdPartPartNumberResponse401 | postApiFilesMultipartUploadIdPartPartNumberResponse429 | postApiFilesMultipartUploadIdPartPartNumberResponse500) & {
   headers: Headers;
 };
 
@@ -1286,7 +1320,120 @@ export const useDeleteApiFilesId = <TError = InternalHandlersErrorResponse,
     }
     
 /**
- * Download a file by ID (must be owned by the authenticated user)
+ * Returns the headers a download of the file would carry, without the contents.
+ * Supports If-None-Match and If-Modified-Since like the download endpoint.
+ * @summary Get download headers for a file
+ */
+export type headApiFilesIdResponse200 = {
+  data: void
+  status: 200
+}
+
+export type headApiFilesIdResponse304 = {
+  data: void
+  status: 304
+}
+
+export type headApiFilesIdResponse400 = {
+  data: InternalHandlersErrorResponse
+  status: 400
+}
+
+export type headApiFilesIdResponse401 = {
+  data: InternalHandlersErrorResponse
+  status: 401
+}
+
+export type headApiFilesIdResponse404 = {
+  data: InternalHandlersErrorResponse
+  status: 404
+}
+
+export type headApiFilesIdResponse500 = {
+  data: InternalHandlersErrorResponse
+  status: 500
+}
+    
+export type headApiFilesIdResponseSuccess = (headApiFilesIdResponse200) & {
+  headers: Headers;
+};
+export type headApiFilesIdResponseError = (headApiFilesIdResponse304 | headApiFilesIdResponse400 | headApiFilesIdResponse401 | headApiFilesIdResponse404 | headApiFilesIdResponse500) & {
+  headers: Headers;
+};
+
+export type headApiFilesIdResponse = (headApiFilesIdResponseSuccess | headApiFilesIdResponseError)
+
+export const getHeadApiFilesIdUrl = (id: string,) => {
+
+
+  
+
+  return `/api/files/${id}`
+}
+
+export const headApiFilesId = async (id: string, options?: RequestInit): Promise<headApiFilesIdResponse> => {
+  
+  return customFetch<headApiFilesIdResponse>(getHeadApiFilesIdUrl(id),
+  {      
+    ...options,
+    method: 'HEAD'
+    
+    
+  }
+);}
+
+
+
+
+export const getHeadApiFilesIdMutationOptions = <TError = InternalHandlersErrorResponse,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof headApiFilesId>>, TError,{id: string}, TContext>, request?: SecondParameter<typeof customFetch>}
+): UseMutationOptions<Awaited<ReturnType<typeof headApiFilesId>>, TError,{id: string}, TContext> => {
+
+const mutationKey = ['headApiFilesId'];
+const {mutation: mutationOptions, request: requestOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }, request: undefined};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof headApiFilesId>>, {id: string}> = (props) => {
+          const {id} = props ?? {};
+
+          return  headApiFilesId(id,requestOptions)
+        }
+
+
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type HeadApiFilesIdMutationResult = NonNullable<Awaited<ReturnType<typeof headApiFilesId>>>
+    
+    export type HeadApiFilesIdMutationError = InternalHandlersErrorResponse
+
+    /**
+ * @summary Get download headers for a file
+ */
+export const useHeadApiFilesId = <TError = InternalHandlersErrorResponse,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof headApiFilesId>>, TError,{id: string}, TContext>, request?: SecondParameter<typeof customFetch>}
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof headApiFilesId>>,
+        TError,
+        {id: string},
+        TContext
+      > => {
+      return useMutation(getHeadApiFilesIdMutationOptions(options), queryClient);
+    }
+    
+/**
+ * Download a file by ID (must be owned by the authenticated user or shared with them for download)
+ * Send a single Range to download part of the file, optionally guarded by If-Range.
+ * Responses carry ETag and Last-Modified; If-None-Match and If-Modified-Since return 304 when the file is unchanged.
  * @summary Download a file
  */
 export type getApiFilesIdDownloadResponse200 = {
@@ -1294,6 +1441,16 @@ export type getApiFilesIdDownloadResponse200 = {
   status: 200
 }
 
+export type getApiFilesIdDownloadResponse206 = {
+  data: Blob
+  status: 206
+}
+
+export type getApiFilesIdDownloadResponse304 = {
+  data: void
+  status: 304
+}
+
 export type getApiFilesIdDownloadResponse400 = {
   data: Blob
   status: 400
@@ -1304,20 +1461,30 @@ export type getApiFilesIdDownloadResponse401 = {
   status: 401
 }
 
+export type getApiFilesIdDownloadResponse403 = {
+  data: Blob
+  status: 403
+}
+
 export type getApiFilesIdDownloadResponse404 = {
   data: Blob
   status: 404
 }
 
+export type getApiFilesIdDownloadResponse416 = {
+  data: Blob
+  status: 416
+}
+
 export type getApiFilesIdDownloadResponse500 = {
   data: Blob
   status: 500
 }
     
-export type getApiFilesIdDownloadResponseSuccess = (getApiFilesIdDownloadResponse200) & {
+export type getApiFilesIdDownloadResponseSuccess = (getApiFilesIdDownloadResponse200 | getApiFilesIdDownloadResponse206) & {
   headers: Headers;
 };
-export type getApiFilesIdDownloadResponseError = (getApiFilesIdDownloadResponse400 | getApiFilesIdDownloadResponse401 | getApiFilesIdDownloadResponse404 | getApiFilesIdDownloadResponse500) & {
+export type getApiFilesIdDownloadResponseError = (getApiFilesIdDownloadResponse304 | getApiFilesIdDownloadResponse400 | getApiFilesIdDownloadResponse401 | getApiFilesIdDownloadResponse403 | getApiFilesIdDownloadResponse404 | getApiFilesIdDownloadResponse416 | getApiFilesIdDownloadResponse500) & {
   headers: Headers;
 };
 
@@ -1438,6 +1605,11 @@ export type postApiLoginResponse401 = {
   status: 401
 }
 
+export type postApiLoginResponse429 = {
+  data: InternalHandlersErrorResponse
+  status: 429
+}
+
 export type postApiLoginResponse500 = {
   data: InternalHandlersErrorResponse
   status: 500
@@ -1446,7 +1618,7 @@ export type postApiLoginResponse500 = {
 export type postApiLoginResponseSuccess = (postApiLoginResponse200) & {
   headers: Headers;
 };
-export type postApiLoginResponseError = (postApiLoginResponse400 | postApiLoginResponse401 | postApiLoginResponse500) & {
+export type postApiLoginResponseError = (postApiLoginResponse400 | postApiLoginResponse401 | postApiLoginResponse429 | postApiLoginResponse500) & {
   headers: Headers;
 };
 
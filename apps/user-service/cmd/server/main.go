@@ -2,13 +2,22 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
+	"github.com/provsalt/DOP_P01_Team1/common/interceptor"
+	"github.com/provsalt/DOP_P01_Team1/common/logging"
 	"github.com/provsalt/DOP_P01_Team1/common/telemetry"
+	"github.com/provsalt/DOP_P01_Team1/common/tlsconfig"
 	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+	"github.com/provsalt/DOP_P01_Team1/user-service/internal/authz"
 	"github.com/provsalt/DOP_P01_Team1/user-service/internal/config"
 	"github.com/provsalt/DOP_P01_Team1/user-service/internal/health"
 	"github.com/provsalt/DOP_P01_Team1/user-service/internal/service"
@@ -25,37 +34,51 @@ import (
 func main() {
 	cfg, err := config.Load()
 	if err != nil {
-		log.Fatalf("Failed to load configuration: %v", err)
+		fatal("Failed to load configuration", "error", err)
 	}
 
-	if cfg.AxiomToken == "" {
-		log.Printf("Tracing disabled: AXIOM_API_TOKEN is empty")
-	} else {
-		shutdown, err := telemetry.InitTelemetry(context.Background(), telemetry.Config{
-			ServiceName:    "user-service",
-			Environment:    cfg.Environment,
-			Token:          cfg.AxiomToken,
-			Endpoint:       cfg.AxiomEndpoint,
-			Dataset:        cfg.AxiomDataset,
-			MetricsDataset: cfg.AxiomMetricsDataset,
-		})
-		if err != nil {
-			log.Printf("Tracing disabled: failed to initialize tracer: %v", err)
-		} else {
-			defer shutdown(context.Background())
-		}
+	if _, err := logging.Setup(logging.Config{
+		ServiceName: "user-service",
+		Environment: cfg.Environment,
+		Level:       cfg.LogLevel,
+		Format:      cfg.LogFormat,
+	}); err != nil {
+		fatal("Failed to configure logging", "error", err)
+	}
+
+	telemetryCfg := telemetry.Config{
+		ServiceName: "user-service",
+		Environment: cfg.Environment,
+		Prometheus:  cfg.PrometheusEnabled,
+	}
+	// Axiom is kept as a shortcut; otherwise the OTEL_* variables decide where
+	// telemetry goes, and nothing is exported when none are set.
+	if cfg.AxiomToken != "" {
+		telemetryCfg.Token = cfg.AxiomToken
+		telemetryCfg.Endpoint = cfg.AxiomEndpoint
+		telemetryCfg.Dataset = cfg.AxiomDataset
+		telemetryCfg.MetricsDataset = cfg.AxiomMetricsDataset
+	}
+	shutdownTelemetry, err := telemetry.InitTelemetry(context.Background(), telemetryCfg)
+	if err != nil {
+		slog.Warn("Telemetry disabled: failed to initialize", "error", err)
+		shutdownTelemetry = func(context.Context) error { return nil }
+	}
+
+	var adminServer *http.Server
+	if cfg.PrometheusEnabled {
+		adminServer = startAdminServer(cfg.AdminPort)
 	}
 
 	clientOptions := options.Client().ApplyURI(cfg.MongoDBURI).SetMonitor(otelmongo.NewMonitor())
 	client, err := mongo.Connect(clientOptions)
 	if err != nil {
-		log.Fatalf("Failed to connect to MongoDB: %v", err)
+		fatal("Failed to connect to MongoDB", "error", err)
 	}
-	defer client.Disconnect(context.Background())
 
 	err = client.Ping(context.Background(), nil)
 	if err != nil {
-		log.Fatalf("Failed to ping MongoDB: %v", err)
+		fatal("Failed to ping MongoDB", "error", err)
 	}
 
 	database := client.Database(cfg.MongoDBDatabase)
@@ -64,27 +87,113 @@ func main() {
 	defer cancel()
 	if cfg.DefaultAdminUsername != "" || cfg.DefaultAdminPassword != "" {
 		if err := userStore.EnsureDefaultAdmin(ctx, cfg.DefaultAdminUsername, cfg.DefaultAdminPassword); err != nil {
-			log.Fatalf("Failed to initialize default admin: %v", err)
+			fatal("Failed to initialize default admin", "error", err)
 		}
 	} else {
-		log.Printf("No default admin username or default admin password. Skipping creating admin user")
+		slog.Info("No default admin username or default admin password. Skipping creating admin user")
 	}
-	log.Printf("Database initialization complete")
+	slog.Info("Database initialization complete")
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.Port))
 	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
+		fatal("Failed to listen", "error", err)
+	}
+
+	serverCreds, err := tlsconfig.ServerCredentials(cfg.TLS())
+	if err != nil {
+		fatal("Failed to load TLS configuration", "error", err)
 	}
 
-	grpcServer := grpc.NewServer(
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	mutualTLS := cfg.TLSCAFile != "" && cfg.TLS().ServerEnabled()
+	if cfg.ServiceAuthSecret != "" || mutualTLS {
+		authenticator := authz.NewAuthenticator(cfg.ServiceAuthSecret, authz.DefaultPolicy)
+		unaryInterceptors = append(unaryInterceptors, authenticator.UnaryServerInterceptor())
+	} else {
+		slog.Warn("Service authentication disabled: set SERVICE_AUTH_SECRET or TLS_CA_FILE to restrict callers")
+	}
+
+	serverOpts := append([]grpc.ServerOption{
+		grpc.Creds(serverCreds),
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-	)
-	userv1.RegisterUserServiceServer(grpcServer, service.NewUserServiceServer(userStore))
-	grpc_health_v1.RegisterHealthServer(grpcServer, health.NewHealthServer())
+	}, interceptor.ServerOptions(unaryInterceptors...)...)
+	grpcServer := grpc.NewServer(serverOpts...)
+	userService := service.NewUserServiceServer(userStore)
+	userService.SetRoleQuotas(service.RoleQuotas{
+		userv1.Role_ROLE_USER:  {MaxFiles: cfg.UserQuotaMaxFiles, MaxBytes: cfg.UserQuotaMaxBytes},
+		userv1.Role_ROLE_ADMIN: {MaxFiles: cfg.AdminQuotaMaxFiles, MaxBytes: cfg.AdminQuotaMaxBytes},
+	})
+	userv1.RegisterUserServiceServer(grpcServer, userService)
+	healthServer := health.NewHealthServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
 	reflection.Register(grpcServer)
 
-	log.Printf("User service listening on :%s", cfg.Port)
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+	sigCtx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		slog.Info("User service listening", "port", cfg.Port, "tls", cfg.TLS().ServerEnabled())
+		serveErr <- grpcServer.Serve(lis)
+	}()
+
+	exitCode := 0
+	select {
+	case err := <-serveErr:
+		slog.Error("Failed to serve", "error", err)
+		exitCode = 1
+	case <-sigCtx.Done():
+		slog.Info("Shutdown signal received, draining in-flight RPCs", "delay", cfg.ShutdownDrainDelay.String(), "timeout", cfg.ShutdownTimeout.String())
+		healthServer.Drain(grpcServer, cfg.ShutdownDrainDelay, cfg.ShutdownTimeout)
+	}
+
+	disconnectCtx, cancelDisconnect := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelDisconnect()
+	if err := client.Disconnect(disconnectCtx); err != nil {
+		slog.Error("Failed to disconnect from MongoDB", "error", err)
+	}
+
+	if adminServer != nil {
+		adminCtx, cancelAdmin := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := adminServer.Shutdown(adminCtx); err != nil {
+			slog.Error("Failed to stop admin server", "error", err)
+		}
+		cancelAdmin()
+	}
+
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFlush()
+	if err := shutdownTelemetry(flushCtx); err != nil {
+		slog.Error("Failed to flush telemetry", "error", err)
 	}
+
+	slog.Info("User service stopped")
+	if exitCode != 0 {
+		os.Exit(exitCode)
+	}
+}
+
+// startAdminServer serves /metrics on port. Scrapes go over plain HTTP so they
+// need neither client certificates nor service tokens.
+func startAdminServer(port string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", telemetry.MetricsHandler())
+	srv := &http.Server{
+		Addr:              ":" + port,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		slog.Info("Admin server listening", "port", port)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Admin server failed", "error", err)
+		}
+	}()
+	return srv
+}
+
+// fatal logs msg at error level and exits, mirroring log.Fatal for slog.
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
 }
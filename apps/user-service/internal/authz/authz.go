@@ -0,0 +1,93 @@
+package authz
+
+import (
+	"context"
+	"strings"
+
+	"github.com/provsalt/DOP_P01_Team1/common/serviceauth"
+	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Audience is the audience service tokens must be minted for to reach user-service.
+const Audience = "user-service"
+
+const guardedPrefix = "/user.v1.UserService/"
+
+// Policy maps a full gRPC method name to the services allowed to call it.
+// UserService methods without an entry are denied.
+type Policy map[string][]string
+
+// DefaultPolicy grants each caller only the RPCs it actually uses.
+var DefaultPolicy = Policy{
+	userv1.UserService_CreateUser_FullMethodName:        {"auth-service"},
+	userv1.UserService_VerifyPassword_FullMethodName:    {"auth-service"},
+	userv1.UserService_GetUserByUsername_FullMethodName: {"api-gateway", "auth-service"},
+	userv1.UserService_GetUser_FullMethodName:           {"api-gateway", "auth-service"},
+	userv1.UserService_DeleteUser_FullMethodName:        {"api-gateway"},
+	userv1.UserService_ListUsers_FullMethodName:         {"api-gateway"},
+	userv1.UserService_GetQuota_FullMethodName:          {"api-gateway"},
+	userv1.UserService_SetQuota_FullMethodName:          {"api-gateway"},
+}
+
+type Authenticator struct {
+	secret []byte
+	policy Policy
+}
+
+// NewAuthenticator returns an Authenticator that accepts service tokens signed
+// with secret and verified mTLS client certificates. An empty secret disables
+// token authentication so only mTLS identities are accepted.
+func NewAuthenticator(secret string, policy Policy) *Authenticator {
+	return &Authenticator{secret: []byte(secret), policy: policy}
+}
+
+// UnaryServerInterceptor rejects UserService calls from unauthenticated or
+// unauthorized services. Health checks and reflection are left open.
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, guardedPrefix) {
+			return handler(ctx, req)
+		}
+
+		caller, err := a.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !a.allowed(info.FullMethod, caller) {
+			return nil, status.Errorf(codes.PermissionDenied, "service %q may not call %s", caller, info.FullMethod)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (a *Authenticator) authenticate(ctx context.Context) (string, error) {
+	if name, ok := serviceauth.PeerIdentity(ctx); ok {
+		return name, nil
+	}
+
+	if len(a.secret) > 0 {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if tokens := md.Get(serviceauth.MetadataKey); len(tokens) > 0 {
+			name, err := serviceauth.VerifyToken(tokens[0], Audience, a.secret)
+			if err != nil {
+				return "", status.Error(codes.Unauthenticated, "invalid service token")
+			}
+			return name, nil
+		}
+	}
+
+	return "", status.Error(codes.Unauthenticated, "missing service credentials")
+}
+
+func (a *Authenticator) allowed(method, caller string) bool {
+	for _, name := range a.policy[method] {
+		if name == caller {
+			return true
+		}
+	}
+	return false
+}
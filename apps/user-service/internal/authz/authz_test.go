@@ -0,0 +1,132 @@
+package authz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/provsalt/DOP_P01_Team1/common/serviceauth"
+	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testSecret = "service-secret"
+
+func callAs(t *testing.T, a *Authenticator, method, token string) error {
+	t.Helper()
+	ctx := context.Background()
+	if token != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(serviceauth.MetadataKey, token))
+	}
+	handler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	_, err := a.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	return err
+}
+
+func tokenFor(t *testing.T, service, audience, secret string) string {
+	t.Helper()
+	token, err := serviceauth.NewSigner(service, audience, secret).Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestInterceptor_AllowsListedService(t *testing.T) {
+	a := NewAuthenticator(testSecret, DefaultPolicy)
+	token := tokenFor(t, "auth-service", Audience, testSecret)
+
+	if err := callAs(t, a, userv1.UserService_VerifyPassword_FullMethodName, token); err != nil {
+		t.Fatalf("expected auth-service to call VerifyPassword, got %v", err)
+	}
+}
+
+func TestInterceptor_AllowsGatewayUsernameLookup(t *testing.T) {
+	a := NewAuthenticator(testSecret, DefaultPolicy)
+	token := tokenFor(t, "api-gateway", Audience, testSecret)
+
+	// Sharing a file resolves the recipient's username through the gateway.
+	if err := callAs(t, a, userv1.UserService_GetUserByUsername_FullMethodName, token); err != nil {
+		t.Fatalf("expected api-gateway to call GetUserByUsername, got %v", err)
+	}
+}
+
+func TestDefaultPolicy_AllowsGatewayClient(t *testing.T) {
+	a := NewAuthenticator(testSecret, DefaultPolicy)
+	token := tokenFor(t, "api-gateway", Audience, testSecret)
+
+	// Every UserService RPC the gateway's client calls; admin file listings
+	// filtered by owner resolve the username with GetUserByUsername.
+	for _, method := range []string{
+		userv1.UserService_GetUser_FullMethodName,
+		userv1.UserService_GetUserByUsername_FullMethodName,
+		userv1.UserService_DeleteUser_FullMethodName,
+		userv1.UserService_ListUsers_FullMethodName,
+		userv1.UserService_GetQuota_FullMethodName,
+		userv1.UserService_SetQuota_FullMethodName,
+	} {
+		if err := callAs(t, a, method, token); err != nil {
+			t.Errorf("expected api-gateway to call %s, got %v", method, err)
+		}
+	}
+}
+
+func TestInterceptor_DeniesUnlistedService(t *testing.T) {
+	a := NewAuthenticator(testSecret, DefaultPolicy)
+	token := tokenFor(t, "api-gateway", Audience, testSecret)
+
+	for _, method := range []string{
+		userv1.UserService_VerifyPassword_FullMethodName,
+		userv1.UserService_CreateUser_FullMethodName,
+	} {
+		if code := status.Code(callAs(t, a, method, token)); code != codes.PermissionDenied {
+			t.Fatalf("%s: expected PermissionDenied, got %v", method, code)
+		}
+	}
+}
+
+func TestInterceptor_RejectsMissingOrInvalidToken(t *testing.T) {
+	a := NewAuthenticator(testSecret, DefaultPolicy)
+
+	if code := status.Code(callAs(t, a, userv1.UserService_ListUsers_FullMethodName, "")); code != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated without a token, got %v", code)
+	}
+
+	forged := tokenFor(t, "api-gateway", Audience, "wrong-secret")
+	if code := status.Code(callAs(t, a, userv1.UserService_ListUsers_FullMethodName, forged)); code != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated for a forged token, got %v", code)
+	}
+
+	otherAudience := tokenFor(t, "api-gateway", "auth-service", testSecret)
+	if code := status.Code(callAs(t, a, userv1.UserService_ListUsers_FullMethodName, otherAudience)); code != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated for a token minted for another service, got %v", code)
+	}
+}
+
+func TestInterceptor_DeniesMethodWithoutPolicy(t *testing.T) {
+	a := NewAuthenticator(testSecret, Policy{})
+	token := tokenFor(t, "auth-service", Audience, testSecret)
+
+	if code := status.Code(callAs(t, a, userv1.UserService_GetUser_FullMethodName, token)); code != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied, got %v", code)
+	}
+}
+
+func TestInterceptor_IgnoresTokensWithoutSecret(t *testing.T) {
+	a := NewAuthenticator("", DefaultPolicy)
+	token := tokenFor(t, "auth-service", Audience, "")
+
+	if code := status.Code(callAs(t, a, userv1.UserService_CreateUser_FullMethodName, token)); code != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated when only mTLS is accepted, got %v", code)
+	}
+}
+
+func TestInterceptor_LeavesHealthChecksOpen(t *testing.T) {
+	a := NewAuthenticator(testSecret, DefaultPolicy)
+
+	if err := callAs(t, a, "/grpc.health.v1.Health/Check", ""); err != nil {
+		t.Fatalf("expected health checks to bypass service auth, got %v", err)
+	}
+}
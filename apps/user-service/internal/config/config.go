@@ -1,7 +1,10 @@
 package config
 
 import (
+	"time"
+
 	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/provsalt/DOP_P01_Team1/common/tlsconfig"
 )
 
 type Config struct {
@@ -15,6 +18,33 @@ type Config struct {
 	Environment          string `env:"ENVIRONMENT" env-default:"development"`
 	DefaultAdminUsername string `env:"DEFAULT_ADMIN_USERNAME"`
 	DefaultAdminPassword string `env:"DEFAULT_ADMIN_PASSWORD"`
+	// Storage quotas each role gets unless an admin sets a user's own. A limit
+	// of 0 is unlimited.
+	UserQuotaMaxFiles  int64 `env:"USER_QUOTA_MAX_FILES" env-default:"20"`
+	UserQuotaMaxBytes  int64 `env:"USER_QUOTA_MAX_BYTES" env-default:"10737418240"`
+	AdminQuotaMaxFiles int64 `env:"ADMIN_QUOTA_MAX_FILES" env-default:"100"`
+	AdminQuotaMaxBytes int64 `env:"ADMIN_QUOTA_MAX_BYTES" env-default:"107374182400"`
+	// ShutdownTimeout bounds how long in-flight RPCs may drain after SIGTERM.
+	ShutdownTimeout time.Duration `env:"SHUTDOWN_TIMEOUT" env-default:"30s"`
+	// ShutdownDrainDelay is how long health reports NOT_SERVING while new RPCs
+	// are still accepted, so clients can stop routing here first.
+	ShutdownDrainDelay time.Duration `env:"SHUTDOWN_DRAIN_DELAY" env-default:"5s"`
+	// Logging
+	LogLevel  string `env:"LOG_LEVEL" env-default:"info"`
+	LogFormat string `env:"LOG_FORMAT" env-default:"json"`
+	// ServiceAuthSecret signs the service tokens internal services present to
+	// each other. It must match across services.
+	ServiceAuthSecret string `env:"SERVICE_AUTH_SECRET"`
+	// TLS for gRPC. CERT/KEY are this service's identity; CA verifies peers and,
+	// on servers, requires client certificates. Files are reloaded on change.
+	TLSCertFile string `env:"TLS_CERT_FILE"`
+	TLSKeyFile  string `env:"TLS_KEY_FILE"`
+	TLSCAFile   string `env:"TLS_CA_FILE"`
+	TLSDevMode  bool   `env:"TLS_DEV_MODE" env-default:"false"`
+	// PrometheusEnabled serves /metrics for scraping on AdminPort, an HTTP
+	// listener kept apart from the gRPC port.
+	PrometheusEnabled bool   `env:"PROMETHEUS_ENABLED" env-default:"false"`
+	AdminPort         string `env:"ADMIN_PORT" env-default:"9080"`
 }
 
 func Load() (*Config, error) {
@@ -24,3 +54,13 @@ func Load() (*Config, error) {
 	}
 	return &cfg, nil
 }
+
+// TLS returns the gRPC TLS settings in the form the tlsconfig package expects.
+func (c *Config) TLS() tlsconfig.Config {
+	return tlsconfig.Config{
+		CertFile: c.TLSCertFile,
+		KeyFile:  c.TLSKeyFile,
+		CAFile:   c.TLSCAFile,
+		DevMode:  c.TLSDevMode,
+	}
+}
@@ -2,26 +2,66 @@ package health
 
 import (
 	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type HealthServer struct {
 	grpc_health_v1.UnimplementedHealthServer
+	shuttingDown atomic.Bool
 }
 
 func NewHealthServer() *HealthServer {
 	return &HealthServer{}
 }
 
+// Shutdown marks the service as NOT_SERVING so load balancers and probes stop
+// routing new calls to it while in-flight calls drain.
+func (h *HealthServer) Shutdown() {
+	h.shuttingDown.Store(true)
+}
+
+// Drain marks the service NOT_SERVING and keeps srv accepting calls for delay,
+// so clients stop routing here before it stops. It then waits for in-flight
+// calls to finish, forcing srv closed once timeout elapses so a stuck stream
+// cannot block the rollout.
+func (h *HealthServer) Drain(srv *grpc.Server, delay, timeout time.Duration) {
+	h.Shutdown()
+	time.Sleep(delay)
+
+	done := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		slog.Warn("Graceful shutdown timed out, forcing stop", "timeout", timeout.String())
+		srv.Stop()
+	}
+}
+
 func (h *HealthServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
 	return &grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
+		Status: h.status(),
 	}, nil
 }
 
 func (h *HealthServer) Watch(req *grpc_health_v1.HealthCheckRequest, server grpc_health_v1.Health_WatchServer) error {
 	return server.Send(&grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
+		Status: h.status(),
 	})
 }
+
+func (h *HealthServer) status() grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if h.shuttingDown.Load() {
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_SERVING
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/provsalt/DOP_P01_Team1/user-service/internal/service"
+
+var roleKey = attribute.Key("user.role")
+
+type userMetrics struct {
+	created metric.Int64Counter
+	deleted metric.Int64Counter
+}
+
+// newUserMetrics creates the instruments on the global meter provider, which
+// forwards to the SDK provider once telemetry.InitTelemetry has run.
+func newUserMetrics() *userMetrics {
+	meter := otel.GetMeterProvider().Meter(meterName)
+	m := &userMetrics{}
+	var err, errs error
+
+	m.created, err = meter.Int64Counter("users.created",
+		metric.WithDescription("Users created, by role."),
+		metric.WithUnit("{user}"))
+	errs = errors.Join(errs, err)
+	m.deleted, err = meter.Int64Counter("users.deleted",
+		metric.WithDescription("Users deleted."),
+		metric.WithUnit("{user}"))
+	errs = errors.Join(errs, err)
+
+	if errs != nil {
+		otel.Handle(errs)
+	}
+	return m
+}
+
+func (m *userMetrics) recordCreated(ctx context.Context, role string) {
+	m.created.Add(ctx, 1, metric.WithAttributes(roleKey.String(role)))
+}
+
+func (m *userMetrics) recordDeleted(ctx context.Context) {
+	m.deleted.Add(ctx, 1)
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
+	"github.com/provsalt/DOP_P01_Team1/user-service/internal/store"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestMetrics_CountsCreatedAndDeletedUsers(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	prev := otel.GetMeterProvider()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	defer otel.SetMeterProvider(prev)
+
+	mockStore := &mockUserStore{
+		createUserFunc: func(ctx context.Context, user *store.User) (string, error) { return "u1", nil },
+	}
+	srv := NewUserServiceServer(mockStore)
+	ctx := context.Background()
+	if _, err := srv.CreateUser(ctx, &userv1.CreateUserRequest{Username: "root", HashedPassword: "x", Role: userv1.Role_ROLE_ADMIN}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := srv.DeleteUser(ctx, &userv1.DeleteUserByIdRequest{Id: "u1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]metricdata.DataPoint[int64])
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			got[m.Name] = m.Data.(metricdata.Sum[int64]).DataPoints[0]
+		}
+	}
+
+	created := got["users.created"]
+	if role, _ := created.Attributes.Value(roleKey); created.Value != 1 || role != attribute.StringValue("admin") {
+		t.Fatalf("expected one admin created, got %+v", created)
+	}
+	if got["users.deleted"].Value != 1 {
+		t.Fatalf("expected one deletion, got %+v", got["users.deleted"])
+	}
+}
@@ -3,7 +3,7 @@ package service
 import (
 	"context"
 	"errors"
-	"log"
+	"log/slog"
 
 	userv1 "github.com/provsalt/DOP_P01_Team1/common/user/v1"
 	"github.com/provsalt/DOP_P01_Team1/user-service/internal/store"
@@ -19,20 +19,33 @@ type userStore interface {
 	GetUserByUsername(ctx context.Context, username string) (*store.User, error)
 	DeleteUserByID(ctx context.Context, id string) error
 	ListUsers(ctx context.Context, roleFilter string, usernameFilter string) ([]*store.User, error)
+	SetQuota(ctx context.Context, id string, maxFiles, maxBytes *int64) error
 }
 
+// RoleQuotas is the storage quota each role gets unless a user has their own.
+// A limit of 0 is unlimited, as is a role with no entry.
+type RoleQuotas map[userv1.Role]*userv1.Quota
+
 type UserServiceServer struct {
-	store userStore
+	store   userStore
+	metrics *userMetrics
+	quotas  RoleQuotas
 
 	userv1.UnimplementedUserServiceServer
 }
 
 func NewUserServiceServer(store userStore) *UserServiceServer {
 	return &UserServiceServer{
-		store: store,
+		store:   store,
+		metrics: newUserMetrics(),
 	}
 }
 
+// SetRoleQuotas replaces the per-role default storage quotas.
+func (s *UserServiceServer) SetRoleQuotas(quotas RoleQuotas) {
+	s.quotas = quotas
+}
+
 func (s *UserServiceServer) CreateUser(ctx context.Context, req *userv1.CreateUserRequest) (*userv1.CreateUserResponse, error) {
 	if req.Username == "" {
 		return nil, status.Error(codes.InvalidArgument, "username is required")
@@ -57,11 +70,12 @@ func (s *UserServiceServer) CreateUser(ctx context.Context, req *userv1.CreateUs
 		if errors.Is(err, store.ErrUserExists) {
 			return nil, status.Error(codes.AlreadyExists, "username already exists")
 		}
-		log.Printf("failed to create user: %v", err)
+		slog.ErrorContext(ctx, "failed to create user", "error", err)
 		return nil, status.Error(codes.Internal, "failed to create user")
 	}
 
 	user.Id = id
+	s.metrics.recordCreated(ctx, user.Role)
 
 	return &userv1.CreateUserResponse{
 		User: &userv1.User{
@@ -82,7 +96,7 @@ func (s *UserServiceServer) GetUser(ctx context.Context, req *userv1.GetUserRequ
 		if errors.Is(err, store.ErrUserNotFound) {
 			return nil, status.Error(codes.NotFound, "user not found")
 		}
-		log.Printf("failed to get user: %v", err)
+		slog.ErrorContext(ctx, "failed to get user", "error", err)
 		return nil, status.Error(codes.Internal, "failed to get user")
 	}
 
@@ -105,7 +119,7 @@ func (s *UserServiceServer) GetUserByUsername(ctx context.Context, req *userv1.G
 		if errors.Is(err, store.ErrUserNotFound) {
 			return nil, status.Error(codes.NotFound, "user not found")
 		}
-		log.Printf("failed to get user by username: %v", err)
+		slog.ErrorContext(ctx, "failed to get user by username", "error", err)
 		return nil, status.Error(codes.Internal, "failed to get user")
 	}
 
@@ -131,7 +145,7 @@ func (s *UserServiceServer) VerifyPassword(ctx context.Context, req *userv1.Veri
 		if errors.Is(err, store.ErrUserNotFound) {
 			return &userv1.VerifyPasswordResponse{Valid: false}, nil
 		}
-		log.Printf("failed to get user for password verification: %v", err)
+		slog.ErrorContext(ctx, "failed to get user for password verification", "error", err)
 		return nil, status.Error(codes.Internal, "failed to verify password")
 	}
 
@@ -160,9 +174,10 @@ func (s *UserServiceServer) DeleteUser(ctx context.Context, req *userv1.DeleteUs
 		if errors.Is(err, store.ErrUserNotFound) {
 			return nil, status.Error(codes.NotFound, "user not found")
 		}
-		log.Printf("failed to delete user: %v", err)
+		slog.ErrorContext(ctx, "failed to delete user", "error", err)
 		return nil, status.Error(codes.Internal, "failed to delete user")
 	}
+	s.metrics.recordDeleted(ctx)
 	return &userv1.DeleteUserByIdResponse{Success: true}, nil
 }
 
@@ -181,7 +196,7 @@ func (s *UserServiceServer) ListUsers(ctx context.Context, req *userv1.ListUsers
 
 	users, err := s.store.ListUsers(ctx, roleStr, req.UsernameFilter)
 	if err != nil {
-		log.Printf("failed to list users: %v", err)
+		slog.ErrorContext(ctx, "failed to list users", "error", err)
 		return nil, status.Error(codes.Internal, "failed to list users")
 	}
 
@@ -199,6 +214,67 @@ func (s *UserServiceServer) ListUsers(ctx context.Context, req *userv1.ListUsers
 	}, nil
 }
 
+func (s *UserServiceServer) GetQuota(ctx context.Context, req *userv1.GetQuotaRequest) (*userv1.GetQuotaResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	user, err := s.store.GetUserByID(ctx, req.UserId)
+	if err != nil {
+		if errors.Is(err, store.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		slog.ErrorContext(ctx, "failed to get user for quota", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get quota")
+	}
+
+	return s.quotaResponse(user), nil
+}
+
+// SetQuota overrides a user's storage quota. Each limit left unset goes back
+// to the role default, so sending neither resets the user entirely.
+func (s *UserServiceServer) SetQuota(ctx context.Context, req *userv1.SetQuotaRequest) (*userv1.GetQuotaResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.GetMaxFiles() < 0 || req.GetMaxBytes() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "quota limits cannot be negative")
+	}
+
+	err := s.store.SetQuota(ctx, req.UserId, req.MaxFiles, req.MaxBytes)
+	if err != nil {
+		if errors.Is(err, store.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		slog.ErrorContext(ctx, "failed to set quota", "error", err)
+		return nil, status.Error(codes.Internal, "failed to set quota")
+	}
+
+	return s.GetQuota(ctx, &userv1.GetQuotaRequest{UserId: req.UserId})
+}
+
+func (s *UserServiceServer) quotaResponse(user *store.User) *userv1.GetQuotaResponse {
+	roleDefault := &userv1.Quota{}
+	if quota := s.quotas[stringToRole(user.Role)]; quota != nil {
+		roleDefault.MaxFiles = quota.MaxFiles
+		roleDefault.MaxBytes = quota.MaxBytes
+	}
+
+	quota := &userv1.Quota{MaxFiles: roleDefault.MaxFiles, MaxBytes: roleDefault.MaxBytes}
+	if user.MaxFiles != nil {
+		quota.MaxFiles = *user.MaxFiles
+	}
+	if user.MaxBytes != nil {
+		quota.MaxBytes = *user.MaxBytes
+	}
+
+	return &userv1.GetQuotaResponse{
+		Quota:       quota,
+		RoleDefault: roleDefault,
+		Custom:      user.MaxFiles != nil || user.MaxBytes != nil,
+	}
+}
+
 func roleToString(role userv1.Role) string {
 	switch role {
 	case userv1.Role_ROLE_ADMIN:
@@ -88,6 +88,7 @@ type mockUserStore struct {
 	getUserByUsernameFunc func(ctx context.Context, username string) (*store.User, error)
 	deleteUserByIDFunc    func(ctx context.Context, id string) error
 	listUsersFunc         func(ctx context.Context, roleFilter string, usernameFilter string) ([]*store.User, error)
+	setQuotaFunc          func(ctx context.Context, id string, maxFiles, maxBytes *int64) error
 }
 
 func (m *mockUserStore) CreateUser(ctx context.Context, user *store.User) (string, error) {
@@ -125,6 +126,13 @@ func (m *mockUserStore) ListUsers(ctx context.Context, roleFilter string, userna
 	return nil, nil
 }
 
+func (m *mockUserStore) SetQuota(ctx context.Context, id string, maxFiles, maxBytes *int64) error {
+	if m.setQuotaFunc != nil {
+		return m.setQuotaFunc(ctx, id, maxFiles, maxBytes)
+	}
+	return nil
+}
+
 func TestListUsers_Success_NoFilters(t *testing.T) {
 	mockStore := &mockUserStore{
 		listUsersFunc: func(ctx context.Context, roleFilter string, usernameFilter string) ([]*store.User, error) {
@@ -540,3 +548,88 @@ func TestDeleteUser_InternalError(t *testing.T) {
 		t.Fatalf("expected Internal, got %v", status.Code(err))
 	}
 }
+
+func quotaTestServer(mockStore *mockUserStore) *UserServiceServer {
+	srv := NewUserServiceServer(mockStore)
+	srv.SetRoleQuotas(RoleQuotas{
+		userv1.Role_ROLE_USER:  {MaxFiles: 20, MaxBytes: 1 << 30},
+		userv1.Role_ROLE_ADMIN: {MaxFiles: 100, MaxBytes: 0},
+	})
+	return srv
+}
+
+func TestGetQuota_RoleDefault(t *testing.T) {
+	mockStore := &mockUserStore{
+		getUserByIDFunc: func(ctx context.Context, id string) (*store.User, error) {
+			return &store.User{Id: id, Username: "admin", Role: "admin"}, nil
+		},
+	}
+	resp, err := quotaTestServer(mockStore).GetQuota(context.Background(), &userv1.GetQuotaRequest{UserId: "u1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Custom || resp.Quota.MaxFiles != 100 || resp.Quota.MaxBytes != 0 {
+		t.Fatalf("unexpected quota %v", resp)
+	}
+}
+
+func TestGetQuota_UserOverride(t *testing.T) {
+	maxBytes := int64(5 << 30)
+	mockStore := &mockUserStore{
+		getUserByIDFunc: func(ctx context.Context, id string) (*store.User, error) {
+			return &store.User{Id: id, Username: "alice", Role: "user", MaxBytes: &maxBytes}, nil
+		},
+	}
+	resp, err := quotaTestServer(mockStore).GetQuota(context.Background(), &userv1.GetQuotaRequest{UserId: "u1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Custom || resp.Quota.MaxFiles != 20 || resp.Quota.MaxBytes != maxBytes {
+		t.Fatalf("unexpected quota %v", resp.Quota)
+	}
+	if resp.RoleDefault.MaxBytes != 1<<30 {
+		t.Fatalf("unexpected role default %v", resp.RoleDefault)
+	}
+}
+
+func TestGetQuota_NotFound(t *testing.T) {
+	mockStore := &mockUserStore{
+		getUserByIDFunc: func(ctx context.Context, id string) (*store.User, error) { return nil, store.ErrUserNotFound },
+	}
+	_, err := quotaTestServer(mockStore).GetQuota(context.Background(), &userv1.GetQuotaRequest{UserId: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", status.Code(err))
+	}
+}
+
+func TestSetQuota_Success(t *testing.T) {
+	var saved *int64
+	mockStore := &mockUserStore{
+		setQuotaFunc: func(ctx context.Context, id string, maxFiles, maxBytes *int64) error {
+			if maxBytes != nil {
+				t.Errorf("expected max_bytes left unset, got %d", *maxBytes)
+			}
+			saved = maxFiles
+			return nil
+		},
+		getUserByIDFunc: func(ctx context.Context, id string) (*store.User, error) {
+			return &store.User{Id: id, Username: "alice", Role: "user", MaxFiles: saved}, nil
+		},
+	}
+	maxFiles := int64(50)
+	resp, err := quotaTestServer(mockStore).SetQuota(context.Background(), &userv1.SetQuotaRequest{UserId: "u1", MaxFiles: &maxFiles})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Custom || resp.Quota.MaxFiles != 50 || resp.Quota.MaxBytes != 1<<30 {
+		t.Fatalf("unexpected quota %v", resp.Quota)
+	}
+}
+
+func TestSetQuota_Negative(t *testing.T) {
+	maxBytes := int64(-1)
+	_, err := quotaTestServer(&mockUserStore{}).SetQuota(context.Background(), &userv1.SetQuotaRequest{UserId: "u1", MaxBytes: &maxBytes})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", status.Code(err))
+	}
+}
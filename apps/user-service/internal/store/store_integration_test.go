@@ -241,3 +241,38 @@ func TestListUsers_Success_Integration(t *testing.T) {
 		t.Errorf("expected 3 users, got %d", len(users))
 	}
 }
+
+func TestSetQuota_Integration(t *testing.T) {
+	store, cleanup := setupTestContainer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	id, err := store.CreateUser(ctx, &User{Username: "quota", HashedPassword: "hash", Role: "user"})
+	if err != nil {
+		t.Fatalf("CreateUser failed: %v", err)
+	}
+
+	maxFiles := int64(50)
+	if err := store.SetQuota(ctx, id, &maxFiles, nil); err != nil {
+		t.Fatalf("SetQuota failed: %v", err)
+	}
+	user, err := store.GetUserByID(ctx, id)
+	if err != nil {
+		t.Fatalf("GetUserByID failed: %v", err)
+	}
+	if user.MaxFiles == nil || *user.MaxFiles != 50 || user.MaxBytes != nil {
+		t.Errorf("unexpected quota %v/%v", user.MaxFiles, user.MaxBytes)
+	}
+
+	if err := store.SetQuota(ctx, id, nil, nil); err != nil {
+		t.Fatalf("SetQuota failed: %v", err)
+	}
+	user, _ = store.GetUserByID(ctx, id)
+	if user.MaxFiles != nil {
+		t.Errorf("expected quota reset, got %d", *user.MaxFiles)
+	}
+
+	if err := store.SetQuota(ctx, "507f1f77bcf86cd799439011", &maxFiles, nil); err != ErrUserNotFound {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
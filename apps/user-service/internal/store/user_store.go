@@ -18,6 +18,10 @@ type User struct {
 	Username       string `bson:"username" json:"username"`
 	HashedPassword string `bson:"hashedPassword" json:"hashedPassword"`
 	Role           string `bson:"role" json:"role"`
+	// MaxFiles and MaxBytes override the storage quota of the user's role.
+	// Nil keeps the role default.
+	MaxFiles *int64 `bson:"maxFiles,omitempty" json:"maxFiles,omitempty"`
+	MaxBytes *int64 `bson:"maxBytes,omitempty" json:"maxBytes,omitempty"`
 }
 
 type UserStore struct {
@@ -64,6 +68,8 @@ func (u *UserStore) GetUserByID(ctx context.Context, id string) (*User, error) {
 		Username       string        `bson:"username"`
 		HashedPassword string        `bson:"hashedPassword"`
 		Role           string        `bson:"role"`
+		MaxFiles       *int64        `bson:"maxFiles"`
+		MaxBytes       *int64        `bson:"maxBytes"`
 	}
 
 	err = collection.FindOne(ctx, bson.M{"_id": oid}).Decode(&result)
@@ -79,6 +85,8 @@ func (u *UserStore) GetUserByID(ctx context.Context, id string) (*User, error) {
 		Username:       result.Username,
 		HashedPassword: result.HashedPassword,
 		Role:           result.Role,
+		MaxFiles:       result.MaxFiles,
+		MaxBytes:       result.MaxBytes,
 	}
 
 	return user, nil
@@ -92,6 +100,8 @@ func (u *UserStore) GetUserByUsername(ctx context.Context, username string) (*Us
 		Username       string        `bson:"username"`
 		HashedPassword string        `bson:"hashedPassword"`
 		Role           string        `bson:"role"`
+		MaxFiles       *int64        `bson:"maxFiles"`
+		MaxBytes       *int64        `bson:"maxBytes"`
 	}
 
 	err := collection.FindOne(ctx, bson.M{"username": username}).Decode(&result)
@@ -107,6 +117,8 @@ func (u *UserStore) GetUserByUsername(ctx context.Context, username string) (*Us
 		Username:       result.Username,
 		HashedPassword: result.HashedPassword,
 		Role:           result.Role,
+		MaxFiles:       result.MaxFiles,
+		MaxBytes:       result.MaxBytes,
 	}
 
 	return user, nil
@@ -127,6 +139,42 @@ func (u *UserStore) DeleteUserByID(ctx context.Context, id string) error {
 	return err
 }
 
+// SetQuota overrides a user's storage quota. A nil limit is removed, so the
+// user falls back to their role's default for it.
+func (u *UserStore) SetQuota(ctx context.Context, id string, maxFiles, maxBytes *int64) error {
+	collection := u.database.Collection("users")
+
+	oid, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrUserNotFound
+	}
+
+	set, unset := bson.M{}, bson.M{}
+	for field, limit := range map[string]*int64{"maxFiles": maxFiles, "maxBytes": maxBytes} {
+		if limit != nil {
+			set[field] = *limit
+		} else {
+			unset[field] = ""
+		}
+	}
+	update := bson.M{}
+	if len(set) > 0 {
+		update["$set"] = set
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	result, err := collection.UpdateOne(ctx, bson.M{"_id": oid}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
 func (u *UserStore) ListUsers(ctx context.Context, roleFilter string, usernameFilter string) ([]*User, error) {
 	collection := u.database.Collection("users")
 
@@ -149,6 +197,8 @@ func (u *UserStore) ListUsers(ctx context.Context, roleFilter string, usernameFi
 		Username       string        `bson:"username"`
 		HashedPassword string        `bson:"hashedPassword"`
 		Role           string        `bson:"role"`
+		MaxFiles       *int64        `bson:"maxFiles"`
+		MaxBytes       *int64        `bson:"maxBytes"`
 	}
 
 	if err := cursor.All(ctx, &results); err != nil {
@@ -162,6 +212,8 @@ func (u *UserStore) ListUsers(ctx context.Context, roleFilter string, usernameFi
 			Username:       result.Username,
 			HashedPassword: result.HashedPassword,
 			Role:           result.Role,
+			MaxFiles:       result.MaxFiles,
+			MaxBytes:       result.MaxBytes,
 		}
 	}
 